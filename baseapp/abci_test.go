@@ -2,6 +2,7 @@ package baseapp
 
 import (
 	"encoding/json"
+	"errors"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -197,3 +198,75 @@ func (ps *paramStore) Get(_ sdk.Context, key []byte, ptr interface{}) {
 		panic(err)
 	}
 }
+
+// mockABCIListener records the Commit responses it receives and fails every hook with err, if set
+type mockABCIListener struct {
+	halt    bool
+	err     error
+	commits []abci.ResponseCommit
+}
+
+func (l *mockABCIListener) ListenBeginBlock(sdk.Context, abci.RequestBeginBlock, abci.ResponseBeginBlock) error {
+	return l.err
+}
+
+func (l *mockABCIListener) ListenEndBlock(sdk.Context, abci.RequestEndBlock, abci.ResponseEndBlock) error {
+	return l.err
+}
+
+func (l *mockABCIListener) ListenDeliverTx(sdk.Context, abci.RequestDeliverTx, abci.ResponseDeliverTx) error {
+	return l.err
+}
+
+func (l *mockABCIListener) ListenCommit(_ sdk.Context, res abci.ResponseCommit) error {
+	l.commits = append(l.commits, res)
+	return l.err
+}
+
+func (l *mockABCIListener) HaltAppOnDeliveryError() bool {
+	return l.halt
+}
+
+func TestABCIListenerCommit(t *testing.T) {
+	app := setupBaseApp(t)
+	listener := &mockABCIListener{}
+	app.abciListeners = append(app.abciListeners, listener)
+
+	app.InitChain(abci.RequestInitChain{})
+	app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+	app.EndBlock(abci.RequestEndBlock{Height: 1})
+	res := app.Commit()
+
+	// the listener receives the Commit response carrying the app hash
+	require.Equal(t, []abci.ResponseCommit{res}, listener.commits)
+	require.Equal(t, app.LastCommitID().Hash, listener.commits[0].Data)
+}
+
+func TestABCIListenerHaltOnError(t *testing.T) {
+	app := setupBaseApp(t)
+	listener := &mockABCIListener{err: errors.New("listener failure")}
+	app.abciListeners = append(app.abciListeners, listener)
+	app.InitChain(abci.RequestInitChain{})
+
+	// errors are only logged if the listener does not require guaranteed delivery
+	require.NotPanics(t, func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}})
+		app.DeliverTx(abci.RequestDeliverTx{})
+		app.EndBlock(abci.RequestEndBlock{Height: 1})
+		app.Commit()
+	})
+	require.Equal(t, int64(1), app.LastBlockHeight())
+
+	// otherwise the block is not processed further
+	listener.halt = true
+	require.Panics(t, func() {
+		app.BeginBlock(abci.RequestBeginBlock{Header: tmproto.Header{Height: 2}})
+	})
+	require.Panics(t, func() {
+		app.DeliverTx(abci.RequestDeliverTx{})
+	})
+	require.Panics(t, func() {
+		app.EndBlock(abci.RequestEndBlock{Height: 2})
+	})
+	require.Equal(t, int64(1), app.LastBlockHeight())
+}
@@ -1144,6 +1144,43 @@ func TestBeginBlock_WithInitialHeight(t *testing.T) {
 	require.Equal(t, int64(3), app.LastBlockHeight())
 }
 
+func TestGenesisContext_WithInitialHeight(t *testing.T) {
+	name := t.Name()
+	db := dbm.NewMemDB()
+	logger := defaultLogger()
+	app := NewBaseApp(name, logger, db, nil)
+
+	var initCtx, beginCtx sdk.Context
+	app.SetInitChainer(func(ctx sdk.Context, _ abci.RequestInitChain) abci.ResponseInitChain {
+		initCtx = ctx
+		return abci.ResponseInitChain{}
+	})
+	app.SetBeginBlocker(func(ctx sdk.Context, _ abci.RequestBeginBlock) abci.ResponseBeginBlock {
+		beginCtx = ctx
+		return abci.ResponseBeginBlock{}
+	})
+
+	app.InitChain(
+		abci.RequestInitChain{
+			InitialHeight: 3,
+		},
+	)
+
+	// the genesis is marked although its height is the initial height
+	require.True(t, initCtx.IsGenesis())
+	require.Equal(t, int64(3), initCtx.BlockHeight())
+
+	app.BeginBlock(abci.RequestBeginBlock{
+		Header: tmproto.Header{
+			Height: 3,
+		},
+	})
+
+	// the first block reuses the genesis deliver state, but is not the genesis
+	require.False(t, beginCtx.IsGenesis())
+	require.Equal(t, int64(3), beginCtx.BlockHeight())
+}
+
 // Simple tx with a list of Msgs.
 type txTest struct {
 	Msgs       []sdk.Msg
@@ -1430,6 +1467,47 @@ func TestCheckTx(t *testing.T) {
 	require.Nil(t, storedBytes)
 }
 
+// Test that CheckTx returns the priority set by the AnteHandler
+func TestCheckTxPriority(t *testing.T) {
+	anteOpt := func(bapp *BaseApp) {
+		bapp.SetAnteHandler(func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+			txTest := tx.(txTest)
+			if txTest.FailOnAnte {
+				return ctx.WithPriority(100), sdkerrors.Wrap(sdkerrors.ErrUnauthorized, "ante handler failure")
+			}
+			return ctx.WithPriority(txTest.Counter * 10), nil
+		})
+	}
+	routerOpt := func(bapp *BaseApp) {
+		bapp.Router().AddRoute(sdk.NewRoute(routeMsgCounter, func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+			return &sdk.Result{}, nil
+		}))
+	}
+
+	app := setupBaseApp(t, anteOpt, routerOpt)
+	app.InitChain(abci.RequestInitChain{})
+
+	codec := codec.NewLegacyAmino()
+	registerTestCodec(codec)
+
+	for i := int64(0); i < 3; i++ {
+		txBytes, err := codec.Marshal(newTxCounter(i, 0))
+		require.NoError(t, err)
+		r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+		require.True(t, r.IsOK(), fmt.Sprintf("%v", r))
+		require.Equal(t, i*10, r.Priority)
+	}
+
+	// a rejected tx has no priority
+	tx := newTxCounter(5, 0)
+	tx.setFailOnAnte(true)
+	txBytes, err := codec.Marshal(tx)
+	require.NoError(t, err)
+	r := app.CheckTx(abci.RequestCheckTx{Tx: txBytes})
+	require.False(t, r.IsOK())
+	require.Zero(t, r.Priority)
+}
+
 // Test that successive DeliverTx can see each others' effects
 // on the store, both within and across blocks.
 func TestDeliverTx(t *testing.T) {
@@ -9,6 +9,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec/types"
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/store"
+	"github.com/cosmos/cosmos-sdk/store/journal"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -20,6 +22,11 @@ func SetPruning(opts sdk.PruningOptions) func(*BaseApp) {
 	return func(bapp *BaseApp) { bapp.cms.SetPruning(opts) }
 }
 
+// SetBackgroundPruning returns an option that sets whether the pruned heights are deleted in the background.
+func SetBackgroundPruning(enabled bool) func(*BaseApp) {
+	return func(bapp *BaseApp) { bapp.SetBackgroundPruning(enabled) }
+}
+
 // SetMinGasPrices returns an option that sets the minimum gas prices on the app.
 func SetMinGasPrices(gasPricesStr string) func(*BaseApp) {
 	gasPrices, err := sdk.ParseDecCoins(gasPricesStr)
@@ -73,6 +80,16 @@ func SetInterBlockCache(cache sdk.MultiStorePersistentCache) func(*BaseApp) {
 	return func(app *BaseApp) { app.setInterBlockCache(cache) }
 }
 
+// SetStateJournal sets the state diff journal used to query the pruned heights.
+func SetStateJournal(j *journal.Journal) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStateJournal(j) }
+}
+
+// SetStateJournalMaxReplay sets the maximum number of heights replayed from the state journal to query a pruned height.
+func SetStateJournalMaxReplay(maxReplay uint64) func(*BaseApp) {
+	return func(app *BaseApp) { app.SetStateJournalMaxReplay(maxReplay) }
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func SetSnapshotInterval(interval uint64) func(*BaseApp) {
 	return func(app *BaseApp) { app.SetSnapshotInterval(interval) }
@@ -225,6 +242,52 @@ func (app *BaseApp) SetSnapshotStore(snapshotStore *snapshots.Store) {
 	app.snapshotManager = snapshots.NewManager(snapshotStore, app.cms)
 }
 
+// SetBackgroundPruning sets whether the pruned heights are deleted by a background worker, one by one, instead
+// of during the commits. It requires a rootmulti store.
+func (app *BaseApp) SetBackgroundPruning(enabled bool) {
+	if app.sealed {
+		panic("SetBackgroundPruning() on sealed BaseApp")
+	}
+	if !enabled {
+		return
+	}
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic("background pruning requires a rootmulti store")
+	}
+	rms.SetBackgroundPruning(enabled)
+}
+
+// SetStateJournal sets the state diff journal recording the changes of each block, so the state of the pruned
+// heights can be reconstructed for queries. It requires a rootmulti store.
+func (app *BaseApp) SetStateJournal(j *journal.Journal) {
+	if app.sealed {
+		panic("SetStateJournal() on sealed BaseApp")
+	}
+	if j == nil {
+		return
+	}
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic("the state journal requires a rootmulti store")
+	}
+	rms.SetJournal(j)
+}
+
+// SetStateJournalMaxReplay sets the maximum number of heights whose changes are replayed from the state journal to
+// reconstruct the state of a pruned height, queries of the heights further from the nearest retained height fail.
+// Zero disables the limit. It requires a rootmulti store.
+func (app *BaseApp) SetStateJournalMaxReplay(maxReplay uint64) {
+	if app.sealed {
+		panic("SetStateJournalMaxReplay() on sealed BaseApp")
+	}
+	rms, ok := app.cms.(*rootmulti.Store)
+	if !ok {
+		panic("the state journal requires a rootmulti store")
+	}
+	rms.SetJournalMaxReplay(int64(maxReplay))
+}
+
 // SetSnapshotInterval sets the snapshot interval.
 func (app *BaseApp) SetSnapshotInterval(snapshotInterval uint64) {
 	if app.sealed {
@@ -255,6 +318,6 @@ func (app *BaseApp) SetStreamingService(s StreamingService) {
 		app.cms.AddListeners(key, lis)
 	}
 	// register the StreamingService within the BaseApp
-	// BaseApp will pass BeginBlock, DeliverTx, and EndBlock requests and responses to the streaming services to update their ABCI context
+	// BaseApp will pass BeginBlock, DeliverTx, EndBlock and Commit requests and responses to the streaming services to update their ABCI context
 	app.abciListeners = append(app.abciListeners, s)
 }
@@ -1,6 +1,7 @@
 package baseapp
 
 import (
+	"fmt"
 	"io"
 	"sync"
 
@@ -18,6 +19,14 @@ type ABCIListener interface {
 	ListenEndBlock(ctx types.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error
 	// ListenDeliverTx updates the steaming service with the latest DeliverTx messages
 	ListenDeliverTx(ctx types.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error
+	// ListenCommit updates the steaming service with the latest Commit messages, the Data of the response
+	// is the app hash of the committed block, signaling the data of the block is complete
+	ListenCommit(ctx types.Context, res abci.ResponseCommit) error
+	// HaltAppOnDeliveryError returns true if the node must stop processing blocks when one of the hooks fails,
+	// so no data is lost. When a BeginBlock, DeliverTx or EndBlock hook fails, the block is not committed
+	// and it is replayed to all listeners when the node restarts, listeners which succeeded receive it twice.
+	// When the Commit hook fails, the block is already committed and it is not replayed.
+	HaltAppOnDeliveryError() bool
 }
 
 // StreamingService interface for registering WriteListeners with the BaseApp and updating the service with the ABCI messages using the hooks
@@ -31,3 +40,12 @@ type StreamingService interface {
 	// Closer interface
 	io.Closer
 }
+
+// handleListenerError logs the error returned by a listening hook and panics if the listener
+// requires guaranteed delivery, which stops the node from processing the current block
+func (app *BaseApp) handleListenerError(listener ABCIListener, hook string, height int64, err error) {
+	app.logger.Error(fmt.Sprintf("%s listening hook failed", hook), "height", height, "err", err)
+	if listener.HaltAppOnDeliveryError() {
+		panic(fmt.Errorf("%s listening hook failed at height %d: %w", hook, height, err))
+	}
+}
@@ -27,20 +27,22 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 		Use:   "prune",
 		Short: "Prune app history states by keeping the recent heights and deleting old heights",
 		Long: `Prune app history states by keeping the recent heights and deleting old heights.
-		The pruning strategy is provided via the '--pruning' flag or alternatively with '--pruning-keep-recent'
-		
-		For '--pruning' the options are as follows:
-		
-		default: the last 362880 states are kept
-		nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
-		everything: 2 latest states will be kept
-		custom: allow pruning options to be manually specified through the 'app.toml' config file or through CLI flags.
-		besides pruning options, database home directory and database backend type should also be specified via flags
-		'--home' and '--app-db-backend'.
-		valid app-db-backend type includes 'goleveldb', 'cleveldb', 'rocksdb', 'boltdb', and 'badgerdb'.
-		`,
-		Example: `prune --home './' --app-db-backend 'goleveldb' --pruning 'custom' --pruning-keep-recent 100 --
-		pruning-keep-every 10, --pruning-interval 10`,
+The node must be stopped. The versions of every IAVL store which are not retained by the
+pruning strategy are deleted, including the versions left on disk by a previous strategy.
+The pruning strategy is provided via the '--pruning' flag or alternatively with '--pruning-keep-recent'
+and '--pruning-keep-every'.
+
+For '--pruning' the options are as follows:
+
+default: the last 362880 states are kept in addition to every 100th state
+nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
+everything: 2 latest states will be kept
+custom: allow pruning options to be manually specified through the 'app.toml' config file or through CLI flags.
+besides pruning options, database home directory and database backend type should also be specified via flags
+'--home' and '--app-db-backend'.
+valid app-db-backend type includes 'goleveldb', 'cleveldb', 'rocksdb', 'boltdb', and 'badgerdb'.
+`,
+		Example: `prune --home './' --app-db-backend 'goleveldb' --pruning 'custom' --pruning-keep-recent 100 --pruning-keep-every 10 --pruning-interval 10`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			vp := viper.New()
 
@@ -52,8 +54,8 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 			if err != nil {
 				return err
 			}
-			fmt.Printf("get pruning options from command flags, keep-recent: %v\n",
-				pruningOptions.KeepRecent,
+			fmt.Printf("get pruning options from command flags, keep-recent: %v, keep-every: %v\n",
+				pruningOptions.KeepRecent, pruningOptions.KeepEvery,
 			)
 
 			home := vp.GetString(flags.FlagHome)
@@ -76,31 +78,17 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 				return fmt.Errorf("the database has no valid heights to prune, the latest height: %v", latestHeight)
 			}
 
-			effectiveLastHeight := latestHeight - int64(pruningOptions.KeepRecent)
-
-			if effectiveLastHeight < 1 {
+			pruned, err := rootMultiStore.PruneVersions(pruningOptions)
+			if err != nil {
+				return err
+			}
+			if pruned == 0 {
 				fmt.Printf("no heights to prune\n")
 				return nil
 			}
-
-			lenPH := effectiveLastHeight + 1
-			pruningHeights := make([]int64, lenPH)
-			for height := int64(1); height < lenPH; height++ {
-				pruningHeights[height] = height
-			}
-			pruningHeights = pruningHeights[1:]
-
-			fmt.Printf(
-				"pruning heights start from %v, end at %v\n",
-				pruningHeights[0],
-				pruningHeights[len(pruningHeights)-1],
+			fmt.Printf("successfully pruned %d heights of the application root multi stores, the latest height: %v\n",
+				pruned, latestHeight,
 			)
-
-			rootMultiStore.PruneStores(false, pruningHeights)
-			if err != nil {
-				return err
-			}
-			fmt.Printf("successfully pruned the application root multi stores\n")
 			return nil
 		},
 	}
@@ -109,9 +97,7 @@ func PruningCmd(appCreator servertypes.AppCreator) *cobra.Command {
 	cmd.Flags().String(FlagAppDBBackend, "", "The type of database for application and snapshots databases")
 	cmd.Flags().String(server.FlagPruning, storetypes.PruningOptionDefault, "Pruning strategy (default|nothing|everything|custom)")
 	cmd.Flags().Uint64(server.FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
-	cmd.Flags().Uint64(server.FlagPruningKeepEvery, 0,
-		`Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom'),
-		this is not used by this command but kept for compatibility with the complete pruning options`)
+	cmd.Flags().Uint64(server.FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(server.FlagPruningInterval, 10,
 		`Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom'), 
 		this is not used by this command but kept for compatibility with the complete pruning options`)
@@ -0,0 +1,105 @@
+package snapshot
+
+import (
+	"archive/tar"
+	"fmt"
+	"io"
+	"strconv"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+// archiveMetadataName is the name of the first entry of a snapshot archive, containing the snapshot metadata. It is
+// followed by the chunks of the snapshot, named by their index.
+const archiveMetadataName = "metadata"
+
+// writeArchive writes the snapshot and its chunks to a tar archive
+func writeArchive(w io.Writer, snapshot *types.Snapshot, chunks <-chan io.ReadCloser) error {
+	defer snapshots.DrainChunks(chunks)
+
+	tw := tar.NewWriter(w)
+	metadata, err := proto.Marshal(snapshot)
+	if err != nil {
+		return err
+	}
+	if err := writeArchiveEntry(tw, archiveMetadataName, metadata); err != nil {
+		return err
+	}
+
+	var index uint32
+	for chunk := range chunks {
+		bz, err := io.ReadAll(chunk)
+		chunk.Close()
+		if err != nil {
+			return fmt.Errorf("failed to read snapshot chunk %d: %w", index, err)
+		}
+		if err := writeArchiveEntry(tw, strconv.FormatUint(uint64(index), 10), bz); err != nil {
+			return err
+		}
+		index++
+	}
+	if index != snapshot.Chunks {
+		return fmt.Errorf("snapshot has %d chunks, but %d were read", snapshot.Chunks, index)
+	}
+	return tw.Close()
+}
+
+func writeArchiveEntry(tw *tar.Writer, name string, bz []byte) error {
+	err := tw.WriteHeader(&tar.Header{
+		Name: name,
+		Mode: 0o644,
+		Size: int64(len(bz)),
+	})
+	if err != nil {
+		return err
+	}
+	_, err = tw.Write(bz)
+	return err
+}
+
+// readArchive reads a tar archive written by writeArchive. The chunks are passed through the channel as they are
+// read, an invalid chunk entry is passed as a reader returning the error.
+func readArchive(r io.Reader) (*types.Snapshot, <-chan io.ReadCloser, error) {
+	tr := tar.NewReader(r)
+	header, err := tr.Next()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to read snapshot archive: %w", err)
+	}
+	if header.Name != archiveMetadataName {
+		return nil, nil, fmt.Errorf("invalid snapshot archive, expected %s entry, got %s", archiveMetadataName, header.Name)
+	}
+	metadata, err := io.ReadAll(tr)
+	if err != nil {
+		return nil, nil, err
+	}
+	var snapshot types.Snapshot
+	if err := proto.Unmarshal(metadata, &snapshot); err != nil {
+		return nil, nil, fmt.Errorf("invalid snapshot metadata: %w", err)
+	}
+
+	chunks := make(chan io.ReadCloser)
+	go func() {
+		defer close(chunks)
+		for i := uint32(0); i < snapshot.Chunks; i++ {
+			pr, pw := io.Pipe()
+			chunks <- pr
+			header, err := tr.Next()
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			if err == nil && header.Name != strconv.FormatUint(uint64(i), 10) {
+				err = fmt.Errorf("invalid snapshot archive, expected chunk %d, got %s", i, header.Name)
+			}
+			if err != nil {
+				pw.CloseWithError(err)
+				return
+			}
+			_, err = io.Copy(pw, tr)
+			pw.CloseWithError(err)
+		}
+	}()
+	return &snapshot, chunks, nil
+}
@@ -0,0 +1,66 @@
+package snapshot
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/snapshots/types"
+)
+
+func makeChunks(chunks [][]byte) <-chan io.ReadCloser {
+	ch := make(chan io.ReadCloser, len(chunks))
+	for _, chunk := range chunks {
+		ch <- io.NopCloser(bytes.NewReader(chunk))
+	}
+	close(ch)
+	return ch
+}
+
+func TestArchive(t *testing.T) {
+	chunks := [][]byte{{1, 2, 3}, {4, 5, 6}, {}}
+	snapshot := &types.Snapshot{
+		Height: 3,
+		Format: 1,
+		Chunks: 3,
+		Hash:   []byte{1, 2, 3},
+		Metadata: types.Metadata{
+			ChunkHashes: [][]byte{{1}, {2}, {3}},
+			StoreHashes: []types.SnapshotStoreHash{{Name: "bank", Hash: []byte{4, 5, 6}}},
+		},
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, writeArchive(&buf, snapshot, makeChunks(chunks)))
+
+	read, readChunks, err := readArchive(bytes.NewReader(buf.Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, snapshot, read)
+	var got [][]byte
+	for chunk := range readChunks {
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		got = append(got, bz)
+	}
+	require.Equal(t, chunks, got)
+
+	// the chunk count must match the metadata
+	snapshot.Chunks = 4
+	require.Error(t, writeArchive(io.Discard, snapshot, makeChunks(chunks)))
+
+	// a truncated archive passes an erroring chunk
+	_, readChunks, err = readArchive(bytes.NewReader(buf.Bytes()[:1024]))
+	require.NoError(t, err)
+	var readErr error
+	for chunk := range readChunks {
+		if _, err := io.ReadAll(chunk); err != nil {
+			readErr = err
+		}
+	}
+	require.Error(t, readErr)
+
+	_, _, err = readArchive(bytes.NewReader(nil))
+	require.Error(t, err)
+}
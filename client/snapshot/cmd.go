@@ -0,0 +1,36 @@
+package snapshot
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+	dbm "github.com/tendermint/tm-db"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Cmd returns the snapshots group command, managing the state sync snapshots of a node while it is stopped
+func Cmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "snapshots",
+		Short: "Manage local state sync snapshots",
+		Long: `Manage the local state sync snapshots of a stopped node. Snapshots can be taken from the
+local state, written to a single archive file and imported on another node, so that new
+nodes can be seeded from a file instead of a live network.`,
+	}
+	cmd.AddCommand(
+		ListSnapshotsCmd(),
+		DeleteSnapshotCmd(),
+		ExportSnapshotCmd(appCreator),
+		DumpArchiveCmd(),
+		ImportArchiveCmd(),
+		RestoreSnapshotCmd(appCreator),
+	)
+	return cmd
+}
+
+func openDB(rootDir string) (dbm.DB, error) {
+	dataDir := filepath.Join(rootDir, "data")
+	return sdk.NewLevelDB("application", dataDir)
+}
@@ -0,0 +1,66 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+const flagOutput = "output"
+
+// DumpArchiveCmd returns the command writing a local snapshot to an archive file
+func DumpArchiveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "dump <height> <format>",
+		Short:   "Write a local snapshot to a single archive file",
+		Args:    cobra.ExactArgs(2),
+		Example: "dump 1000 1 --output snapshot.tar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+			output, err := cmd.Flags().GetString(flagOutput)
+			if err != nil {
+				return err
+			}
+			if output == "" {
+				output = fmt.Sprintf("%d-%d.tar", height, format)
+			}
+
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			snapshot, chunks, err := snapshotStore.Load(height, format)
+			if err != nil {
+				return err
+			}
+			if snapshot == nil {
+				return fmt.Errorf("snapshot at height %d format %d not found", height, format)
+			}
+
+			f, err := os.Create(output)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+			if err := writeArchive(f, snapshot, chunks); err != nil {
+				return err
+			}
+			if err := f.Close(); err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d format %d written to %s\n", height, format, output)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringP(flagOutput, "o", "", "Output file, <height>-<format>.tar by default")
+	return cmd
+}
@@ -0,0 +1,58 @@
+package snapshot
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagHeight = "height"
+
+// ExportSnapshotCmd returns the command taking a snapshot of the local app state
+func ExportSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export",
+		Short: "Take a snapshot of the local app state",
+		Long: `Take a state sync snapshot of the local app state at the given height, the latest height by
+default, and save it to the local snapshot store. The height must not have been pruned.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			ctx := server.GetServerContextFromCmd(cmd)
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+
+			db, err := openDB(ctx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			snapshotManager := app.SnapshotManager()
+			if snapshotManager == nil {
+				return errors.New("no snapshot store is configured for the app")
+			}
+
+			if height == 0 {
+				height = app.CommitMultiStore().LastCommitID().Version
+			}
+			if height == 0 {
+				return errors.New("the app has no committed state to snapshot")
+			}
+			cmd.Printf("Exporting snapshot for height %d\n", height)
+			snapshot, err := snapshotManager.Create(uint64(height))
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot created at height %d, format %d, chunks %d\n", snapshot.Height, snapshot.Format, snapshot.Chunks)
+			return nil
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height to take the snapshot at, the latest height by default")
+	return cmd
+}
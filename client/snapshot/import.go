@@ -0,0 +1,47 @@
+package snapshot
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+)
+
+// ImportArchiveCmd returns the command importing an archive file into the local snapshot store
+func ImportArchiveCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:     "import <archive-file>",
+		Aliases: []string{"load"},
+		Short:   "Import a snapshot archive file into the local snapshot store",
+		Long: `Import a snapshot archive file, written by the dump command, into the local snapshot store.
+The chunks are verified against the checksums of the snapshot metadata.`,
+		Args:    cobra.ExactArgs(1),
+		Example: "import 1000-1.tar",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			f, err := os.Open(args[0])
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			snapshot, chunks, err := readArchive(f)
+			if err != nil {
+				return err
+			}
+
+			ctx := server.GetServerContextFromCmd(cmd)
+			snapshotStore, err := server.GetSnapshotStore(ctx.Viper)
+			if err != nil {
+				return err
+			}
+			snapshot, err = snapshotStore.Import(snapshot, chunks)
+			if err != nil {
+				return err
+			}
+
+			cmd.Printf("Snapshot at height %d format %d imported\n", snapshot.Height, snapshot.Format)
+			return nil
+		},
+	}
+}
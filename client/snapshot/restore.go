@@ -0,0 +1,50 @@
+package snapshot
+
+import (
+	"errors"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// RestoreSnapshotCmd returns the command restoring the app state from a local snapshot
+func RestoreSnapshotCmd(appCreator servertypes.AppCreator) *cobra.Command {
+	return &cobra.Command{
+		Use:   "restore <height> <format>",
+		Short: "Restore the app state from a local snapshot",
+		Long: `Restore the app state from a local snapshot, without Tendermint. The app database must be empty.
+Only the app state is restored, the Tendermint state and block store of the node are not, so the
+node must be bootstrapped at the snapshot height before it can follow the chain.`,
+		Args:    cobra.ExactArgs(2),
+		Example: "restore 1000 1",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			height, format, err := parseHeightAndFormat(args)
+			if err != nil {
+				return err
+			}
+
+			ctx := server.GetServerContextFromCmd(cmd)
+			db, err := openDB(ctx.Config.RootDir)
+			if err != nil {
+				return err
+			}
+			app := appCreator(ctx.Logger, db, nil, ctx.Viper)
+			snapshotManager := app.SnapshotManager()
+			if snapshotManager == nil {
+				return errors.New("no snapshot store is configured for the app")
+			}
+			if version := app.CommitMultiStore().LastCommitID().Version; version != 0 {
+				return errors.New("the app database is not empty, restoring requires an empty database")
+			}
+
+			if err := snapshotManager.RestoreLocalSnapshot(height, format); err != nil {
+				return err
+			}
+
+			cmd.Printf("App state restored at height %d, app hash %X\n", height, app.CommitMultiStore().LastCommitID().Hash)
+			return nil
+		},
+	}
+}
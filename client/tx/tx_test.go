@@ -17,6 +17,7 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
 	signingtypes "github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
 	"github.com/cosmos/cosmos-sdk/x/auth/signing"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 )
@@ -148,6 +149,30 @@ func TestBuildUnsignedTx(t *testing.T) {
 	require.Empty(t, sigs)
 }
 
+func TestBuildUnsignedUnorderedTx(t *testing.T) {
+	txf := tx.Factory{}.
+		WithTxConfig(NewTestTxConfig()).
+		WithAccountNumber(50).
+		WithSequence(23).
+		WithFees("50stake").
+		WithChainID("test-chain").
+		WithUnordered(true)
+
+	msg := banktypes.NewMsgSend(sdk.AccAddress("from"), sdk.AccAddress("to"), nil)
+
+	// a timeout height is required
+	_, err := tx.BuildUnsignedTx(txf, msg)
+	require.Error(t, err)
+
+	txb, err := tx.BuildUnsignedTx(txf.WithTimeoutHeight(100), msg)
+	require.NoError(t, err)
+
+	unorderedTx, ok := txb.GetTx().(ante.UnorderedTx)
+	require.True(t, ok)
+	require.True(t, unorderedTx.GetUnordered())
+	require.Equal(t, uint64(100), unorderedTx.GetTimeoutHeight())
+}
+
 func TestSign(t *testing.T) {
 	requireT := require.New(t)
 	path := hd.CreateHDPath(118, 0, 0).String()
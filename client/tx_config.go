@@ -44,4 +44,12 @@ type (
 		SetTimeoutHeight(height uint64)
 		SetFeeGranter(feeGranter sdk.AccAddress)
 	}
+
+	// UnorderedTxBuilder defines a TxBuilder which can build unordered
+	// transactions, whose signer sequences are nonces.
+	UnorderedTxBuilder interface {
+		TxBuilder
+
+		SetUnordered(unordered bool)
+	}
 )
@@ -29,11 +29,20 @@ type BaseConfig struct {
 	// specified in this config (e.g. 0.25token1;0.0001token2).
 	MinGasPrices string `mapstructure:"minimum-gas-prices"`
 
+	// MsgFeePolicies multiply the minimum gas prices for the transactions with
+	// messages of the given types, overriding the policies set by governance
+	// (e.g. /cosmos.slashing.v1beta1.MsgUnjail=0;/cosmos.bank.v1beta1.MsgMultiSend=2).
+	MsgFeePolicies string `mapstructure:"msg-fee-policies"`
+
 	Pruning           string `mapstructure:"pruning"`
 	PruningKeepRecent string `mapstructure:"pruning-keep-recent"`
 	PruningKeepEvery  string `mapstructure:"pruning-keep-every"`
 	PruningInterval   string `mapstructure:"pruning-interval"`
 
+	// PruningBackground removes the pruned heights from disk one by one in the
+	// background, instead of during the commits.
+	PruningBackground bool `mapstructure:"pruning-background"`
+
 	// HaltHeight contains a non-zero block height at which a node will gracefully
 	// halt and shutdown that can be used to assist upgrades and testing.
 	//
@@ -75,6 +84,14 @@ type BaseConfig struct {
 
 	// IAVLDisableFastNode enables or disables the fast sync node.
 	IAVLDisableFastNode bool `mapstructure:"iavl-disable-fastnode"`
+
+	// StateJournal enables the state diff journal, which records the state changes
+	// of each block so the state of the pruned heights can be queried.
+	StateJournal bool `mapstructure:"state-journal"`
+
+	// StateJournalMaxReplay is the maximum number of heights replayed from the
+	// state journal to query a pruned height, zero disables the limit.
+	StateJournalMaxReplay uint64 `mapstructure:"state-journal-max-replay"`
 }
 
 // APIConfig defines the API listener configuration.
@@ -207,16 +224,17 @@ func (c *Config) GetMinGasPrices() sdk.DecCoins {
 func DefaultConfig() *Config {
 	return &Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        defaultMinGasPrices,
-			InterBlockCache:     true,
-			Pruning:             storetypes.PruningOptionDefault,
-			PruningKeepRecent:   "0",
-			PruningKeepEvery:    "0",
-			PruningInterval:     "0",
-			MinRetainBlocks:     0,
-			IndexEvents:         make([]string, 0),
-			IAVLCacheSize:       781250, // 50 MB
-			IAVLDisableFastNode: true,
+			MinGasPrices:          defaultMinGasPrices,
+			InterBlockCache:       true,
+			Pruning:               storetypes.PruningOptionDefault,
+			PruningKeepRecent:     "0",
+			PruningKeepEvery:      "0",
+			PruningInterval:       "0",
+			MinRetainBlocks:       0,
+			IndexEvents:           make([]string, 0),
+			IAVLCacheSize:         781250, // 50 MB
+			IAVLDisableFastNode:   true,
+			StateJournalMaxReplay: 1000,
 		},
 		Telemetry: telemetry.Config{
 			Enabled:      false,
@@ -273,17 +291,21 @@ func GetConfig(v *viper.Viper) (Config, error) {
 
 	return Config{
 		BaseConfig: BaseConfig{
-			MinGasPrices:        v.GetString("minimum-gas-prices"),
-			InterBlockCache:     v.GetBool("inter-block-cache"),
-			Pruning:             v.GetString("pruning"),
-			PruningKeepRecent:   v.GetString("pruning-keep-recent"),
-			PruningInterval:     v.GetString("pruning-interval"),
-			HaltHeight:          v.GetUint64("halt-height"),
-			HaltTime:            v.GetUint64("halt-time"),
-			IndexEvents:         v.GetStringSlice("index-events"),
-			MinRetainBlocks:     v.GetUint64("min-retain-blocks"),
-			IAVLCacheSize:       v.GetUint64("iavl-cache-size"),
-			IAVLDisableFastNode: v.GetBool("iavl-disable-fastnode"),
+			MinGasPrices:          v.GetString("minimum-gas-prices"),
+			MsgFeePolicies:        v.GetString("msg-fee-policies"),
+			InterBlockCache:       v.GetBool("inter-block-cache"),
+			Pruning:               v.GetString("pruning"),
+			PruningKeepRecent:     v.GetString("pruning-keep-recent"),
+			PruningInterval:       v.GetString("pruning-interval"),
+			PruningBackground:     v.GetBool("pruning-background"),
+			HaltHeight:            v.GetUint64("halt-height"),
+			HaltTime:              v.GetUint64("halt-time"),
+			IndexEvents:           v.GetStringSlice("index-events"),
+			MinRetainBlocks:       v.GetUint64("min-retain-blocks"),
+			IAVLCacheSize:         v.GetUint64("iavl-cache-size"),
+			IAVLDisableFastNode:   v.GetBool("iavl-disable-fastnode"),
+			StateJournal:          v.GetBool("state-journal"),
+			StateJournalMaxReplay: v.GetUint64("state-journal-max-replay"),
 		},
 		Telemetry: telemetry.Config{
 			ServiceName:             v.GetString("telemetry.service-name"),
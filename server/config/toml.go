@@ -20,6 +20,13 @@ const DefaultConfigTemplate = `# This is a TOML config file.
 # specified in this config (e.g. 0.25token1;0.0001token2).
 minimum-gas-prices = "{{ .BaseConfig.MinGasPrices }}"
 
+# The fee policies of the message types, multiplying the minimum gas prices for
+# the transactions containing a message of the type. A factor of 0 makes the
+# message free. The transactions with several messages use the highest factor,
+# and these policies override the ones set by governance
+# (e.g. /cosmos.slashing.v1beta1.MsgUnjail=0;/cosmos.bank.v1beta1.MsgMultiSend=2).
+msg-fee-policies = "{{ .BaseConfig.MsgFeePolicies }}"
+
 # default: the last 100 states are kept in addition to every 500th state; pruning at 10 block intervals
 # nothing: all historic states will be saved, nothing will be deleted (i.e. archiving node)
 # everything: all saved states will be deleted, storing only the current and previous state; pruning at 10 block intervals
@@ -31,6 +38,12 @@ pruning-keep-recent = "{{ .BaseConfig.PruningKeepRecent }}"
 pruning-keep-every = "{{ .BaseConfig.PruningKeepEvery }}"
 pruning-interval = "{{ .BaseConfig.PruningInterval }}"
 
+# PruningBackground removes the pruned heights from disk one by one in the
+# background, at every pruning interval, so the commits are not slowed down by
+# the pruning of many heights at once. A commit waits at most for the removal of
+# a single height from a single store.
+pruning-background = {{ .BaseConfig.PruningBackground }}
+
 # HaltHeight contains a non-zero block height at which a node will gracefully
 # halt and shutdown that can be used to assist upgrades and testing.
 #
@@ -78,6 +91,17 @@ iavl-cache-size = {{ .BaseConfig.IAVLCacheSize }}
 # Default is true.
 iavl-disable-fastnode = {{ .BaseConfig.IAVLDisableFastNode }}
 
+# StateJournal records the state changes of each block in data/state_journal.db,
+# so queries at heights removed by pruning are served by replaying the changes
+# on top of the nearest retained height. Combine it with pruning-keep-every to
+# bound the number of heights to replay.
+state-journal = {{ .BaseConfig.StateJournal }}
+
+# StateJournalMaxReplay bounds the number of heights replayed from the state
+# journal to serve a query at a pruned height; queries of the heights further
+# from the nearest retained height fail. 0 disables the limit.
+state-journal-max-replay = {{ .BaseConfig.StateJournalMaxReplay }}
+
 ###############################################################################
 ###                         Telemetry Configuration                         ###
 ###############################################################################
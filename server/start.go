@@ -40,6 +40,7 @@ const (
 	flagTraceStore         = "trace-store"
 	flagCPUProfile         = "cpu-profile"
 	FlagMinGasPrices       = "minimum-gas-prices"
+	FlagMsgFeePolicies     = "msg-fee-policies"
 	FlagHaltHeight         = "halt-height"
 	FlagHaltTime           = "halt-time"
 	FlagInterBlockCache    = "inter-block-cache"
@@ -47,14 +48,17 @@ const (
 	FlagTrace              = "trace"
 	FlagInvCheckPeriod     = "inv-check-period"
 
-	FlagPruning           = "pruning"
-	FlagPruningKeepRecent = "pruning-keep-recent"
-	FlagPruningKeepEvery  = "pruning-keep-every"
-	FlagPruningInterval   = "pruning-interval"
-	FlagIndexEvents       = "index-events"
-	FlagMinRetainBlocks   = "min-retain-blocks"
-	FlagIAVLCacheSize     = "iavl-cache-size"
-	FlagIAVLFastNode      = "iavl-disable-fastnode"
+	FlagPruning               = "pruning"
+	FlagPruningKeepRecent     = "pruning-keep-recent"
+	FlagPruningKeepEvery      = "pruning-keep-every"
+	FlagPruningInterval       = "pruning-interval"
+	FlagPruningBackground     = "pruning-background"
+	FlagIndexEvents           = "index-events"
+	FlagMinRetainBlocks       = "min-retain-blocks"
+	FlagIAVLCacheSize         = "iavl-cache-size"
+	FlagIAVLFastNode          = "iavl-disable-fastnode"
+	FlagStateJournal          = "state-journal"
+	FlagStateJournalMaxReplay = "state-journal-max-replay"
 
 	// state sync-related flags
 	FlagStateSyncSnapshotInterval   = "state-sync.snapshot-interval"
@@ -142,6 +146,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().String(flagTransport, "socket", "Transport protocol: socket, grpc")
 	cmd.Flags().String(flagTraceStore, "", "Enable KVStore tracing to an output file")
 	cmd.Flags().String(FlagMinGasPrices, "", "Minimum gas prices to accept for transactions; Any fee in a tx must meet this minimum (e.g. 0.01photino;0.0001stake)")
+	cmd.Flags().String(FlagMsgFeePolicies, "", "Gas price factors of the message types, overriding the ones set by governance (e.g. /cosmos.slashing.v1beta1.MsgUnjail=0;/cosmos.bank.v1beta1.MsgMultiSend=2)")
 	cmd.Flags().IntSlice(FlagUnsafeSkipUpgrades, []int{}, "Skip a set of upgrade heights to continue the old binary")
 	cmd.Flags().Uint64(FlagHaltHeight, 0, "Block height at which to gracefully halt the chain and shutdown the node")
 	cmd.Flags().Uint64(FlagHaltTime, 0, "Minimum block time (in Unix seconds) at which to gracefully halt the chain and shutdown the node")
@@ -152,6 +157,7 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint64(FlagPruningKeepRecent, 0, "Number of recent heights to keep on disk (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningKeepEvery, 0, "Offset heights to keep on disk after 'keep-every' (ignored if pruning is not 'custom')")
 	cmd.Flags().Uint64(FlagPruningInterval, 0, "Height interval at which pruned heights are removed from disk (ignored if pruning is not 'custom')")
+	cmd.Flags().Bool(FlagPruningBackground, false, "Remove the pruned heights from disk in the background instead of during the commits")
 	cmd.Flags().Uint(FlagInvCheckPeriod, 0, "Assert registered invariants every N blocks")
 	cmd.Flags().Uint64(FlagMinRetainBlocks, 0, "Minimum block height offset during ABCI commit to prune Tendermint blocks")
 
@@ -166,6 +172,8 @@ is performed. Note, when enabled, gRPC will also be automatically enabled.
 	cmd.Flags().Uint32(FlagStateSyncSnapshotKeepRecent, 2, "State sync snapshot to keep")
 
 	cmd.Flags().Bool(FlagIAVLFastNode, true, "Enable fast node for IAVL tree")
+	cmd.Flags().Bool(FlagStateJournal, false, "Record the state changes of each block to query the pruned heights")
+	cmd.Flags().Uint64(FlagStateJournalMaxReplay, 1000, "Maximum number of heights replayed from the state journal to query a pruned height (0 for no limit)")
 
 	// add support for all Tendermint-specific command line options
 	tcmd.AddNodeFlags(cmd)
@@ -16,6 +16,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
+	"github.com/spf13/cast"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"github.com/spf13/viper"
@@ -27,6 +28,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/server/config"
 	"github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	"github.com/cosmos/cosmos-sdk/store/journal"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 )
@@ -384,6 +387,28 @@ func openDB(rootDir string) (dbm.DB, error) {
 	return sdk.NewLevelDB("application", dataDir)
 }
 
+// GetSnapshotStore opens the state sync snapshot store of the node, stored in the data/snapshots directory of its home
+func GetSnapshotStore(appOpts types.AppOptions) (*snapshots.Store, error) {
+	snapshotDir := filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data", "snapshots")
+	snapshotDB, err := sdk.NewLevelDB("metadata", snapshotDir)
+	if err != nil {
+		return nil, err
+	}
+	return snapshots.NewStore(snapshotDB, snapshotDir)
+}
+
+// GetStateJournal opens the state diff journal of the app, it returns nil if the journal is not enabled
+func GetStateJournal(appOpts types.AppOptions) (*journal.Journal, error) {
+	if !cast.ToBool(appOpts.Get(FlagStateJournal)) {
+		return nil, nil
+	}
+	journalDB, err := sdk.NewLevelDB("state_journal", filepath.Join(cast.ToString(appOpts.Get(flags.FlagHome)), "data"))
+	if err != nil {
+		return nil, err
+	}
+	return journal.NewJournal(journalDB), nil
+}
+
 func openTraceWriter(traceWriterFile string) (w io.Writer, err error) {
 	if traceWriterFile == "" {
 		return
@@ -22,6 +22,10 @@ const (
 	DefaultWeightTextProposal           int = 5
 	DefaultWeightParamChangeProposal    int = 5
 
+	// mint
+	DefaultWeightUpdateMunicipalInflationProposal int = 5
+	DefaultWeightRemoveMunicipalInflationProposal int = 5
+
 	// feegrant
 	DefaultWeightGrantAllowance  int = 100
 	DefaultWeightRevokeAllowance int = 100
@@ -0,0 +1,116 @@
+package simapp
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	evidencetypes "github.com/cosmos/cosmos-sdk/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/x/feegrant"
+	feemarkettypes "github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	upgradetypes "github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// StreamingKeyLayouts returns the key layouts of the stores of the SimApp modules, used by the streaming services
+// to decode the keys of the state changes. Keys of indexes ordered by power or time are not decoded.
+func StreamingKeyLayouts() file.KeyLayoutRegistry {
+	return file.KeyLayoutRegistry{
+		authtypes.StoreKey: {
+			{Name: "account", Prefix: authtypes.AddressStoreKeyPrefix, Segments: []file.KeySegment{{Name: "address", Kind: file.KeyRawAccAddress}}},
+			{Name: "unordered_nonce", Prefix: authtypes.UnorderedNonceKeyPrefix, Segments: []file.KeySegment{uint64Segment("timeout_height"), accAddressSegment("address"), uint64Segment("nonce")}},
+			{Name: "global_account_number", Prefix: authtypes.GlobalAccountNumberKey},
+		},
+		banktypes.StoreKey: {
+			{Name: "supply", Prefix: banktypes.SupplyKey, Segments: []file.KeySegment{stringSegment("denom")}},
+			{Name: "denom_metadata", Prefix: banktypes.DenomMetadataPrefix, Segments: []file.KeySegment{stringSegment("denom")}},
+			{Name: "balance", Prefix: banktypes.BalancesPrefix, Segments: []file.KeySegment{accAddressSegment("address"), stringSegment("denom")}},
+		},
+		stakingtypes.StoreKey: {
+			{Name: "last_validator_power", Prefix: stakingtypes.LastValidatorPowerKey, Segments: []file.KeySegment{valAddressSegment("validator")}},
+			{Name: "last_total_power", Prefix: stakingtypes.LastTotalPowerKey},
+			{Name: "validator", Prefix: stakingtypes.ValidatorsKey, Segments: []file.KeySegment{valAddressSegment("validator")}},
+			{Name: "validator_by_cons_addr", Prefix: stakingtypes.ValidatorsByConsAddrKey, Segments: []file.KeySegment{consAddressSegment("cons_address")}},
+			{Name: "delegation", Prefix: stakingtypes.DelegationKey, Segments: []file.KeySegment{accAddressSegment("delegator"), valAddressSegment("validator")}},
+			{Name: "unbonding_delegation", Prefix: stakingtypes.UnbondingDelegationKey, Segments: []file.KeySegment{accAddressSegment("delegator"), valAddressSegment("validator")}},
+			{Name: "unbonding_delegation_by_validator", Prefix: stakingtypes.UnbondingDelegationByValIndexKey, Segments: []file.KeySegment{valAddressSegment("validator"), accAddressSegment("delegator")}},
+			{Name: "redelegation", Prefix: stakingtypes.RedelegationKey, Segments: []file.KeySegment{accAddressSegment("delegator"), valAddressSegment("src_validator"), valAddressSegment("dst_validator")}},
+			{Name: "redelegation_by_src_validator", Prefix: stakingtypes.RedelegationByValSrcIndexKey, Segments: []file.KeySegment{valAddressSegment("src_validator"), accAddressSegment("delegator"), valAddressSegment("dst_validator")}},
+			{Name: "redelegation_by_dst_validator", Prefix: stakingtypes.RedelegationByValDstIndexKey, Segments: []file.KeySegment{valAddressSegment("dst_validator"), accAddressSegment("delegator"), valAddressSegment("src_validator")}},
+			{Name: "historical_info", Prefix: stakingtypes.HistoricalInfoKey, Segments: []file.KeySegment{stringSegment("height")}},
+		},
+		minttypes.StoreKey: {
+			{Name: "minter", Prefix: minttypes.MinterKey},
+			{Name: "minted_total", Prefix: minttypes.MintedTotalKeyPrefix, Segments: []file.KeySegment{stringSegment("denom")}},
+			{Name: "recipient_minted", Prefix: minttypes.RecipientMintedKeyPrefix, Segments: []file.KeySegment{{Name: "recipient", Kind: file.KeyLengthPrefixedString}, stringSegment("denom")}},
+			{Name: "mint_checkpoint", Prefix: minttypes.MintCheckpointKeyPrefix, Segments: []file.KeySegment{uint64Segment("height")}},
+			{Name: "block_time_observation", Prefix: minttypes.BlockTimeObservationKey},
+			{Name: "burned_total", Prefix: minttypes.BurnedTotalKeyPrefix, Segments: []file.KeySegment{stringSegment("denom")}},
+		},
+		distrtypes.StoreKey: {
+			{Name: "fee_pool", Prefix: distrtypes.FeePoolKey},
+			{Name: "proposer", Prefix: distrtypes.ProposerKey},
+			{Name: "validator_outstanding_rewards", Prefix: distrtypes.ValidatorOutstandingRewardsPrefix, Segments: []file.KeySegment{valAddressSegment("validator")}},
+			{Name: "delegator_withdraw_address", Prefix: distrtypes.DelegatorWithdrawAddrPrefix, Segments: []file.KeySegment{accAddressSegment("delegator")}},
+			{Name: "delegator_starting_info", Prefix: distrtypes.DelegatorStartingInfoPrefix, Segments: []file.KeySegment{valAddressSegment("validator"), accAddressSegment("delegator")}},
+			{Name: "validator_historical_rewards", Prefix: distrtypes.ValidatorHistoricalRewardsPrefix, Segments: []file.KeySegment{valAddressSegment("validator"), {Name: "period", Kind: file.KeyUint64LittleEndian}}},
+			{Name: "validator_current_rewards", Prefix: distrtypes.ValidatorCurrentRewardsPrefix, Segments: []file.KeySegment{valAddressSegment("validator")}},
+			{Name: "validator_accumulated_commission", Prefix: distrtypes.ValidatorAccumulatedCommissionPrefix, Segments: []file.KeySegment{valAddressSegment("validator")}},
+			{Name: "validator_slash_event", Prefix: distrtypes.ValidatorSlashEventPrefix, Segments: []file.KeySegment{valAddressSegment("validator"), uint64Segment("height"), uint64Segment("period")}},
+		},
+		slashingtypes.StoreKey: {
+			{Name: "validator_signing_info", Prefix: slashingtypes.ValidatorSigningInfoKeyPrefix, Segments: []file.KeySegment{consAddressSegment("cons_address")}},
+			{Name: "validator_missed_block", Prefix: slashingtypes.ValidatorMissedBlockBitArrayKeyPrefix, Segments: []file.KeySegment{consAddressSegment("cons_address"), {Name: "index", Kind: file.KeyUint64LittleEndian}}},
+			{Name: "address_pubkey_relation", Prefix: slashingtypes.AddrPubkeyRelationKeyPrefix, Segments: []file.KeySegment{consAddressSegment("cons_address")}},
+		},
+		govtypes.StoreKey: {
+			{Name: "proposal", Prefix: govtypes.ProposalsKeyPrefix, Segments: []file.KeySegment{uint64Segment("proposal_id")}},
+			{Name: "proposal_id", Prefix: govtypes.ProposalIDKey},
+			{Name: "deposit", Prefix: govtypes.DepositsKeyPrefix, Segments: []file.KeySegment{uint64Segment("proposal_id"), accAddressSegment("depositor")}},
+			{Name: "vote", Prefix: govtypes.VotesKeyPrefix, Segments: []file.KeySegment{uint64Segment("proposal_id"), accAddressSegment("voter")}},
+		},
+		upgradetypes.StoreKey: {
+			{Name: "plan", Prefix: upgradetypes.PlanKey()},
+			{Name: "done", Prefix: []byte{upgradetypes.DoneByte}, Segments: []file.KeySegment{stringSegment("name")}},
+			{Name: "module_version", Prefix: []byte{upgradetypes.VersionMapByte}, Segments: []file.KeySegment{stringSegment("module")}},
+			{Name: "protocol_version", Prefix: []byte{upgradetypes.ProtocolVersionByte}},
+		},
+		feegrant.StoreKey: {
+			{Name: "fee_allowance", Prefix: feegrant.FeeAllowanceKeyPrefix, Segments: []file.KeySegment{accAddressSegment("grantee"), accAddressSegment("granter")}},
+		},
+		feemarkettypes.StoreKey: {
+			{Name: "base_gas_price", Prefix: feemarkettypes.BaseGasPriceKey},
+			{Name: "last_block_gas", Prefix: feemarkettypes.LastBlockGasKey},
+		},
+		evidencetypes.StoreKey: {
+			{Name: "evidence", Prefix: evidencetypes.KeyPrefixEvidence, Segments: []file.KeySegment{{Name: "hash", Kind: file.KeyBytes}}},
+		},
+		authzkeeper.StoreKey: {
+			{Name: "grant", Prefix: authzkeeper.GrantKey, Segments: []file.KeySegment{accAddressSegment("granter"), accAddressSegment("grantee"), stringSegment("msg_type_url")}},
+		},
+	}
+}
+
+func accAddressSegment(name string) file.KeySegment {
+	return file.KeySegment{Name: name, Kind: file.KeyAccAddress}
+}
+
+func valAddressSegment(name string) file.KeySegment {
+	return file.KeySegment{Name: name, Kind: file.KeyValAddress}
+}
+
+func consAddressSegment(name string) file.KeySegment {
+	return file.KeySegment{Name: name, Kind: file.KeyConsAddress}
+}
+
+func uint64Segment(name string) file.KeySegment {
+	return file.KeySegment{Name: name, Kind: file.KeyUint64}
+}
+
+func stringSegment(name string) file.KeySegment {
+	return file.KeySegment{Name: name, Kind: file.KeyString}
+}
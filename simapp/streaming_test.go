@@ -0,0 +1,44 @@
+package simapp
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestStreamingKeyLayouts(t *testing.T) {
+	layouts := StreamingKeyLayouts()
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	valAddr := sdk.ValAddress([]byte("val1________________"))
+	consAddr := sdk.ConsAddress([]byte("cons1_______________"))
+
+	testCases := []struct {
+		store    string
+		key      []byte
+		expected map[string]string
+	}{
+		{authtypes.StoreKey, authtypes.AddressStoreKey(addr), map[string]string{"type": "account", "address": addr.String()}},
+		{banktypes.StoreKey, append(banktypes.CreateAccountBalancesPrefix(addr), "uatom"...), map[string]string{"type": "balance", "address": addr.String(), "denom": "uatom"}},
+		{stakingtypes.StoreKey, stakingtypes.GetDelegationKey(addr, valAddr), map[string]string{"type": "delegation", "delegator": addr.String(), "validator": valAddr.String()}},
+		{distrtypes.StoreKey, distrtypes.GetValidatorHistoricalRewardsKey(valAddr, 3), map[string]string{"type": "validator_historical_rewards", "validator": valAddr.String(), "period": "3"}},
+		{slashingtypes.StoreKey, slashingtypes.ValidatorMissedBlockBitArrayKey(consAddr, 5), map[string]string{"type": "validator_missed_block", "cons_address": consAddr.String(), "index": "5"}},
+		{govtypes.StoreKey, govtypes.VoteKey(7, addr), map[string]string{"type": "vote", "proposal_id": "7", "voter": addr.String()}},
+		{minttypes.StoreKey, append(minttypes.RecipientMintedKey("community_pool"), "uatom"...), map[string]string{"type": "recipient_minted", "recipient": "community_pool", "denom": "uatom"}},
+	}
+
+	for _, tc := range testCases {
+		decoded, ok := file.DecodeKey(layouts[tc.store], tc.key)
+		require.True(t, ok, tc.expected["type"])
+		require.Equal(t, tc.expected, decoded)
+	}
+}
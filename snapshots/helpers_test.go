@@ -143,6 +143,24 @@ func (m *mockSnapshotter) SupportedFormats() []uint32 {
 	return []uint32{1}
 }
 
+// mockVerifiableSnapshotter is a mockSnapshotter reporting store hashes, which records the store hashes it restores with
+type mockVerifiableSnapshotter struct {
+	mockSnapshotter
+	storeHashes         []types.SnapshotStoreHash
+	restoredStoreHashes []types.SnapshotStoreHash
+}
+
+func (m *mockVerifiableSnapshotter) SnapshotStoreHashes(height uint64) ([]types.SnapshotStoreHash, error) {
+	return m.storeHashes, nil
+}
+
+func (m *mockVerifiableSnapshotter) RestoreVerified(
+	height uint64, format uint32, protoReader protoio.Reader, storeHashes []types.SnapshotStoreHash,
+) (snapshottypes.SnapshotItem, error) {
+	m.restoredStoreHashes = storeHashes
+	return m.Restore(height, format, protoReader)
+}
+
 // setupBusyManager creates a manager with an empty store that is busy creating a snapshot at height 1.
 // The snapshot will complete when the returned closer is called.
 func setupBusyManager(t *testing.T) *snapshots.Manager {
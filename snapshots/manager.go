@@ -10,6 +10,8 @@ import (
 	"sync"
 
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
@@ -161,11 +163,25 @@ func (m *Manager) Create(height uint64) (*types.Snapshot, error) {
 			"a more recent snapshot already exists at height %v", latest.Height)
 	}
 
+	// The store hashes are included in the metadata, so the stores can be verified one by one when restored
+	var storeHashes []types.SnapshotStoreHash
+	if verifiable, ok := m.multistore.(types.VerifiableSnapshotter); ok {
+		storeHashes, err = verifiable.SnapshotStoreHashes(height)
+		if err != nil {
+			return nil, sdkerrors.Wrap(err, "failed to load store hashes")
+		}
+	}
+
 	// Spawn goroutine to generate snapshot chunks and pass their io.ReadClosers through a channel
 	ch := make(chan io.ReadCloser)
 	go m.createSnapshot(height, ch)
 
-	return m.store.Save(height, types.CurrentFormat, ch)
+	snapshot, err := m.store.Save(height, types.CurrentFormat, ch)
+	if err != nil || len(storeHashes) == 0 {
+		return snapshot, err
+	}
+	snapshot.Metadata.StoreHashes = storeHashes
+	return snapshot, m.store.saveSnapshot(snapshot)
 }
 
 // createSnapshot do the heavy work of snapshotting after the validations of request are done
@@ -232,6 +248,31 @@ func (m *Manager) Prune(retain uint32) (uint64, error) {
 	return m.store.Prune(retain)
 }
 
+// RestoreWithAppHash begins an async snapshot restoration like Restore. If the snapshot metadata contains the
+// store hashes, they are verified against the trusted app hash of the snapshot height before the restoration
+// begins, so that each store can be trusted as soon as it is restored.
+func (m *Manager) RestoreWithAppHash(snapshot types.Snapshot, appHash []byte) error {
+	if len(snapshot.Metadata.StoreHashes) > 0 && len(appHash) > 0 {
+		if hash := storeHashesRoot(snapshot.Metadata.StoreHashes); !bytes.Equal(hash, appHash) {
+			return sdkerrors.Wrapf(types.ErrInvalidMetadata,
+				"store hashes do not match app hash: expected %X, got %X", appHash, hash)
+		}
+	}
+	return m.Restore(snapshot)
+}
+
+// storeHashesRoot returns the root hash of the commit info made of the store hashes, i.e. the app hash
+func storeHashesRoot(storeHashes []types.SnapshotStoreHash) []byte {
+	storeInfos := make([]storetypes.StoreInfo, 0, len(storeHashes))
+	for _, storeHash := range storeHashes {
+		storeInfos = append(storeInfos, storetypes.StoreInfo{
+			Name:     storeHash.Name,
+			CommitId: storetypes.CommitID{Hash: storeHash.Hash},
+		})
+	}
+	return storetypes.CommitInfo{StoreInfos: storeInfos}.Hash()
+}
+
 // Restore begins an async snapshot restoration, mirroring ABCI OfferSnapshot. Chunks must be fed
 // via RestoreChunk() until the restore is complete or a chunk fails.
 func (m *Manager) Restore(snapshot types.Snapshot) error {
@@ -280,6 +321,9 @@ func (m *Manager) Restore(snapshot types.Snapshot) error {
 	m.chRestoreDone = chDone
 	m.restoreChunkHashes = snapshot.Metadata.ChunkHashes
 	m.restoreChunkIndex = 0
+	telemetry.SetGauge(float32(snapshot.Height), "snapshot", "restore", "height")
+	telemetry.SetGauge(float32(snapshot.Chunks), "snapshot", "restore", "chunks_total")
+	telemetry.SetGauge(0, "snapshot", "restore", "chunks")
 	return nil
 }
 
@@ -291,7 +335,14 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 	}
 	defer streamReader.Close()
 
-	next, err := m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	// The stores are verified as soon as they are restored if the snapshot contains their hashes
+	var next types.SnapshotItem
+	verifiable, ok := m.multistore.(types.VerifiableSnapshotter)
+	if ok && len(snapshot.Metadata.StoreHashes) > 0 {
+		next, err = verifiable.RestoreVerified(snapshot.Height, snapshot.Format, streamReader, snapshot.Metadata.StoreHashes)
+	} else {
+		next, err = m.multistore.Restore(snapshot.Height, snapshot.Format, streamReader)
+	}
 	if err != nil {
 		return sdkerrors.Wrap(err, "multistore restore")
 	}
@@ -319,6 +370,34 @@ func (m *Manager) restoreSnapshot(snapshot types.Snapshot, chChunks <-chan io.Re
 	return nil
 }
 
+// RestoreLocalSnapshot restores the app state from a snapshot of the local snapshot store, without Tendermint. It
+// blocks until the restore is complete. The stores are verified against the store hashes of the snapshot metadata,
+// if it contains them.
+func (m *Manager) RestoreLocalSnapshot(height uint64, format uint32) error {
+	snapshot, chChunks, err := m.store.Load(height, format)
+	if err != nil {
+		return err
+	}
+	if snapshot == nil {
+		return sdkerrors.Wrapf(sdkerrors.ErrNotFound, "snapshot at height %v format %v", height, format)
+	}
+	defer DrainChunks(chChunks)
+
+	if snapshot.Format != types.CurrentFormat {
+		return sdkerrors.Wrapf(types.ErrUnknownFormat, "snapshot format %v", snapshot.Format)
+	}
+
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	err = m.beginLocked(opRestore)
+	if err != nil {
+		return err
+	}
+	defer m.endLocked()
+
+	return m.restoreSnapshot(*snapshot, chChunks)
+}
+
 // RestoreChunk adds a chunk to an active snapshot restoration, mirroring ABCI ApplySnapshotChunk.
 // Chunks must be given until the restore is complete, returning true, or a chunk errors.
 func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
@@ -354,6 +433,7 @@ func (m *Manager) RestoreChunk(chunk []byte) (bool, error) {
 	// Pass the chunk to the restore, and wait for completion if it was the final one.
 	m.chRestore <- io.NopCloser(bytes.NewReader(chunk))
 	m.restoreChunkIndex++
+	telemetry.SetGauge(float32(m.restoreChunkIndex), "snapshot", "restore", "chunks")
 
 	if int(m.restoreChunkIndex) >= len(m.restoreChunkHashes) {
 		close(m.chRestore)
@@ -9,6 +9,8 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/snapshots"
 	"github.com/cosmos/cosmos-sdk/snapshots/types"
+	storetypes "github.com/cosmos/cosmos-sdk/store/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 func TestManager_List(t *testing.T) {
@@ -210,3 +212,77 @@ func TestManager_Restore(t *testing.T) {
 	})
 	require.NoError(t, err)
 }
+
+func TestManager_RestoreVerified(t *testing.T) {
+	storeHashes := []types.SnapshotStoreHash{
+		{Name: "bank", Hash: []byte{1, 2, 3}},
+		{Name: "mem", Hash: nil},
+		{Name: "staking", Hash: []byte{4, 5, 6}},
+	}
+	appHash := storetypes.CommitInfo{StoreInfos: []storetypes.StoreInfo{
+		{Name: "bank", CommitId: storetypes.CommitID{Hash: []byte{1, 2, 3}}},
+		{Name: "mem"},
+		{Name: "staking", CommitId: storetypes.CommitID{Hash: []byte{4, 5, 6}}},
+	}}.Hash()
+
+	// the store hashes are included in the snapshot metadata
+	source := &mockVerifiableSnapshotter{
+		mockSnapshotter: mockSnapshotter{items: [][]byte{{1, 2, 3}, {4, 5, 6}}},
+		storeHashes:     storeHashes,
+	}
+	store := setupStore(t)
+	snapshot, err := snapshots.NewManager(store, source).Create(5)
+	require.NoError(t, err)
+	require.Equal(t, storeHashes, snapshot.Metadata.StoreHashes)
+	saved, err := store.Get(5, snapshot.Format)
+	require.NoError(t, err)
+	require.Equal(t, snapshot, saved)
+
+	target := &mockVerifiableSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	// the store hashes must match the app hash
+	err = manager.RestoreWithAppHash(*snapshot, []byte{9, 9, 9})
+	require.ErrorIs(t, err, types.ErrInvalidMetadata)
+
+	err = manager.RestoreWithAppHash(*snapshot, appHash)
+	require.NoError(t, err)
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := manager.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		done, err := manager.RestoreChunk(chunk)
+		require.NoError(t, err)
+		assert.Equal(t, i == snapshot.Chunks-1, done)
+	}
+
+	// the stores are verified against the hashes while they are restored
+	assert.Equal(t, source.items, target.items)
+	assert.Equal(t, storeHashes, target.restoredStoreHashes)
+}
+
+func TestManager_RestoreLocalSnapshot(t *testing.T) {
+	store := setupStore(t)
+	items := [][]byte{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}
+	snapshot, err := snapshots.NewManager(store, &mockSnapshotter{items: items}).Create(5)
+	require.NoError(t, err)
+
+	target := &mockSnapshotter{}
+	manager := snapshots.NewManager(store, target)
+
+	// Restoring a missing snapshot errors
+	err = manager.RestoreLocalSnapshot(6, snapshot.Format)
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+
+	// Restoring a snapshot of an unknown format errors
+	err = manager.RestoreLocalSnapshot(3, 2)
+	require.ErrorIs(t, err, types.ErrUnknownFormat)
+
+	// Restoring the snapshot from the store completes synchronously
+	err = manager.RestoreLocalSnapshot(snapshot.Height, snapshot.Format)
+	require.NoError(t, err)
+	assert.Equal(t, items, target.items)
+
+	// Other operations can run once the restore is done
+	_, err = manager.Prune(1)
+	require.NoError(t, err)
+}
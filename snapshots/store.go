@@ -1,6 +1,7 @@
 package snapshots
 
 import (
+	"bytes"
 	"crypto/sha256"
 	"encoding/binary"
 	"io"
@@ -293,6 +294,31 @@ func (s *Store) Save(
 	return snapshot, s.saveSnapshot(snapshot)
 }
 
+// Import saves a snapshot taken by another node, e.g. read from an archive. The chunks are verified against the
+// hashes of the snapshot metadata, and the snapshot is deleted if they do not match.
+func (s *Store) Import(snapshot *types.Snapshot, chunks <-chan io.ReadCloser) (*types.Snapshot, error) {
+	saved, err := s.Save(snapshot.Height, snapshot.Format, chunks)
+	if err != nil {
+		return nil, err
+	}
+
+	valid := saved.Chunks == snapshot.Chunks && bytes.Equal(saved.Hash, snapshot.Hash) &&
+		len(saved.Metadata.ChunkHashes) == len(snapshot.Metadata.ChunkHashes)
+	for i := 0; valid && i < len(saved.Metadata.ChunkHashes); i++ {
+		valid = bytes.Equal(saved.Metadata.ChunkHashes[i], snapshot.Metadata.ChunkHashes[i])
+	}
+	if !valid {
+		if err := s.Delete(saved.Height, saved.Format); err != nil {
+			return nil, err
+		}
+		return nil, sdkerrors.Wrapf(types.ErrChunkHashMismatch,
+			"imported snapshot at height %v format %v does not match its metadata", snapshot.Height, snapshot.Format)
+	}
+
+	saved.Metadata.StoreHashes = snapshot.Metadata.StoreHashes
+	return saved, s.saveSnapshot(saved)
+}
+
 // saveSnapshot saves snapshot metadata to the database.
 func (s *Store) saveSnapshot(snapshot *types.Snapshot) error {
 	value, err := proto.Marshal(snapshot)
@@ -341,3 +341,35 @@ func TestStore_Save(t *testing.T) {
 	require.NoError(t, err)
 	close(ch)
 }
+
+func TestStore_Import(t *testing.T) {
+	store := setupStore(t)
+	chunks := [][]byte{{4, 1, 0}, {4, 1, 1}}
+	snapshot := &types.Snapshot{
+		Height: 4,
+		Format: 1,
+		Chunks: 2,
+		Hash:   hash(chunks),
+		Metadata: types.Metadata{
+			ChunkHashes: checksums(chunks),
+			StoreHashes: []types.SnapshotStoreHash{{Name: "bank", Hash: []byte{1, 2, 3}}},
+		},
+	}
+
+	// Importing a snapshot matching its metadata should save it along with the store hashes
+	imported, err := store.Import(snapshot, makeChunks(chunks))
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, imported)
+	loaded, err := store.Get(4, 1)
+	require.NoError(t, err)
+	assert.Equal(t, snapshot, loaded)
+
+	// Importing a snapshot whose chunks don't match its metadata should error and not keep it
+	snapshot.Height = 5
+	_, err = store.Import(snapshot, makeChunks([][]byte{{5, 1, 0}, {5, 1, 1}}))
+	require.Error(t, err)
+	require.True(t, errors.Is(err, types.ErrChunkHashMismatch))
+	loaded, err = store.Get(5, 1)
+	require.NoError(t, err)
+	assert.Nil(t, loaded)
+}
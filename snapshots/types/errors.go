@@ -13,4 +13,7 @@ var (
 
 	// ErrInvalidMetadata is returned when the snapshot metadata is invalid.
 	ErrInvalidMetadata = errors.New("invalid snapshot metadata")
+
+	// ErrStoreHashMismatch is returned when the root hash of a restored store does not match the snapshot metadata.
+	ErrStoreHashMismatch = errors.New("store hash verification failed")
 )
@@ -103,6 +103,9 @@ func (m *Snapshot) GetMetadata() Metadata {
 // Metadata contains SDK-specific snapshot metadata.
 type Metadata struct {
 	ChunkHashes [][]byte `protobuf:"bytes,1,rep,name=chunk_hashes,json=chunkHashes,proto3" json:"chunk_hashes,omitempty"`
+	// store_hashes contains the root hashes of the stores committed at the snapshot height, each restored store is
+	// verified against them as soon as it is imported.
+	StoreHashes []SnapshotStoreHash `protobuf:"bytes,2,rep,name=store_hashes,json=storeHashes,proto3" json:"store_hashes"`
 }
 
 func (m *Metadata) Reset()         { *m = Metadata{} }
@@ -145,6 +148,66 @@ func (m *Metadata) GetChunkHashes() [][]byte {
 	return nil
 }
 
+func (m *Metadata) GetStoreHashes() []SnapshotStoreHash {
+	if m != nil {
+		return m.StoreHashes
+	}
+	return nil
+}
+
+// SnapshotStoreHash contains the root hash of a store at the snapshot height.
+type SnapshotStoreHash struct {
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Hash []byte `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+}
+
+func (m *SnapshotStoreHash) Reset()         { *m = SnapshotStoreHash{} }
+func (m *SnapshotStoreHash) String() string { return proto.CompactTextString(m) }
+func (*SnapshotStoreHash) ProtoMessage()    {}
+func (*SnapshotStoreHash) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+}
+func (m *SnapshotStoreHash) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SnapshotStoreHash) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SnapshotStoreHash.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SnapshotStoreHash) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SnapshotStoreHash.Merge(m, src)
+}
+func (m *SnapshotStoreHash) XXX_Size() int {
+	return m.Size()
+}
+func (m *SnapshotStoreHash) XXX_DiscardUnknown() {
+	xxx_messageInfo_SnapshotStoreHash.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SnapshotStoreHash proto.InternalMessageInfo
+
+func (m *SnapshotStoreHash) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SnapshotStoreHash) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 // SnapshotItem is an item contained in a rootmulti.Store snapshot.
 type SnapshotItem struct {
 	// item is the specific type of snapshot item.
@@ -161,7 +224,7 @@ func (m *SnapshotItem) Reset()         { *m = SnapshotItem{} }
 func (m *SnapshotItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotItem) ProtoMessage()    {}
 func (*SnapshotItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{2}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
 }
 func (m *SnapshotItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -268,7 +331,7 @@ func (m *SnapshotStoreItem) Reset()         { *m = SnapshotStoreItem{} }
 func (m *SnapshotStoreItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotStoreItem) ProtoMessage()    {}
 func (*SnapshotStoreItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{3}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
 }
 func (m *SnapshotStoreItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -318,7 +381,7 @@ func (m *SnapshotIAVLItem) Reset()         { *m = SnapshotIAVLItem{} }
 func (m *SnapshotIAVLItem) String() string { return proto.CompactTextString(m) }
 func (*SnapshotIAVLItem) ProtoMessage()    {}
 func (*SnapshotIAVLItem) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{4}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
 }
 func (m *SnapshotIAVLItem) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -385,7 +448,7 @@ func (m *SnapshotExtensionMeta) Reset()         { *m = SnapshotExtensionMeta{} }
 func (m *SnapshotExtensionMeta) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionMeta) ProtoMessage()    {}
 func (*SnapshotExtensionMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{5}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
 }
 func (m *SnapshotExtensionMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -437,7 +500,7 @@ func (m *SnapshotExtensionPayload) Reset()         { *m = SnapshotExtensionPaylo
 func (m *SnapshotExtensionPayload) String() string { return proto.CompactTextString(m) }
 func (*SnapshotExtensionPayload) ProtoMessage()    {}
 func (*SnapshotExtensionPayload) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dd7a3c9b0a19e1ee, []int{6}
+	return fileDescriptor_dd7a3c9b0a19e1ee, []int{7}
 }
 func (m *SnapshotExtensionPayload) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -476,6 +539,7 @@ func (m *SnapshotExtensionPayload) GetPayload() []byte {
 func init() {
 	proto.RegisterType((*Snapshot)(nil), "cosmos.base.snapshots.v1beta1.Snapshot")
 	proto.RegisterType((*Metadata)(nil), "cosmos.base.snapshots.v1beta1.Metadata")
+	proto.RegisterType((*SnapshotStoreHash)(nil), "cosmos.base.snapshots.v1beta1.SnapshotStoreHash")
 	proto.RegisterType((*SnapshotItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotItem")
 	proto.RegisterType((*SnapshotStoreItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotStoreItem")
 	proto.RegisterType((*SnapshotIAVLItem)(nil), "cosmos.base.snapshots.v1beta1.SnapshotIAVLItem")
@@ -488,40 +552,42 @@ func init() {
 }
 
 var fileDescriptor_dd7a3c9b0a19e1ee = []byte{
-	// 513 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x53, 0xd1, 0x6e, 0xd3, 0x30,
-	0x14, 0x8d, 0xd7, 0xb4, 0x74, 0x37, 0x41, 0xea, 0xac, 0x81, 0x22, 0x24, 0xb2, 0x92, 0x97, 0xf5,
-	0x61, 0x4b, 0x58, 0x99, 0xc4, 0x33, 0x45, 0xa0, 0x54, 0x02, 0x81, 0x3c, 0xc4, 0x03, 0x2f, 0x93,
-	0xdb, 0x7a, 0x4d, 0xd4, 0x26, 0xae, 0x6a, 0xb7, 0xa2, 0x7f, 0xc1, 0xaf, 0xf0, 0x17, 0x7b, 0xdc,
-	0x23, 0x4f, 0x13, 0x6a, 0x3f, 0x80, 0x5f, 0x40, 0xb6, 0x93, 0x30, 0x8d, 0x0d, 0xb6, 0xa7, 0xde,
-	0x73, 0x7a, 0xee, 0xf1, 0xf5, 0xc9, 0x35, 0x1c, 0x0c, 0xb9, 0xc8, 0xb8, 0x88, 0x06, 0x54, 0xb0,
-	0x48, 0xe4, 0x74, 0x26, 0x12, 0x2e, 0x45, 0xb4, 0x3c, 0x1a, 0x30, 0x49, 0x8f, 0x2a, 0x26, 0x9c,
-	0xcd, 0xb9, 0xe4, 0xf8, 0xa9, 0x51, 0x87, 0x4a, 0x1d, 0x56, 0xea, 0xb0, 0x50, 0x3f, 0xd9, 0x1d,
-	0xf3, 0x31, 0xd7, 0xca, 0x48, 0x55, 0xa6, 0x29, 0xf8, 0x8e, 0xa0, 0x79, 0x52, 0x68, 0xf1, 0x63,
-	0x68, 0x24, 0x2c, 0x1d, 0x27, 0xd2, 0x43, 0x6d, 0xd4, 0xb1, 0x49, 0x81, 0x14, 0x7f, 0xc6, 0xe7,
-	0x19, 0x95, 0xde, 0x56, 0x1b, 0x75, 0x1e, 0x92, 0x02, 0x29, 0x7e, 0x98, 0x2c, 0xf2, 0x89, 0xf0,
-	0x6a, 0x86, 0x37, 0x08, 0x63, 0xb0, 0x13, 0x2a, 0x12, 0xcf, 0x6e, 0xa3, 0x8e, 0x4b, 0x74, 0x8d,
-	0xfb, 0xd0, 0xcc, 0x98, 0xa4, 0x23, 0x2a, 0xa9, 0x57, 0x6f, 0xa3, 0x8e, 0xd3, 0xdd, 0x0f, 0xff,
-	0x39, 0x70, 0xf8, 0xbe, 0x90, 0xf7, 0xec, 0xf3, 0xcb, 0x3d, 0x8b, 0x54, 0xed, 0xc1, 0x21, 0x34,
-	0xcb, 0xff, 0xf0, 0x33, 0x70, 0xf5, 0xa1, 0xa7, 0xea, 0x10, 0x26, 0x3c, 0xd4, 0xae, 0x75, 0x5c,
-	0xe2, 0x68, 0x2e, 0xd6, 0x54, 0xf0, 0x6b, 0x0b, 0xdc, 0xf2, 0x8a, 0x7d, 0xc9, 0x32, 0x1c, 0x43,
-	0x5d, 0x48, 0x3e, 0x67, 0xfa, 0x96, 0x4e, 0xf7, 0xf9, 0x7f, 0xe6, 0x28, 0x7b, 0x4f, 0x54, 0x8f,
-	0x32, 0x88, 0x2d, 0x62, 0x0c, 0xf0, 0x07, 0xb0, 0x53, 0xba, 0x9c, 0xea, 0x58, 0x9c, 0x6e, 0x74,
-	0x47, 0xa3, 0xfe, 0xab, 0xcf, 0xef, 0x94, 0x4f, 0xaf, 0xb9, 0xbe, 0xdc, 0xb3, 0x15, 0x8a, 0x2d,
-	0xa2, 0x8d, 0xf0, 0x27, 0xd8, 0x66, 0x5f, 0x25, 0xcb, 0x45, 0xca, 0x73, 0x1d, 0xaa, 0xd3, 0x3d,
-	0xbe, 0xa3, 0xeb, 0x9b, 0xb2, 0x4f, 0x65, 0x13, 0x5b, 0xe4, 0x8f, 0x11, 0x3e, 0x83, 0x9d, 0x0a,
-	0x9c, 0xce, 0xe8, 0x6a, 0xca, 0xe9, 0x48, 0x7f, 0x1c, 0xa7, 0xfb, 0xf2, 0xbe, 0xee, 0x1f, 0x4d,
-	0x7b, 0x6c, 0x91, 0x16, 0xbb, 0xc6, 0xf5, 0x1a, 0x60, 0xa7, 0x92, 0x65, 0xc1, 0x3e, 0xec, 0xfc,
-	0x15, 0x9a, 0x5a, 0x8a, 0x9c, 0x66, 0x26, 0xf4, 0x6d, 0xa2, 0xeb, 0x60, 0x0a, 0xad, 0xeb, 0xa1,
-	0xe0, 0x16, 0xd4, 0x26, 0x6c, 0xa5, 0x65, 0x2e, 0x51, 0x25, 0xde, 0x85, 0xfa, 0x92, 0x4e, 0x17,
-	0x4c, 0xc7, 0xec, 0x12, 0x03, 0xb0, 0x07, 0x0f, 0x96, 0x6c, 0x5e, 0x05, 0x55, 0x23, 0x25, 0xbc,
-	0xb2, 0xc6, 0xea, 0x8e, 0xf5, 0x72, 0x8d, 0x83, 0xd7, 0xf0, 0xe8, 0xc6, 0xb0, 0x6e, 0x1a, 0xed,
-	0xb6, 0x9d, 0x0f, 0x8e, 0xc1, 0xbb, 0x2d, 0x13, 0x35, 0x52, 0x99, 0xae, 0x19, 0xbf, 0x84, 0xbd,
-	0xb7, 0xe7, 0x6b, 0x1f, 0x5d, 0xac, 0x7d, 0xf4, 0x73, 0xed, 0xa3, 0x6f, 0x1b, 0xdf, 0xba, 0xd8,
-	0xf8, 0xd6, 0x8f, 0x8d, 0x6f, 0x7d, 0x39, 0x18, 0xa7, 0x32, 0x59, 0x0c, 0xc2, 0x21, 0xcf, 0xa2,
-	0xe2, 0xb9, 0x9b, 0x9f, 0x43, 0x31, 0x9a, 0x5c, 0x79, 0xf4, 0x72, 0x35, 0x63, 0x62, 0xd0, 0xd0,
-	0xaf, 0xf6, 0xc5, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0x90, 0xf8, 0x1f, 0x1a, 0x04, 0x00,
-	0x00,
+	// 549 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x54, 0xcd, 0x6e, 0xd3, 0x4c,
+	0x14, 0xf5, 0x24, 0x4e, 0xbe, 0xf4, 0xda, 0x9f, 0x94, 0x8e, 0x0a, 0xb2, 0x90, 0x70, 0x8d, 0x37,
+	0xf5, 0xa2, 0xd8, 0x34, 0x54, 0x62, 0xc1, 0x8a, 0x20, 0x90, 0x23, 0x81, 0x40, 0x53, 0x84, 0x04,
+	0x9b, 0x6a, 0x92, 0x4c, 0xe3, 0x28, 0x71, 0x26, 0xca, 0x4c, 0x22, 0xf2, 0x06, 0x2c, 0x79, 0x15,
+	0xde, 0xa2, 0xcb, 0x2e, 0x59, 0x55, 0x28, 0x79, 0x00, 0x5e, 0x01, 0xcd, 0xf8, 0xa7, 0x55, 0x48,
+	0xa1, 0x5d, 0x65, 0xce, 0xc9, 0xb9, 0x67, 0xae, 0xcf, 0x9d, 0x19, 0x38, 0xec, 0x71, 0x91, 0x72,
+	0x11, 0x75, 0xa9, 0x60, 0x91, 0x98, 0xd0, 0xa9, 0x48, 0xb8, 0x14, 0xd1, 0xe2, 0xa8, 0xcb, 0x24,
+	0x3d, 0x2a, 0x99, 0x70, 0x3a, 0xe3, 0x92, 0xe3, 0x87, 0x99, 0x3a, 0x54, 0xea, 0xb0, 0x54, 0x87,
+	0xb9, 0xfa, 0xc1, 0xde, 0x80, 0x0f, 0xb8, 0x56, 0x46, 0x6a, 0x95, 0x15, 0xf9, 0xdf, 0x11, 0x34,
+	0x4e, 0x72, 0x2d, 0xbe, 0x0f, 0xf5, 0x84, 0x0d, 0x07, 0x89, 0x74, 0x90, 0x87, 0x02, 0x93, 0xe4,
+	0x48, 0xf1, 0x67, 0x7c, 0x96, 0x52, 0xe9, 0x54, 0x3c, 0x14, 0xfc, 0x4f, 0x72, 0xa4, 0xf8, 0x5e,
+	0x32, 0x9f, 0x8c, 0x84, 0x53, 0xcd, 0xf8, 0x0c, 0x61, 0x0c, 0x66, 0x42, 0x45, 0xe2, 0x98, 0x1e,
+	0x0a, 0x6c, 0xa2, 0xd7, 0xb8, 0x03, 0x8d, 0x94, 0x49, 0xda, 0xa7, 0x92, 0x3a, 0x35, 0x0f, 0x05,
+	0x56, 0xeb, 0x20, 0xfc, 0x6b, 0xc3, 0xe1, 0xdb, 0x5c, 0xde, 0x36, 0xcf, 0x2f, 0xf7, 0x0d, 0x52,
+	0x96, 0xfb, 0x5f, 0x11, 0x34, 0x8a, 0x3f, 0xf1, 0x23, 0xb0, 0xf5, 0xae, 0xa7, 0x6a, 0x17, 0x26,
+	0x1c, 0xe4, 0x55, 0x03, 0x9b, 0x58, 0x9a, 0x8b, 0x35, 0x85, 0x3f, 0x81, 0x2d, 0x24, 0x9f, 0xb1,
+	0x42, 0x52, 0xf1, 0xaa, 0x81, 0xd5, 0x7a, 0xf2, 0x8f, 0xed, 0x8b, 0x54, 0x4e, 0x54, 0xa9, 0x72,
+	0xca, 0xfb, 0xb0, 0x44, 0x41, 0x30, 0xe1, 0x3f, 0x87, 0xdd, 0x3f, 0x74, 0xea, 0xf3, 0x27, 0x34,
+	0x65, 0x3a, 0xc4, 0x1d, 0xa2, 0xd7, 0x65, 0x24, 0x95, 0xab, 0x48, 0xfc, 0x5f, 0x15, 0xb0, 0x8b,
+	0xea, 0x8e, 0x64, 0x29, 0x8e, 0xa1, 0xa6, 0xcd, 0x75, 0xe5, 0x1d, 0x3b, 0x54, 0x06, 0xb1, 0x41,
+	0x32, 0x03, 0xfc, 0x0e, 0xcc, 0x21, 0x5d, 0x8c, 0xf5, 0x76, 0x56, 0x2b, 0xba, 0xa5, 0x51, 0xe7,
+	0xc5, 0xc7, 0x37, 0xca, 0xa7, 0xdd, 0x58, 0x5d, 0xee, 0x9b, 0x0a, 0xc5, 0x06, 0xd1, 0x46, 0xf8,
+	0x03, 0xec, 0xb0, 0x2f, 0x92, 0x4d, 0xc4, 0x90, 0x4f, 0xf4, 0xb4, 0xad, 0xd6, 0xf1, 0x2d, 0x5d,
+	0x5f, 0x15, 0x75, 0x6a, 0x66, 0xb1, 0x41, 0xae, 0x8c, 0xf0, 0x19, 0xec, 0x96, 0xe0, 0x74, 0x4a,
+	0x97, 0x63, 0x4e, 0xfb, 0xfa, 0xd4, 0x58, 0xad, 0x67, 0x77, 0x75, 0x7f, 0x9f, 0x95, 0xc7, 0x06,
+	0x69, 0xb2, 0x0d, 0xae, 0x5d, 0x07, 0x73, 0x28, 0x59, 0xea, 0x1f, 0x6c, 0x8c, 0x4b, 0xa7, 0xbe,
+	0x65, 0x5c, 0xfe, 0x18, 0x9a, 0x9b, 0xa1, 0xe0, 0x26, 0x54, 0x47, 0x6c, 0xa9, 0x65, 0x36, 0x51,
+	0x4b, 0xbc, 0x07, 0xb5, 0x05, 0x1d, 0xcf, 0x59, 0x3e, 0xd5, 0x0c, 0x60, 0x07, 0xfe, 0x5b, 0xb0,
+	0x59, 0x19, 0x54, 0x95, 0x14, 0xf0, 0xda, 0xfd, 0x52, 0xdf, 0x58, 0x2b, 0xee, 0x97, 0xff, 0x12,
+	0xee, 0x6d, 0x0d, 0x6b, 0xeb, 0x49, 0xba, 0xe1, 0x32, 0xfa, 0xc7, 0xe0, 0xdc, 0x94, 0x89, 0x6a,
+	0xa9, 0x48, 0x37, 0x6b, 0xbf, 0x80, 0xed, 0xd7, 0xe7, 0x2b, 0x17, 0x5d, 0xac, 0x5c, 0xf4, 0x73,
+	0xe5, 0xa2, 0x6f, 0x6b, 0xd7, 0xb8, 0x58, 0xbb, 0xc6, 0x8f, 0xb5, 0x6b, 0x7c, 0x3e, 0x1c, 0x0c,
+	0x65, 0x32, 0xef, 0x86, 0x3d, 0x9e, 0x46, 0xf9, 0x3b, 0x94, 0xfd, 0x3c, 0x16, 0xfd, 0xd1, 0xb5,
+	0xd7, 0x48, 0x2e, 0xa7, 0x4c, 0x74, 0xeb, 0xfa, 0x39, 0x79, 0xfa, 0x7b, 0x00, 0x26, 0x3b, 0x5d,
+	0x37, 0xb3, 0x04, 0x00, 0x00,
 }
 
 func (m *Snapshot) Marshal() (dAtA []byte, err error) {
@@ -599,6 +665,20 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.StoreHashes) > 0 {
+		for iNdEx := len(m.StoreHashes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.StoreHashes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSnapshot(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.ChunkHashes) > 0 {
 		for iNdEx := len(m.ChunkHashes) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.ChunkHashes[iNdEx])
@@ -611,6 +691,43 @@ func (m *Metadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SnapshotStoreHash) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SnapshotStoreHash) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SnapshotStoreHash) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintSnapshot(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *SnapshotItem) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -916,6 +1033,29 @@ func (m *Metadata) Size() (n int) {
 			n += 1 + l + sovSnapshot(uint64(l))
 		}
 	}
+	if len(m.StoreHashes) > 0 {
+		for _, e := range m.StoreHashes {
+			l = e.Size()
+			n += 1 + l + sovSnapshot(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SnapshotStoreHash) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovSnapshot(uint64(l))
+	}
 	return n
 }
 
@@ -1285,6 +1425,156 @@ func (m *Metadata) Unmarshal(dAtA []byte) error {
 			m.ChunkHashes = append(m.ChunkHashes, make([]byte, postIndex-iNdEx))
 			copy(m.ChunkHashes[len(m.ChunkHashes)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoreHashes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StoreHashes = append(m.StoreHashes, SnapshotStoreHash{})
+			if err := m.StoreHashes[len(m.StoreHashes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSnapshot(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SnapshotStoreHash) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSnapshot
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SnapshotStoreHash: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SnapshotStoreHash: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hash", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSnapshot
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSnapshot
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hash = append(m.Hash[:0], dAtA[iNdEx:postIndex]...)
+			if m.Hash == nil {
+				m.Hash = []byte{}
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipSnapshot(dAtA[iNdEx:])
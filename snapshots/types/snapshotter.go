@@ -17,6 +17,21 @@ type Snapshotter interface {
 	Restore(height uint64, format uint32, protoReader protoio.Reader) (SnapshotItem, error)
 }
 
+// VerifiableSnapshotter is a Snapshotter whose stores are verified one by one while a snapshot is restored. The root
+// hashes of its stores are included in the snapshot metadata when the snapshot is created, and each store is verified
+// against them as soon as it is imported, instead of only checking the app hash once the whole snapshot is restored.
+type VerifiableSnapshotter interface {
+	Snapshotter
+
+	// SnapshotStoreHashes returns the root hashes of all the stores committed at the given height, including the
+	// stores which are not snapshotted, so that they can be verified against the app hash.
+	SnapshotStoreHashes(height uint64) ([]SnapshotStoreHash, error)
+
+	// RestoreVerified restores a state snapshot like Restore, verifying the root hash of each restored store
+	// against the provided store hashes.
+	RestoreVerified(height uint64, format uint32, protoReader protoio.Reader, storeHashes []SnapshotStoreHash) (SnapshotItem, error)
+}
+
 // ExtensionSnapshotter is an extension Snapshotter that is appended to the snapshot stream.
 // ExtensionSnapshotter has an unique name and manages it's own internal formats.
 type ExtensionSnapshotter interface {
@@ -0,0 +1,149 @@
+package journal
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+	"sync"
+
+	protoio "github.com/gogo/protobuf/io"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// changesetFormat is the first byte of the encoded changesets, it is followed by the length-prefixed StoreKVPairs
+const changesetFormat byte = 1
+
+// maxChangeSize is the maximum size of an encoded change, it matches the maximum size of an IAVL value
+const maxChangeSize = 64 * 1024 * 1024
+
+var _ types.WriteListener = (*Journal)(nil)
+
+// Journal is a state diff journal, recording the changes written to the stores of each committed block in a
+// separate database. It allows the state of a height that was pruned from the stores to be reconstructed from the
+// nearest retained version and the changes of the following blocks, so historical queries can be served without
+// keeping every version of the stores.
+//
+// The Journal is a WriteListener of the stores, the changes of a block are buffered as they are written and saved
+// once the block is committed. Only the last change of each key is kept for a block.
+type Journal struct {
+	db dbm.DB
+
+	mtx     sync.Mutex
+	pending map[string]map[string]*types.StoreKVPair // store name -> key -> last change of the key
+}
+
+// NewJournal returns a new Journal saving the changesets to the database
+func NewJournal(db dbm.DB) *Journal {
+	return &Journal{
+		db:      db,
+		pending: make(map[string]map[string]*types.StoreKVPair),
+	}
+}
+
+// OnWrite implements the WriteListener interface, it buffers the change until the block is committed
+func (j *Journal) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	change := &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte(nil), key...),
+	}
+	if !delete {
+		change.Value = append([]byte(nil), value...)
+	}
+
+	j.mtx.Lock()
+	defer j.mtx.Unlock()
+	changes, ok := j.pending[change.StoreKey]
+	if !ok {
+		changes = make(map[string]*types.StoreKVPair)
+		j.pending[change.StoreKey] = changes
+	}
+	changes[string(key)] = change
+	return nil
+}
+
+// Commit saves the changes buffered since the previous commit as the changeset of the version. A changeset is saved
+// for every version, even if it is empty, so a missing changeset means the changes of the version are unknown.
+func (j *Journal) Commit(version int64) error {
+	j.mtx.Lock()
+	pending := j.pending
+	j.pending = make(map[string]map[string]*types.StoreKVPair)
+	j.mtx.Unlock()
+
+	// the changes are sorted by store name and key, so the changesets are deterministic
+	storeNames := make([]string, 0, len(pending))
+	for name := range pending {
+		storeNames = append(storeNames, name)
+	}
+	sort.Strings(storeNames)
+
+	buf := bytes.NewBuffer([]byte{changesetFormat})
+	writer := protoio.NewDelimitedWriter(buf)
+	for _, name := range storeNames {
+		changes := pending[name]
+		keys := make([]string, 0, len(changes))
+		for key := range changes {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if err := writer.WriteMsg(changes[key]); err != nil {
+				return err
+			}
+		}
+	}
+
+	return j.db.Set(changesetKey(version), buf.Bytes())
+}
+
+// Has returns true if the changeset of the version is in the journal
+func (j *Journal) Has(version int64) (bool, error) {
+	return j.db.Has(changesetKey(version))
+}
+
+// Changeset returns the changes of the version, sorted by store name and key
+func (j *Journal) Changeset(version int64) ([]types.StoreKVPair, error) {
+	bz, err := j.db.Get(changesetKey(version))
+	if err != nil {
+		return nil, err
+	}
+	if bz == nil {
+		return nil, fmt.Errorf("no changeset found for version %d", version)
+	}
+	if len(bz) == 0 || bz[0] != changesetFormat {
+		return nil, fmt.Errorf("unknown changeset format for version %d", version)
+	}
+
+	var changes []types.StoreKVPair
+	reader := protoio.NewDelimitedReader(bytes.NewReader(bz[1:]), maxChangeSize)
+	for {
+		var change types.StoreKVPair
+		err := reader.ReadMsg(&change)
+		if err == io.EOF {
+			return changes, nil
+		} else if err != nil {
+			return nil, fmt.Errorf("invalid changeset for version %d: %w", version, err)
+		}
+		// empty values are not encoded, but they are valid values of the stores
+		if !change.Delete && change.Value == nil {
+			change.Value = []byte{}
+		}
+		changes = append(changes, change)
+	}
+}
+
+// Close closes the database of the journal
+func (j *Journal) Close() error {
+	return j.db.Close()
+}
+
+// changesetKey returns the database key of the changeset of a version, the keys are ordered by version
+func changesetKey(version int64) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(version))
+	return key
+}
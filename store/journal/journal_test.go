@@ -0,0 +1,49 @@
+package journal_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/journal"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestJournal(t *testing.T) {
+	j := journal.NewJournal(dbm.NewMemDB())
+	key1, key2 := types.NewKVStoreKey("store1"), types.NewKVStoreKey("store2")
+
+	// only the last change of each key is kept, sorted by store name and key
+	require.NoError(t, j.OnWrite(key2, []byte("b"), []byte("1"), false))
+	require.NoError(t, j.OnWrite(key1, []byte("b"), []byte("1"), false))
+	require.NoError(t, j.OnWrite(key1, []byte("a"), []byte("1"), false))
+	require.NoError(t, j.OnWrite(key1, []byte("b"), []byte("2"), false))
+	require.NoError(t, j.OnWrite(key2, []byte("a"), nil, true))
+	require.NoError(t, j.OnWrite(key2, []byte("c"), []byte{}, false))
+	require.NoError(t, j.Commit(1))
+
+	// a changeset is saved even if the version has no changes
+	require.NoError(t, j.Commit(2))
+
+	changes, err := j.Changeset(1)
+	require.NoError(t, err)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "store1", Key: []byte("a"), Value: []byte("1")},
+		{StoreKey: "store1", Key: []byte("b"), Value: []byte("2")},
+		{StoreKey: "store2", Key: []byte("a"), Delete: true},
+		{StoreKey: "store2", Key: []byte("b"), Value: []byte("1")},
+		{StoreKey: "store2", Key: []byte("c"), Value: []byte{}},
+	}, changes)
+
+	for version, expected := range map[int64]bool{1: true, 2: true, 3: false} {
+		ok, err := j.Has(version)
+		require.NoError(t, err)
+		require.Equal(t, expected, ok, version)
+	}
+	changes, err = j.Changeset(2)
+	require.NoError(t, err)
+	require.Empty(t, changes)
+	_, err = j.Changeset(3)
+	require.Error(t, err)
+}
@@ -0,0 +1,103 @@
+package rootmulti
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/journal"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+func TestCacheMultiStoreWithVersion_Journal(t *testing.T) {
+	db, journalDB := dbm.NewMemDB(), dbm.NewMemDB()
+	// only every 5th version and the latest one are kept
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(0, 5, 1))
+	ms.SetJournal(journal.NewJournal(journalDB))
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for v := 1; v <= 12; v++ {
+		// the changes are written through a branch of the store, like the changes of a block
+		cms := ms.CacheMultiStore()
+		store1 := cms.GetKVStore(testStoreKey1)
+		store1.Set([]byte("version"), []byte(fmt.Sprint(v)))
+		store1.Set([]byte(fmt.Sprintf("key%d", v)), []byte(fmt.Sprint(v)))
+		if v == 8 {
+			store1.Delete([]byte("key3"))
+		}
+		cms.Write()
+		ms.Commit()
+	}
+	require.False(t, ms.versionExists(7))
+	require.True(t, ms.versionExists(10))
+
+	for v := int64(1); v <= 12; v++ {
+		cms, err := ms.CacheMultiStoreWithVersion(v)
+		require.NoError(t, err)
+		store1 := cms.GetKVStore(testStoreKey1)
+		require.Equal(t, []byte(fmt.Sprint(v)), store1.Get([]byte("version")), v)
+		require.Equal(t, []byte(fmt.Sprint(v)), store1.Get([]byte(fmt.Sprintf("key%d", v))), v)
+		require.Nil(t, store1.Get([]byte(fmt.Sprintf("key%d", v+1))), v)
+		require.Equal(t, v >= 3 && v < 8, store1.Has([]byte("key3")), v)
+
+		// the reconstructed stores can be iterated
+		expected, count := int(v)+1, 0
+		if v >= 8 {
+			expected--
+		}
+		iter := store1.Iterator(nil, nil)
+		for ; iter.Valid(); iter.Next() {
+			count++
+		}
+		require.NoError(t, iter.Close())
+		require.Equal(t, expected, count, v)
+	}
+
+	// the pruned versions too far from the nearest retained version are not reconstructed
+	ms.SetJournalMaxReplay(2)
+	_, err := ms.CacheMultiStoreWithVersion(7)
+	require.NoError(t, err)
+	_, err = ms.CacheMultiStoreWithVersion(8)
+	require.Error(t, err)
+	_, err = ms.CacheMultiStoreWithVersion(2)
+	require.NoError(t, err)
+	_, err = ms.CacheMultiStoreWithVersion(3)
+	require.Error(t, err)
+
+	// the pruned versions can't be reconstructed without the changesets of the following versions
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(0, 5, 1))
+	ms.SetJournal(journal.NewJournal(dbm.NewMemDB()))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.Commit()
+	ms.Commit()
+	_, err = ms.CacheMultiStoreWithVersion(13)
+	require.Error(t, err)
+	_, err = ms.CacheMultiStoreWithVersion(14)
+	require.NoError(t, err)
+}
+
+func TestJournalStoreUpgrades(t *testing.T) {
+	db, journalDB := dbm.NewMemDB(), dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	ms.SetJournal(journal.NewJournal(journalDB))
+	require.NoError(t, ms.LoadLatestVersion())
+	ms.GetStoreByName("store2").(types.KVStore).Set([]byte("moved"), []byte("value"))
+	ms.GetStoreByName("store3").(types.KVStore).Set([]byte("deleted"), []byte("value"))
+	ms.Commit()
+
+	// the changes made by the store upgrades are part of the changeset of the next version
+	upgraded, upgrades := newMultiStoreWithModifiedMounts(db, types.PruneNothing)
+	upgraded.SetJournal(journal.NewJournal(journalDB))
+	require.NoError(t, upgraded.LoadLatestVersionAndUpgrade(upgrades))
+	upgraded.Commit()
+
+	changes, err := journal.NewJournal(journalDB).Changeset(2)
+	require.NoError(t, err)
+	require.Equal(t, []types.StoreKVPair{
+		{StoreKey: "restore2", Key: []byte("moved"), Value: []byte("value")},
+		{StoreKey: "store2", Key: []byte("moved"), Delete: true},
+		{StoreKey: "store3", Key: []byte("deleted"), Delete: true},
+	}, changes)
+}
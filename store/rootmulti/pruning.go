@@ -0,0 +1,162 @@
+package rootmulti
+
+import (
+	"fmt"
+
+	iavltree "github.com/cosmos/iavl"
+	"github.com/pkg/errors"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// pruningBatchSize is the maximum number of versions deleted at once from a store by PruneVersions.
+const pruningBatchSize = 10
+
+// SetBackgroundPruning sets whether the pruned heights are deleted by a background worker instead of during the
+// commits. The worker is started at the first pruning interval. The IAVL stores can't be pruned while they are
+// committed, so the worker deletes a single version from a single store at a time, releasing the pruning mutex in
+// between. In the worst case a commit is held off by the deletion of one version from the largest store, whose cost
+// grows with the number of nodes orphaned by that version.
+func (rs *Store) SetBackgroundPruning(enabled bool) {
+	rs.backgroundPruning = enabled
+}
+
+// startBackgroundPruning signals the background worker that there are heights to prune, starting it if needed
+func (rs *Store) startBackgroundPruning() {
+	rs.pruningWorker.Do(func() {
+		rs.pruningSignal = make(chan struct{}, 1)
+		go rs.runBackgroundPruning()
+	})
+
+	select {
+	case rs.pruningSignal <- struct{}{}:
+	default:
+		// the worker has not picked up the previous signal yet
+	}
+}
+
+// runBackgroundPruning deletes the pending pruning heights one by one each time it is signaled, including the
+// heights added while it runs
+func (rs *Store) runBackgroundPruning() {
+	for range rs.pruningSignal {
+		for rs.pruneNextHeight() {
+		}
+	}
+}
+
+// pruneNextHeight deletes the first pending pruning height from the stores, it returns false if there are no
+// pending heights. The pruning mutex is held only to pick the height and while the height is deleted from a single
+// store, so the commits are not held off until the height is deleted from all the stores. The remaining heights are
+// persisted, so they are pruned after a restart.
+func (rs *Store) pruneNextHeight() bool {
+	rs.pruningMtx.Lock()
+	if len(rs.pruneHeights) == 0 {
+		rs.pruningMtx.Unlock()
+		return false
+	}
+	height := rs.pruneHeights[0]
+	keys := make([]types.StoreKey, 0, len(rs.stores))
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL {
+			keys = append(keys, key)
+		}
+	}
+	rs.pruningMtx.Unlock()
+
+	for _, key := range keys {
+		rs.pruneStoreHeight(key, height)
+	}
+
+	rs.pruningMtx.Lock()
+	defer rs.pruningMtx.Unlock()
+
+	// the pending heights may have been changed in the meantime, e.g. cleared by PruneVersions
+	pending := make([]int64, 0, len(rs.pruneHeights))
+	for _, h := range rs.pruneHeights {
+		if h != height {
+			pending = append(pending, h)
+		}
+	}
+	rs.pruneHeights = pending
+	flushPruningHeights(rs.db, rs.pruneHeights)
+	return true
+}
+
+// pruneStoreHeight deletes the height from the IAVL store, holding off the commits meanwhile
+func (rs *Store) pruneStoreHeight(key types.StoreKey, height int64) {
+	rs.pruningMtx.Lock()
+	defer rs.pruningMtx.Unlock()
+
+	// If the store is wrapped with an inter-block cache, we must first unwrap
+	// it to get the underlying IAVL store.
+	if err := rs.GetCommitKVStore(key).(*iavl.Store).DeleteVersions(height); err != nil {
+		if errCause := errors.Cause(err); errCause != nil && errCause != iavltree.ErrVersionDoesNotExist {
+			panic(err)
+		}
+	}
+}
+
+// PruneVersions deletes the versions of the IAVL stores which are not retained by the pruning options, whether or
+// not they were scheduled for pruning, so the stores match the pruning options after they were changed. The latest
+// version is always retained. The versions are deleted in batches and the pending pruning heights are cleared. It
+// returns the number of deleted versions.
+func (rs *Store) PruneVersions(opts types.PruningOptions) (int, error) {
+	rs.pruningMtx.Lock()
+	defer rs.pruningMtx.Unlock()
+
+	latest := rs.LastCommitID().Version
+	pruned := make(map[int64]struct{})
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		// If the store is wrapped with an inter-block cache, we must first unwrap
+		// it to get the underlying IAVL store.
+		iavlStore := rs.GetCommitKVStore(key).(*iavl.Store)
+
+		var versions []int64
+		for _, version := range iavlStore.GetAllVersions() {
+			if v := int64(version); !isRetained(opts, latest, v) {
+				versions = append(versions, v)
+				pruned[v] = struct{}{}
+			}
+		}
+
+		for start := 0; start < len(versions); start += pruningBatchSize {
+			end := start + pruningBatchSize
+			if end > len(versions) {
+				end = len(versions)
+			}
+			if err := iavlStore.DeleteVersions(versions[start:end]...); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	rs.pruneHeights = make([]int64, 0)
+	flushPruningHeights(rs.db, rs.pruneHeights)
+	return len(pruned), nil
+}
+
+// isRetained returns true if the version is retained by the pruning options, given the latest version. The retained
+// versions match the heights which are not pruned by Commit.
+func isRetained(opts types.PruningOptions, latest, version int64) bool {
+	if version >= latest-int64(opts.KeepRecent) {
+		return true
+	}
+	return opts.KeepEvery != 0 && version%int64(opts.KeepEvery) == 0
+}
+
+// flushPruningHeights persists the pending pruning heights
+func flushPruningHeights(db dbm.DB, pruneHeights []int64) {
+	batch := db.NewBatch()
+	defer batch.Close()
+
+	setPruningHeights(batch, pruneHeights)
+
+	if err := batch.Write(); err != nil {
+		panic(fmt.Errorf("error on batch write %w", err))
+	}
+}
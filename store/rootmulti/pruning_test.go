@@ -0,0 +1,100 @@
+package rootmulti
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/types"
+)
+
+// requireVersions checks the versions of all the IAVL stores
+func requireVersions(t *testing.T, ms *Store, expected []int) {
+	for key := range ms.stores {
+		require.Equal(t, expected, ms.GetCommitKVStore(key).(*iavl.Store).GetAllVersions(), key.Name())
+	}
+}
+
+func TestMultiStore_BackgroundPruning(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.NewPruningOptions(2, 0, 5))
+	ms.SetBackgroundPruning(true)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	for i := 0; i < 30; i++ {
+		ms.Commit()
+	}
+
+	// the heights are deleted in the background, the commits go on in the meantime
+	require.Eventually(t, func() bool {
+		ms.pruningMtx.Lock()
+		defer ms.pruningMtx.Unlock()
+		return len(ms.pruneHeights) == 0
+	}, 5*time.Second, 10*time.Millisecond)
+	requireVersions(t, ms, []int{28, 29, 30})
+
+	// no pending heights are left after a restart
+	ms = newMultiStoreWithMounts(db, types.NewPruningOptions(2, 0, 5))
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Empty(t, ms.pruneHeights)
+	requireVersions(t, ms, []int{28, 29, 30})
+}
+
+func TestMultiStore_PruneNextHeight(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 5; i++ {
+		ms.Commit()
+	}
+	ms.pruneHeights = []int64{1, 2}
+
+	// a single height is deleted at a time and the commits go on in between
+	require.True(t, ms.pruneNextHeight())
+	requireVersions(t, ms, []int{2, 3, 4, 5})
+	require.Equal(t, []int64{2}, ms.pruneHeights)
+	ms.Commit()
+
+	// the heights which no longer exist are skipped
+	ms.pruneHeights = append(ms.pruneHeights, 1)
+	require.True(t, ms.pruneNextHeight())
+	require.True(t, ms.pruneNextHeight())
+	require.False(t, ms.pruneNextHeight())
+	requireVersions(t, ms, []int{3, 4, 5, 6})
+
+	// the pending heights are persisted
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	require.Empty(t, ms.pruneHeights)
+}
+
+func TestMultiStore_PruneVersions(t *testing.T) {
+	db := dbm.NewMemDB()
+	ms := newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	for i := 0; i < 25; i++ {
+		ms.Commit()
+	}
+	requireVersions(t, ms, []int{
+		1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15, 16, 17, 18, 19, 20, 21, 22, 23, 24, 25,
+	})
+
+	// the versions are pruned with the options of the next commits
+	pruned, err := ms.PruneVersions(types.NewPruningOptions(3, 10, 10))
+	require.NoError(t, err)
+	require.Equal(t, 19, pruned)
+	requireVersions(t, ms, []int{10, 20, 22, 23, 24, 25})
+
+	pruned, err = ms.PruneVersions(types.PruneEverything)
+	require.NoError(t, err)
+	require.Equal(t, 3, pruned)
+	requireVersions(t, ms, []int{23, 24, 25})
+
+	// the pruned versions stay pruned when the store is reloaded
+	ms = newMultiStoreWithMounts(db, types.PruneNothing)
+	require.NoError(t, ms.LoadLatestVersion())
+	requireVersions(t, ms, []int{23, 24, 25})
+}
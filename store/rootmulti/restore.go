@@ -0,0 +1,174 @@
+package rootmulti
+
+import (
+	"bytes"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	iavltree "github.com/cosmos/iavl"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// restoreNodeBufferSize is the number of nodes buffered for each store being imported, so the snapshot stream can be
+// read ahead while the stores are imported
+const restoreNodeBufferSize = 4096
+
+// restoreConcurrency is the maximum number of stores imported concurrently
+var restoreConcurrency = runtime.NumCPU()
+
+// storeImporter imports the stores of a snapshot concurrently. The snapshot stream is read sequentially, the nodes of
+// each store being passed to a goroutine importing that store, so the reader can move on to the next store while the
+// previous ones are still being imported.
+type storeImporter struct {
+	height      int64
+	storeHashes map[string][]byte // hashes the stores are verified against, nil disables the verification
+	slots       chan struct{}     // limits the number of stores imported concurrently
+	quit        chan struct{}     // closed when an import fails
+	wg          sync.WaitGroup
+	errOnce     sync.Once
+	err         error
+}
+
+func newStoreImporter(height int64, storeHashes []snapshottypes.SnapshotStoreHash, concurrency int) *storeImporter {
+	if concurrency < 1 {
+		concurrency = 1
+	}
+	si := &storeImporter{
+		height: height,
+		slots:  make(chan struct{}, concurrency),
+		quit:   make(chan struct{}),
+	}
+	if storeHashes != nil {
+		si.storeHashes = make(map[string][]byte, len(storeHashes))
+		for _, storeHash := range storeHashes {
+			si.storeHashes[storeHash.Name] = storeHash.Hash
+		}
+	}
+	return si
+}
+
+// storeImport is the import of a single store, whose nodes are passed to the importing goroutine
+type storeImport struct {
+	importer *storeImporter
+	nodes    chan *iavltree.ExportNode
+	finished bool
+}
+
+// start starts importing a store in the background, it blocks while the maximum number of stores are being imported
+func (si *storeImporter) start(name string, store *iavl.Store) (*storeImport, error) {
+	select {
+	case si.slots <- struct{}{}:
+	case <-si.quit:
+		return nil, si.err
+	}
+
+	importer, err := store.Import(si.height)
+	if err != nil {
+		<-si.slots
+		return nil, sdkerrors.Wrap(err, "import failed")
+	}
+
+	imp := &storeImport{
+		importer: si,
+		nodes:    make(chan *iavltree.ExportNode, restoreNodeBufferSize),
+	}
+	si.wg.Add(1)
+	go func() {
+		defer si.wg.Done()
+		defer func() { <-si.slots }()
+		defer importer.Close()
+		if err := si.importStore(name, store, importer, imp.nodes); err != nil {
+			si.fail(err)
+		}
+	}()
+	return imp, nil
+}
+
+// importStore adds the nodes to the store until the channel is closed, then commits the store and verifies its hash
+func (si *storeImporter) importStore(name string, store *iavl.Store, importer *iavltree.Importer, nodes <-chan *iavltree.ExportNode) error {
+	start := time.Now()
+	labels := []metrics.Label{telemetry.NewLabel("store", name)}
+	var count int
+	for node := range nodes {
+		if si.failed() {
+			// drain the channel, the reader stops once it notices the failure
+			continue
+		}
+		if err := importer.Add(node); err != nil {
+			return sdkerrors.Wrapf(err, "IAVL node import failed for store %q", name)
+		}
+		count++
+		if count%restoreNodeBufferSize == 0 {
+			telemetry.IncrCounterWithLabels([]string{"snapshot", "restore", "nodes"}, restoreNodeBufferSize, labels)
+		}
+	}
+	if si.failed() {
+		return nil
+	}
+	telemetry.IncrCounterWithLabels([]string{"snapshot", "restore", "nodes"}, float32(count%restoreNodeBufferSize), labels)
+
+	if err := importer.Commit(); err != nil {
+		return sdkerrors.Wrapf(err, "IAVL commit failed for store %q", name)
+	}
+
+	if si.storeHashes != nil {
+		expected, ok := si.storeHashes[name]
+		if !ok {
+			return sdkerrors.Wrapf(snapshottypes.ErrStoreHashMismatch, "store %q is not in the snapshot metadata", name)
+		}
+		if hash := store.LastCommitID().Hash; !bytes.Equal(hash, expected) {
+			return sdkerrors.Wrapf(snapshottypes.ErrStoreHashMismatch, "store %q: expected %X, got %X", name, expected, hash)
+		}
+	}
+
+	telemetry.SetGaugeWithLabels([]string{"snapshot", "restore", "store_duration_ms"}, float32(time.Since(start).Milliseconds()), labels)
+	telemetry.IncrCounter(1, "snapshot", "restore", "stores")
+	return nil
+}
+
+// fail records the first error and stops the other imports
+func (si *storeImporter) fail(err error) {
+	si.errOnce.Do(func() {
+		si.err = err
+		close(si.quit)
+	})
+}
+
+func (si *storeImporter) failed() bool {
+	select {
+	case <-si.quit:
+		return true
+	default:
+		return false
+	}
+}
+
+// wait waits for all the imports to complete, returning the first error
+func (si *storeImporter) wait() error {
+	si.wg.Wait()
+	return si.err
+}
+
+// add passes a node to the importing goroutine, returning the error of the first failed import
+func (imp *storeImport) add(node *iavltree.ExportNode) error {
+	select {
+	case imp.nodes <- node:
+		return nil
+	case <-imp.importer.quit:
+		return imp.importer.err
+	}
+}
+
+// finish signals that all the nodes of the store have been read
+func (imp *storeImport) finish() {
+	if !imp.finished {
+		imp.finished = true
+		close(imp.nodes)
+	}
+}
@@ -223,6 +223,61 @@ func TestMultistoreSnapshotRestore(t *testing.T) {
 	}
 }
 
+func TestMultistoreRestoreVerified(t *testing.T) {
+	source := newMultiStoreWithGeneratedData(dbm.NewMemDB(), 4, 1000)
+	version := uint64(source.LastCommitID().Version)
+
+	storeHashes, err := source.SnapshotStoreHashes(version)
+	require.NoError(t, err)
+	require.Len(t, storeHashes, 4)
+	for _, storeHash := range storeHashes {
+		store := source.GetStoreByName(storeHash.Name).(types.CommitKVStore)
+		require.Equal(t, store.LastCommitID().Hash, storeHash.Hash)
+	}
+	_, err = source.SnapshotStoreHashes(version + 1)
+	require.Error(t, err)
+
+	restore := func(storeHashes []snapshottypes.SnapshotStoreHash) (*rootmulti.Store, error) {
+		chunks := make(chan io.ReadCloser, 100)
+		go func() {
+			streamWriter := snapshots.NewStreamWriter(chunks)
+			defer streamWriter.Close()
+			if err := source.Snapshot(version, streamWriter); err != nil {
+				streamWriter.CloseWithError(err)
+			}
+		}()
+		streamReader, err := snapshots.NewStreamReader(chunks)
+		require.NoError(t, err)
+		defer streamReader.Close()
+
+		target := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+		for key := range source.GetStores() {
+			target.MountStoreWithDB(key, types.StoreTypeIAVL, nil)
+		}
+		require.NoError(t, target.LoadLatestVersion())
+		_, err = target.RestoreVerified(version, snapshottypes.CurrentFormat, streamReader, storeHashes)
+		return target, err
+	}
+
+	// the stores are imported concurrently and verified against their hashes
+	target, err := restore(storeHashes)
+	require.NoError(t, err)
+	require.Equal(t, source.LastCommitID(), target.LastCommitID())
+	for key, sourceStore := range source.GetStores() {
+		assertStoresEqual(t, sourceStore, target.GetStoreByName(key.Name()).(types.CommitKVStore), "store %q not equal", key.Name())
+	}
+
+	// a store not matching its hash fails the restore
+	tampered := append([]snapshottypes.SnapshotStoreHash(nil), storeHashes...)
+	tampered[2].Hash = make([]byte, len(tampered[2].Hash))
+	_, err = restore(tampered)
+	require.ErrorIs(t, err, snapshottypes.ErrStoreHashMismatch)
+
+	// as does a store missing from the hashes
+	_, err = restore(storeHashes[1:])
+	require.ErrorIs(t, err, snapshottypes.ErrStoreHashMismatch)
+}
+
 func benchmarkMultistoreSnapshot(b *testing.B, stores uint8, storeKeys uint64) {
 	b.Skip("Noisy with slow setup time, please see https://github.com/cosmos/cosmos-sdk/issues/8855.")
 
@@ -19,9 +19,11 @@ import (
 	dbm "github.com/tendermint/tm-db"
 
 	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/cachekv"
 	"github.com/cosmos/cosmos-sdk/store/cachemulti"
 	"github.com/cosmos/cosmos-sdk/store/dbadapter"
 	"github.com/cosmos/cosmos-sdk/store/iavl"
+	"github.com/cosmos/cosmos-sdk/store/journal"
 	"github.com/cosmos/cosmos-sdk/store/listenkv"
 	"github.com/cosmos/cosmos-sdk/store/mem"
 	"github.com/cosmos/cosmos-sdk/store/tracekv"
@@ -40,6 +42,10 @@ const (
 
 const iavlDisablefastNodeDefault = true
 
+// DefaultJournalMaxReplay is the default maximum number of versions replayed from the state journal to reconstruct the
+// state of a pruned version.
+const DefaultJournalMaxReplay = 1000
+
 // Store is composed of many CommitStores. Name contrasts with
 // cacheMultiStore which is used for branching other MultiStores. It implements
 // the CommitMultiStore interface.
@@ -57,6 +63,12 @@ type Store struct {
 	pruneHeights        []int64
 	initialVersion      int64
 
+	// the pruning heights are protected by the mutex when they are deleted in the background
+	pruningMtx        sync.Mutex
+	backgroundPruning bool
+	pruningWorker     sync.Once
+	pruningSignal     chan struct{}
+
 	traceWriter       io.Writer
 	traceContext      types.TraceContext
 	traceContextMutex sync.Mutex
@@ -64,11 +76,15 @@ type Store struct {
 	interBlockCache types.MultiStorePersistentCache
 
 	listeners map[types.StoreKey][]types.WriteListener
+
+	journal          *journal.Journal
+	journalMaxReplay int64
 }
 
 var (
-	_ types.CommitMultiStore = (*Store)(nil)
-	_ types.Queryable        = (*Store)(nil)
+	_ types.CommitMultiStore              = (*Store)(nil)
+	_ types.Queryable                     = (*Store)(nil)
+	_ snapshottypes.VerifiableSnapshotter = (*Store)(nil)
 )
 
 // NewStore returns a reference to a new Store object with the provided DB. The
@@ -87,6 +103,7 @@ func NewStore(db dbm.DB, logger log.Logger) *Store {
 		keysByName:          make(map[string]types.StoreKey),
 		pruneHeights:        make([]int64, 0),
 		listeners:           make(map[types.StoreKey][]types.WriteListener),
+		journalMaxReplay:    DefaultJournalMaxReplay,
 	}
 }
 
@@ -110,6 +127,19 @@ func (rs *Store) SetIAVLDisableFastNode(disableFastNode bool) {
 	rs.iavlDisableFastNode = disableFastNode
 }
 
+// SetJournal sets the state diff journal recording the changes of the IAVL stores, which is used to reconstruct the
+// state of the pruned versions. It must be set before the stores are loaded.
+func (rs *Store) SetJournal(j *journal.Journal) {
+	rs.journal = j
+}
+
+// SetJournalMaxReplay sets the maximum number of versions whose journal changesets are replayed to reconstruct the
+// state of a pruned version, the versions further from the nearest lower retained version can't be queried. Zero
+// disables the limit.
+func (rs *Store) SetJournalMaxReplay(maxReplay int64) {
+	rs.journalMaxReplay = maxReplay
+}
+
 // SetLazyLoading sets if the iavl store should be loaded lazily or not
 func (rs *Store) SetLazyLoading(lazyLoading bool) {
 	rs.lazyLoading = lazyLoading
@@ -241,7 +271,7 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 
 		// If it was deleted, remove all data
 		if upgrades.IsDeleted(key.Name()) {
-			deleteKVStore(store.(types.KVStore))
+			deleteKVStore(rs.journaledKVStore(key, store.(types.KVStore)))
 		} else if oldName := upgrades.RenamedFrom(key.Name()); oldName != "" {
 			// handle renames specially
 			// make an unregistered key to satify loadCommitStore params
@@ -256,12 +286,13 @@ func (rs *Store) loadVersion(ver int64, upgrades *types.StoreUpgrades) error {
 			}
 
 			// move all data
-			moveKVStoreData(oldStore.(types.KVStore), store.(types.KVStore))
+			moveKVStoreData(rs.journaledKVStore(oldKey, oldStore.(types.KVStore)), rs.journaledKVStore(key, store.(types.KVStore)))
 		}
 	}
 
 	rs.lastCommitInfo = cInfo
 	rs.stores = newStores
+	rs.addJournalListeners()
 
 	// load any pruned heights we missed from disk to be pruned on the next run
 	ph, err := getPruningHeights(rs.db)
@@ -281,6 +312,37 @@ func (rs *Store) getCommitID(infos map[string]types.StoreInfo, name string) type
 	return info.CommitId
 }
 
+// journaledKVStore wraps the store so that the changes made by the store upgrades, which are written directly to
+// the store, are recorded by the journal
+func (rs *Store) journaledKVStore(key types.StoreKey, store types.KVStore) types.KVStore {
+	if rs.journal == nil {
+		return store
+	}
+	return listenkv.NewStore(store, key, []types.WriteListener{rs.journal})
+}
+
+// addJournalListeners registers the journal as a listener of the IAVL stores which it does not listen to yet
+func (rs *Store) addJournalListeners() {
+	if rs.journal == nil {
+		return
+	}
+	for key, store := range rs.stores {
+		if store.GetStoreType() != types.StoreTypeIAVL {
+			continue
+		}
+		registered := false
+		for _, listener := range rs.listeners[key] {
+			if listener == types.WriteListener(rs.journal) {
+				registered = true
+				break
+			}
+		}
+		if !registered {
+			rs.AddListeners(key, []types.WriteListener{rs.journal})
+		}
+	}
+}
+
 func deleteKVStore(kv types.KVStore) {
 	// Note that we cannot write while iterating, so load all keys here, delete below
 	var keys [][]byte
@@ -393,6 +455,10 @@ func (rs *Store) LastCommitID() types.CommitID {
 
 // Commit implements Committer/CommitStore.
 func (rs *Store) Commit() types.CommitID {
+	// the stores can't be committed while the background pruning deletes their versions
+	rs.pruningMtx.Lock()
+	defer rs.pruningMtx.Unlock()
+
 	var previousHeight, version int64
 	if rs.lastCommitInfo.GetVersion() == 0 && rs.initialVersion > 1 {
 		// This case means that no commit has been made in the store, we
@@ -410,6 +476,13 @@ func (rs *Store) Commit() types.CommitID {
 
 	rs.lastCommitInfo = commitStores(version, rs.stores)
 
+	// a failure is not fatal, the versions whose reconstruction requires the missing changeset can't be queried
+	if rs.journal != nil {
+		if err := rs.journal.Commit(version); err != nil {
+			rs.logger.Error("failed to save the state journal changeset", "version", version, "err", err)
+		}
+	}
+
 	// Determine if pruneHeight height needs to be added to the list of heights to
 	// be pruned, where pruneHeight = (commitHeight - 1) - KeepRecent.
 	if rs.pruningOpts.Interval > 0 && int64(rs.pruningOpts.KeepRecent) < previousHeight {
@@ -426,7 +499,11 @@ func (rs *Store) Commit() types.CommitID {
 
 	// batch prune if the current height is a pruning interval height
 	if rs.pruningOpts.Interval > 0 && version%int64(rs.pruningOpts.Interval) == 0 {
-		rs.PruneStores(true, nil)
+		if rs.backgroundPruning {
+			rs.startBackgroundPruning()
+		} else {
+			rs.PruneStores(true, nil)
+		}
 	}
 
 	flushMetadata(rs.db, version, rs.lastCommitInfo, rs.pruneHeights)
@@ -498,6 +575,10 @@ func (rs *Store) CacheMultiStore() types.CacheMultiStore {
 // any store cannot be loaded. This should only be used for querying and
 // iterating at past heights.
 func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStore, error) {
+	if rs.journal != nil && version > 0 && !rs.versionExists(version) {
+		return rs.cacheMultiStoreFromJournal(version)
+	}
+
 	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
 	for key, store := range rs.stores {
 		switch store.GetStoreType() {
@@ -523,6 +604,88 @@ func (rs *Store) CacheMultiStoreWithVersion(version int64) (types.CacheMultiStor
 	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners), nil
 }
 
+// versionExists returns true if the version of the IAVL stores was not pruned
+func (rs *Store) versionExists(version int64) bool {
+	for key, store := range rs.stores {
+		if store.GetStoreType() == types.StoreTypeIAVL && rs.GetCommitKVStore(key).(*iavl.Store).VersionExists(version) {
+			return true
+		}
+	}
+	return false
+}
+
+// cacheMultiStoreFromJournal reconstructs the state of a pruned version from the nearest lower retained version, or
+// from the empty state, and the journal changesets of the following versions. The changes are applied to in-memory
+// caches of the IAVL stores, so the cost of the reconstruction grows with the number of versions to replay, which is
+// bounded by the journal max replay.
+func (rs *Store) cacheMultiStoreFromJournal(version int64) (types.CacheMultiStore, error) {
+	base := version
+	for {
+		if rs.journalMaxReplay > 0 && version-base >= rs.journalMaxReplay {
+			return nil, fmt.Errorf(
+				"version %d is pruned and more than %d versions above the nearest retained version", version, rs.journalMaxReplay,
+			)
+		}
+		ok, err := rs.journal.Has(base)
+		if err != nil {
+			return nil, err
+		}
+		if !ok {
+			return nil, fmt.Errorf("version %d is pruned and the state journal has no changeset for version %d", version, base)
+		}
+		base--
+		if base == 0 || rs.versionExists(base) {
+			break
+		}
+	}
+
+	cachedStores := make(map[types.StoreKey]types.CacheWrapper)
+	replayedStores := make(map[string]types.KVStore)
+	for key, store := range rs.stores {
+		switch store.GetStoreType() {
+		case types.StoreTypeIAVL:
+			// the changes are replayed on an empty store if the store did not exist at the base version, the empty
+			// store returned by GetImmutable can't be iterated
+			var baseStore types.KVStore = dbadapter.Store{DB: dbm.NewMemDB()}
+			if iavlStore := rs.GetCommitKVStore(key).(*iavl.Store); iavlStore.VersionExists(base) {
+				immutable, err := iavlStore.GetImmutable(base)
+				if err != nil {
+					return nil, err
+				}
+				baseStore = immutable
+			}
+
+			replayed := cachekv.NewStore(baseStore)
+			replayedStores[key.Name()] = replayed
+			cachedStores[key] = replayed
+
+		default:
+			cachedStores[key] = store
+		}
+	}
+
+	for v := base + 1; v <= version; v++ {
+		changes, err := rs.journal.Changeset(v)
+		if err != nil {
+			return nil, err
+		}
+		for _, change := range changes {
+			// the changes of the stores which are no longer mounted are skipped
+			store, ok := replayedStores[change.StoreKey]
+			if !ok {
+				continue
+			}
+			if change.Delete {
+				store.Delete(change.Key)
+			} else {
+				store.Set(change.Key, change.Value)
+			}
+		}
+	}
+
+	return cachemulti.NewStore(rs.db, cachedStores, rs.keysByName, rs.traceWriter, rs.getTracingContext(), rs.listeners), nil
+}
+
 // GetStore returns a mounted Store for a given StoreKey. If the StoreKey does
 // not exist, it will panic. If the Store is wrapped in an inter-block cache, it
 // will be unwrapped prior to being returned.
@@ -760,42 +923,88 @@ func (rs *Store) Snapshot(height uint64, protoWriter protoio.Writer) error {
 func (rs *Store) Restore(
 	height uint64, format uint32, protoReader protoio.Reader,
 ) (snapshottypes.SnapshotItem, error) {
+	return rs.RestoreVerified(height, format, protoReader, nil)
+}
+
+// SnapshotStoreHashes implements snapshottypes.VerifiableSnapshotter.
+// It returns the root hashes of the stores in the commit info of the height, sorted by name.
+func (rs *Store) SnapshotStoreHashes(height uint64) ([]snapshottypes.SnapshotStoreHash, error) {
+	cInfo, err := getCommitInfo(rs.db, int64(height))
+	if err != nil {
+		return nil, err
+	}
+	storeHashes := make([]snapshottypes.SnapshotStoreHash, 0, len(cInfo.StoreInfos))
+	for _, storeInfo := range cInfo.StoreInfos {
+		storeHashes = append(storeHashes, snapshottypes.SnapshotStoreHash{
+			Name: storeInfo.Name,
+			Hash: storeInfo.GetHash(),
+		})
+	}
+	sort.Slice(storeHashes, func(i, j int) bool {
+		return storeHashes[i].Name < storeHashes[j].Name
+	})
+	return storeHashes, nil
+}
+
+// RestoreVerified implements snapshottypes.VerifiableSnapshotter.
+// The snapshot items are read sequentially, while the stores are imported concurrently. Each store is verified against
+// its hash in storeHashes as soon as it is imported, a nil storeHashes disables the verification.
+// returns next snapshot item and error.
+func (rs *Store) RestoreVerified(
+	height uint64, format uint32, protoReader protoio.Reader, storeHashes []snapshottypes.SnapshotStoreHash,
+) (snapshottypes.SnapshotItem, error) {
+	imports := newStoreImporter(int64(height), storeHashes, restoreConcurrency)
+	snapshotItem, err := rs.importStores(protoReader, imports)
+	if err != nil {
+		imports.fail(err)
+	}
+	if err := imports.wait(); err != nil {
+		return snapshottypes.SnapshotItem{}, err
+	}
+
+	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
+	return snapshotItem, rs.LoadLatestVersion()
+}
+
+// importStores reads the snapshot items and passes the nodes to the imports of their stores.
+// returns next snapshot item and error.
+func (rs *Store) importStores(protoReader protoio.Reader, imports *storeImporter) (snapshottypes.SnapshotItem, error) {
 	// Import nodes into stores. The first item is expected to be a SnapshotItem containing
 	// a SnapshotStoreItem, telling us which store to import into. The following items will contain
 	// SnapshotNodeItem (i.e. ExportNode) until we reach the next SnapshotStoreItem or EOF.
-	var importer *iavltree.Importer
-	var snapshotItem snapshottypes.SnapshotItem
-loop:
+	var current *storeImport
+	defer func() {
+		if current != nil {
+			current.finish()
+		}
+	}()
 	for {
-		snapshotItem = snapshottypes.SnapshotItem{}
+		snapshotItem := snapshottypes.SnapshotItem{}
 		err := protoReader.ReadMsg(&snapshotItem)
 		if err == io.EOF {
-			break
+			return snapshottypes.SnapshotItem{}, nil
 		} else if err != nil {
 			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
 		}
 
 		switch item := snapshotItem.Item.(type) {
 		case *snapshottypes.SnapshotItem_Store:
-			if importer != nil {
-				err = importer.Commit()
-				if err != nil {
-					return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL commit failed")
-				}
-				importer.Close()
+			if current != nil {
+				// the previous store keeps being imported in the background
+				current.finish()
+				current = nil
 			}
 			store, ok := rs.GetStoreByName(item.Store.Name).(*iavl.Store)
 			if !ok || store == nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrLogic, "cannot import into non-IAVL store %q", item.Store.Name)
 			}
-			importer, err = store.Import(int64(height))
+			current, err = imports.start(item.Store.Name, store)
 			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "import failed")
+				return snapshottypes.SnapshotItem{}, err
 			}
-			defer importer.Close()
 
 		case *snapshottypes.SnapshotItem_IAVL:
-			if importer == nil {
+			if current == nil {
 				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(sdkerrors.ErrLogic, "received IAVL node item before store item")
 			}
 			if item.IAVL.Height > math.MaxInt8 {
@@ -816,26 +1025,14 @@ loop:
 			if node.Height == 0 && node.Value == nil {
 				node.Value = []byte{}
 			}
-			err := importer.Add(node)
-			if err != nil {
-				return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL node import failed")
+			if err := current.add(node); err != nil {
+				return snapshottypes.SnapshotItem{}, err
 			}
 
 		default:
-			break loop
+			return snapshotItem, nil
 		}
 	}
-
-	if importer != nil {
-		err := importer.Commit()
-		if err != nil {
-			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "IAVL commit failed")
-		}
-		importer.Close()
-	}
-
-	flushMetadata(rs.db, int64(height), rs.buildCommitInfo(int64(height)), []int64{})
-	return snapshotItem, rs.LoadLatestVersion()
 }
 
 func (rs *Store) loadCommitStoreFromParams(key types.StoreKey, id types.CommitID, params storeParams) (types.CommitKVStore, error) {
@@ -1,6 +1,7 @@
 package streaming
 
 import (
+	dbsql "database/sql"
 	"fmt"
 	"strings"
 	"sync"
@@ -9,7 +10,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
 	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/streaming/plugin"
+	sqlstreaming "github.com/cosmos/cosmos-sdk/store/streaming/sql"
 	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/spf13/cast"
 )
@@ -23,6 +27,8 @@ type ServiceType int
 const (
 	Unknown ServiceType = iota
 	File
+	Plugin
+	SQL
 	// add more in the future
 )
 
@@ -31,6 +37,10 @@ func ServiceTypeFromString(name string) ServiceType {
 	switch strings.ToLower(name) {
 	case "file", "f":
 		return File
+	case "plugin", "grpc":
+		return Plugin
+	case "sql":
+		return SQL
 	default:
 		return Unknown
 	}
@@ -41,6 +51,10 @@ func (sst ServiceType) String() string {
 	switch sst {
 	case File:
 		return "file"
+	case Plugin:
+		return "plugin"
+	case SQL:
+		return "sql"
 	default:
 		return "unknown"
 	}
@@ -48,7 +62,9 @@ func (sst ServiceType) String() string {
 
 // ServiceConstructorLookupTable is a mapping of streaming.ServiceTypes to streaming.ServiceConstructors
 var ServiceConstructorLookupTable = map[ServiceType]ServiceConstructor{
-	File: NewFileStreamingService,
+	File:   NewFileStreamingService,
+	Plugin: NewPluginStreamingService,
+	SQL:    NewSQLStreamingService,
 }
 
 // NewServiceConstructor returns the streaming.ServiceConstructor corresponding to the provided name
@@ -67,7 +83,85 @@ func NewServiceConstructor(name string) (ServiceConstructor, error) {
 func NewFileStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
 	filePrefix := cast.ToString(opts.Get("streamers.file.prefix"))
 	fileDir := cast.ToString(opts.Get("streamers.file.write_dir"))
-	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller)
+	haltOnError := cast.ToBool(opts.Get("streamers.file.halt_on_error"))
+	fsync := cast.ToBool(opts.Get("streamers.file.fsync"))
+	format, err := file.OutputFormatFromString(cast.ToString(opts.Get("streamers.file.output_format")))
+	if err != nil {
+		return nil, err
+	}
+	return file.NewStreamingService(fileDir, filePrefix, keys, marshaller, haltOnError, fsync, format)
+}
+
+// StoreDecodingService is implemented by the StreamingServices which decode the state changes with the store decoders
+// of the modules
+type StoreDecodingService interface {
+	SetStoreDecoders(decoders sdk.StoreDecoderRegistry)
+}
+
+// SetStoreDecoders sets the store decoders of the modules, e.g. those registered in the SimulationManager, on the
+// StreamingServices which decode the state changes
+func SetStoreDecoders(services []baseapp.StreamingService, decoders sdk.StoreDecoderRegistry) {
+	for _, service := range services {
+		if decodingService, ok := service.(StoreDecodingService); ok {
+			decodingService.SetStoreDecoders(decoders)
+		}
+	}
+}
+
+// KeyDecodingService is implemented by the StreamingServices which decode the keys of the state changes with the
+// key layouts of the modules' stores
+type KeyDecodingService interface {
+	SetKeyLayouts(layouts file.KeyLayoutRegistry)
+}
+
+// SetKeyLayouts sets the key layouts of the modules' stores on the StreamingServices which decode the keys of the
+// state changes
+func SetKeyLayouts(services []baseapp.StreamingService, layouts file.KeyLayoutRegistry) {
+	for _, service := range services {
+		if decodingService, ok := service.(KeyDecodingService); ok {
+			decodingService.SetKeyLayouts(layouts)
+		}
+	}
+}
+
+// NewPluginStreamingService is the streaming.ServiceConstructor function for creating a plugin StreamingService
+func NewPluginStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, _ codec.BinaryCodec) (baseapp.StreamingService, error) {
+	policy, err := plugin.PolicyFromString(cast.ToString(opts.Get("streamers.plugin.policy")))
+	if err != nil {
+		return nil, err
+	}
+	return plugin.NewStreamingService(plugin.Config{
+		Address:     cast.ToString(opts.Get("streamers.plugin.address")),
+		Command:     cast.ToString(opts.Get("streamers.plugin.command")),
+		BufferSize:  cast.ToInt(opts.Get("streamers.plugin.buffer_size")),
+		AckTimeout:  cast.ToDuration(opts.Get("streamers.plugin.ack_timeout")),
+		Policy:      policy,
+		HaltOnError: cast.ToBool(opts.Get("streamers.plugin.halt_on_error")),
+	}, keys)
+}
+
+// NewSQLStreamingService is the streaming.ServiceConstructor function for creating a SQL StreamingService
+// The database/sql driver is not linked by the SDK, the application has to import it, e.g. github.com/lib/pq
+func NewSQLStreamingService(opts serverTypes.AppOptions, keys []types.StoreKey, marshaller codec.BinaryCodec) (baseapp.StreamingService, error) {
+	driver := cast.ToString(opts.Get("streamers.sql.driver"))
+	dialectName := cast.ToString(opts.Get("streamers.sql.dialect"))
+	if dialectName == "" {
+		dialectName = driver
+	}
+	dialect, err := sqlstreaming.DialectFromDriver(dialectName)
+	if err != nil {
+		return nil, err
+	}
+	db, err := dbsql.Open(driver, cast.ToString(opts.Get("streamers.sql.dsn")))
+	if err != nil {
+		return nil, fmt.Errorf("failed to open the sql streaming service database, is the %s driver imported by the application? %w", driver, err)
+	}
+	service, err := sqlstreaming.NewStreamingService(db, dialect, keys, marshaller, cast.ToBool(opts.Get("streamers.sql.halt_on_error")))
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	return service, nil
 }
 
 // LoadStreamingServices is a function for loading StreamingServices onto the BaseApp using the provided AppOptions, codec, and keys
@@ -116,6 +210,19 @@ func LoadStreamingServices(bApp *baseapp.BaseApp, appOpts serverTypes.AppOptions
 			}
 			return nil, nil, err
 		}
+		// filter the writes of the stores with a configured filter
+		filters, err := LoadStoreFilters(appOpts, streamerName, exposeStoreKeys)
+		if err != nil {
+			// close any services we may have already spun up before hitting the error on this one
+			streamingService.Close()
+			for _, activeStreamer := range activeStreamers {
+				activeStreamer.Close()
+			}
+			return nil, nil, err
+		}
+		if len(filters) > 0 {
+			streamingService = NewFilteredStreamingService(streamingService, filters)
+		}
 		// register the streaming service with the BaseApp
 		bApp.SetStreamingService(streamingService)
 		// kick off the background streaming service loop
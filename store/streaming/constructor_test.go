@@ -40,4 +40,20 @@ func TestStreamingServiceConstructor(t *testing.T) {
 		_, ok := listeners[key]
 		require.True(t, ok)
 	}
+
+	constructor, err = NewServiceConstructor("plugin")
+	require.Nil(t, err)
+	require.IsType(t, expectedType, constructor)
+
+	// either the consumer address or the plugin command is required
+	_, err = constructor(mockOptions, mockKeys, testMarshaller)
+	require.NotNil(t, err)
+
+	constructor, err = NewServiceConstructor("sql")
+	require.Nil(t, err)
+	require.IsType(t, expectedType, constructor)
+
+	// the database driver is linked by the application
+	_, err = constructor(mapOptions{"streamers.sql.driver": "unlinked"}, mockKeys, testMarshaller)
+	require.NotNil(t, err)
 }
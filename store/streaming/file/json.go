@@ -0,0 +1,175 @@
+package file
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+// OutputFormat defines the encoding of the files written by the StreamingService
+type OutputFormat int
+
+const (
+	// OutputFormatBinary writes length-prefixed protobuf encoded messages
+	OutputFormatBinary OutputFormat = iota
+	// OutputFormatJSONLines writes a JSON object per line, with the state changes decoded
+	OutputFormatJSONLines
+)
+
+// OutputFormatFromString returns the OutputFormat corresponding to the provided name
+func OutputFormatFromString(name string) (OutputFormat, error) {
+	switch strings.ToLower(name) {
+	case "", "binary":
+		return OutputFormatBinary, nil
+	case "jsonl", "json_lines":
+		return OutputFormatJSONLines, nil
+	default:
+		return OutputFormatBinary, fmt.Errorf("unrecognized file streaming output format %s", name)
+	}
+}
+
+// String returns the string name of an OutputFormat
+func (f OutputFormat) String() string {
+	switch f {
+	case OutputFormatBinary:
+		return "binary"
+	case OutputFormatJSONLines:
+		return "jsonl"
+	default:
+		return "unknown"
+	}
+}
+
+// types of the lines of the JSON Lines format
+const (
+	requestLine     = "request"
+	stateChangeLine = "state_change"
+	responseLine    = "response"
+)
+
+// jsonLine is a line of the JSON Lines format, holding either an ABCI message or a state change
+type jsonLine struct {
+	Type       string            `json:"type"`
+	Message    json.RawMessage   `json:"message,omitempty"`
+	StoreKey   string            `json:"store_key,omitempty"`
+	Delete     bool              `json:"delete,omitempty"`
+	Key        string            `json:"key,omitempty"`
+	DecodedKey map[string]string `json:"decoded_key,omitempty"`
+	Value      string            `json:"value,omitempty"`
+	Decoded    json.RawMessage   `json:"decoded,omitempty"`
+}
+
+// marshal encodes the line, terminated by a newline
+func (l jsonLine) marshal() ([]byte, error) {
+	bz, err := json.Marshal(l)
+	if err != nil {
+		return nil, err
+	}
+	return append(bz, '\n'), nil
+}
+
+// encodeMessage encodes the ABCI request or response in the output format of the StreamingService
+func (fss *StreamingService) encodeMessage(lineType string, msg codec.ProtoMarshaler) ([]byte, error) {
+	if fss.format != OutputFormatJSONLines {
+		return fss.codec.MarshalLengthPrefixed(msg)
+	}
+	bz, err := fss.codec.(codec.ProtoCodecMarshaler).MarshalJSON(msg)
+	if err != nil {
+		return nil, err
+	}
+	return jsonLine{Type: lineType, Message: bz}.marshal()
+}
+
+// decodeValue decodes the value of a state change, either as an Any packing a message registered in the interface
+// registry, as interfaces (e.g. accounts) are stored, or with the store decoder of the module. It returns nil if
+// the value cannot be decoded.
+func (fss *StreamingService) decodeValue(storeName string, key []byte, value []byte) json.RawMessage {
+	cdc := fss.codec.(codec.ProtoCodecMarshaler)
+
+	var anyValue codectypes.Any
+	if err := proto.Unmarshal(value, &anyValue); err == nil && len(anyValue.TypeUrl) > 0 {
+		if _, err := cdc.InterfaceRegistry().Resolve(anyValue.TypeUrl); err == nil {
+			if bz, err := cdc.MarshalJSON(&anyValue); err == nil {
+				return bz
+			}
+		}
+	}
+
+	decoder, ok := fss.decoders[storeName]
+	if !ok {
+		return nil
+	}
+	decoded, ok := decodeWithStoreDecoder(decoder, kv.Pair{Key: key, Value: value})
+	if !ok {
+		return nil
+	}
+	bz, err := json.Marshal(decoded)
+	if err != nil {
+		return nil
+	}
+	return bz
+}
+
+// decodeWithStoreDecoder decodes the pair with the store decoder of a module. The store decoders compare two pairs,
+// so the pair is compared with itself and the output for a single pair is extracted. The decoders panic on keys
+// they do not recognize, in which case false is returned.
+func decodeWithStoreDecoder(decoder func(kvA, kvB kv.Pair) string, pair kv.Pair) (decoded string, ok bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			decoded, ok = "", false
+		}
+	}()
+
+	decoded = decoder(pair, pair)
+	// most decoders print both pairs separated by a newline
+	if half := len(decoded) / 2; len(decoded)%2 == 1 && decoded[half] == '\n' && decoded[:half] == decoded[half+1:] {
+		decoded = decoded[:half]
+	}
+	return decoded, true
+}
+
+// jsonLinesWriteListener is the WriteListener of the JSON Lines format, writing a line with the decoded state
+// change to the underlying io.Writer
+type jsonLinesWriteListener struct {
+	writer io.Writer
+	fss    *StreamingService
+}
+
+func newJSONLinesWriteListener(w io.Writer, fss *StreamingService) *jsonLinesWriteListener {
+	return &jsonLinesWriteListener{
+		writer: w,
+		fss:    fss,
+	}
+}
+
+// OnWrite satisfies the WriteListener interface by writing a line with the decoded state change, the key is decoded
+// with the key layouts of the store
+func (wl *jsonLinesWriteListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	line := jsonLine{
+		Type:     stateChangeLine,
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      fmt.Sprintf("%X", key),
+	}
+	if layouts, ok := wl.fss.keyLayouts[storeKey.Name()]; ok {
+		line.DecodedKey, _ = DecodeKey(layouts, key)
+	}
+	if !delete {
+		line.Value = fmt.Sprintf("%X", value)
+		line.Decoded = wl.fss.decodeValue(storeKey.Name(), key, value)
+	}
+	bz, err := line.marshal()
+	if err != nil {
+		return err
+	}
+	_, err = wl.writer.Write(bz)
+	return err
+}
@@ -0,0 +1,113 @@
+package file
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+)
+
+func TestOutputFormatFromString(t *testing.T) {
+	for name, expected := range map[string]OutputFormat{"": OutputFormatBinary, "binary": OutputFormatBinary, "JSONL": OutputFormatJSONLines, "json_lines": OutputFormatJSONLines} {
+		format, err := OutputFormatFromString(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, format)
+	}
+	_, err := OutputFormatFromString("xml")
+	require.Error(t, err)
+}
+
+func TestFileStreamingServiceJSONLines(t *testing.T) {
+	registry := codecTypes.NewInterfaceRegistry()
+	testdata.RegisterInterfaces(registry)
+	cdc := codec.NewProtoCodec(registry)
+
+	// the JSON Lines format requires a codec encoding to JSON
+	_, err := NewStreamingService(t.TempDir(), "", []sdk.StoreKey{mockStoreKey1}, codec.NewAminoCodec(codec.NewLegacyAmino()), false, false, OutputFormatJSONLines)
+	require.Error(t, err)
+
+	dir := t.TempDir()
+	fss, err := NewStreamingService(dir, testPrefix, []sdk.StoreKey{mockStoreKey1, mockStoreKey2}, cdc, false, false, OutputFormatJSONLines)
+	require.NoError(t, err)
+	fss.SetStoreDecoders(sdk.StoreDecoderRegistry{
+		mockStoreKey2.Name(): func(kvA, kvB kv.Pair) string {
+			if !bytes.Equal(kvA.Key, mockKey2) {
+				panic("unexpected key")
+			}
+			return string(kvA.Value) + "\n" + string(kvB.Value)
+		},
+	})
+
+	fss.SetKeyLayouts(KeyLayoutRegistry{
+		mockStoreKey1.Name(): {{Name: "dog", Prefix: []byte{1}, Segments: []KeySegment{{Name: "id", Kind: KeyBytes}}}},
+	})
+
+	dog, err := codecTypes.NewAnyWithValue(&testdata.Dog{Name: "spot"})
+	require.NoError(t, err)
+	dogBz, err := cdc.Marshal(dog)
+	require.NoError(t, err)
+
+	listener1 := fss.Listeners()[mockStoreKey1][0]
+	listener2 := fss.Listeners()[mockStoreKey2][0]
+	require.NoError(t, listener1.OnWrite(mockStoreKey1, mockKey1, dogBz, false))
+	require.NoError(t, listener2.OnWrite(mockStoreKey2, mockKey2, []byte("decoded"), false))
+	require.NoError(t, listener2.OnWrite(mockStoreKey2, mockKey3, mockValue3, false))
+	require.NoError(t, listener1.OnWrite(mockStoreKey1, mockKey1, nil, true))
+	require.NoError(t, fss.ListenBeginBlock(emptyContext, testBeginBlockReq, testBeginBlockRes))
+
+	lines := readJSONLines(t, filepath.Join(dir, testPrefix+"-block-1-begin.jsonl"))
+	require.Len(t, lines, 6)
+
+	require.Equal(t, requestLine, lines[0].Type)
+	var req abci.RequestBeginBlock
+	require.NoError(t, cdc.UnmarshalJSON(lines[0].Message, &req))
+	require.Equal(t, testBeginBlockReq.Header.Height, req.Header.Height)
+
+	// values packed in an Any are decoded through the interface registry
+	// keys are decoded with the key layouts of the store
+	decodedKey := map[string]string{"type": "dog", "id": "0203"}
+	require.Equal(t, jsonLine{Type: stateChangeLine, StoreKey: mockStoreKey1.Name(), Key: "010203", DecodedKey: decodedKey, Value: lines[1].Value, Decoded: lines[1].Decoded}, lines[1])
+	require.JSONEq(t, `{"@type":"/testdata.Dog","size":"","name":"spot"}`, string(lines[1].Decoded))
+
+	// values are decoded with the store decoder of the module, if it recognizes the key
+	require.Equal(t, `"decoded"`, string(lines[2].Decoded))
+	require.Equal(t, "050403", lines[3].Value)
+	require.Empty(t, lines[3].Decoded)
+	require.Empty(t, lines[3].DecodedKey)
+
+	// deletes carry no value
+	require.Equal(t, jsonLine{Type: stateChangeLine, StoreKey: mockStoreKey1.Name(), Delete: true, Key: "010203", DecodedKey: decodedKey}, lines[4])
+
+	require.Equal(t, responseLine, lines[5].Type)
+	var res abci.ResponseBeginBlock
+	require.NoError(t, cdc.UnmarshalJSON(lines[5].Message, &res))
+	require.Len(t, res.Events, len(testBeginBlockRes.Events))
+	require.Equal(t, testBeginBlockRes.Events[0].Type, res.Events[0].Type)
+}
+
+func readJSONLines(t *testing.T, name string) []jsonLine {
+	f, err := os.Open(name)
+	require.NoError(t, err)
+	defer f.Close()
+
+	var lines []jsonLine
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var line jsonLine
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), &line))
+		lines = append(lines, line)
+	}
+	require.NoError(t, scanner.Err())
+	return lines
+}
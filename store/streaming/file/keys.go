@@ -0,0 +1,122 @@
+package file
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// KeySegmentKind defines how a segment of a store key is decoded
+type KeySegmentKind int
+
+const (
+	// KeyAccAddress is a length-prefixed account address, decoded to bech32
+	KeyAccAddress KeySegmentKind = iota
+	// KeyValAddress is a length-prefixed validator operator address, decoded to bech32
+	KeyValAddress
+	// KeyConsAddress is a length-prefixed consensus address, decoded to bech32
+	KeyConsAddress
+	// KeyLengthPrefixedString is a length-prefixed string
+	KeyLengthPrefixedString
+	// KeyUint64 is a big endian uint64
+	KeyUint64
+	// KeyUint64LittleEndian is a little endian uint64
+	KeyUint64LittleEndian
+	// KeyRawAccAddress is an account address taking the rest of the key, decoded to bech32
+	KeyRawAccAddress
+	// KeyString is a string taking the rest of the key
+	KeyString
+	// KeyBytes is the rest of the key, hex encoded
+	KeyBytes
+)
+
+// KeySegment is a named segment of a store key
+type KeySegment struct {
+	Name string
+	Kind KeySegmentKind
+}
+
+// KeyLayout describes the keys of a KVStore starting with Prefix: the rest of the key is made of the Segments,
+// in order. A layout without segments describes a single key.
+type KeyLayout struct {
+	Name     string
+	Prefix   []byte
+	Segments []KeySegment
+}
+
+// KeyLayoutRegistry holds the key layouts of the modules' stores, by store name
+type KeyLayoutRegistry map[string][]KeyLayout
+
+// DecodeKey decodes the key with the layout of the longest matching prefix. The decoded key holds the name of the
+// layout under "type" and the decoded segments under their names. It returns false if no layout decodes the key.
+func DecodeKey(layouts []KeyLayout, key []byte) (map[string]string, bool) {
+	var layout *KeyLayout
+	for i := range layouts {
+		if bytes.HasPrefix(key, layouts[i].Prefix) && (layout == nil || len(layouts[i].Prefix) > len(layout.Prefix)) {
+			layout = &layouts[i]
+		}
+	}
+	if layout == nil {
+		return nil, false
+	}
+
+	decoded := map[string]string{"type": layout.Name}
+	rest := key[len(layout.Prefix):]
+	for _, segment := range layout.Segments {
+		value, n, err := decodeKeySegment(segment.Kind, rest)
+		if err != nil {
+			return nil, false
+		}
+		decoded[segment.Name] = value
+		rest = rest[n:]
+	}
+	if len(rest) > 0 {
+		return nil, false
+	}
+	return decoded, true
+}
+
+// decodeKeySegment decodes the segment at the start of the key, returning its decoded value and length
+func decodeKeySegment(kind KeySegmentKind, key []byte) (string, int, error) {
+	switch kind {
+	case KeyAccAddress, KeyValAddress, KeyConsAddress, KeyLengthPrefixedString:
+		if len(key) == 0 || len(key) < 1+int(key[0]) {
+			return "", 0, fmt.Errorf("invalid length-prefixed key segment")
+		}
+		bz := key[1 : 1+int(key[0])]
+		switch kind {
+		case KeyAccAddress:
+			return sdk.AccAddress(bz).String(), len(bz) + 1, nil
+		case KeyValAddress:
+			return sdk.ValAddress(bz).String(), len(bz) + 1, nil
+		case KeyConsAddress:
+			return sdk.ConsAddress(bz).String(), len(bz) + 1, nil
+		default:
+			return string(bz), len(bz) + 1, nil
+		}
+	case KeyUint64:
+		if len(key) < 8 {
+			return "", 0, fmt.Errorf("invalid uint64 key segment")
+		}
+		return strconv.FormatUint(binary.BigEndian.Uint64(key), 10), 8, nil
+	case KeyUint64LittleEndian:
+		if len(key) < 8 {
+			return "", 0, fmt.Errorf("invalid uint64 key segment")
+		}
+		return strconv.FormatUint(binary.LittleEndian.Uint64(key), 10), 8, nil
+	case KeyRawAccAddress:
+		if len(key) == 0 {
+			return "", 0, fmt.Errorf("empty address key segment")
+		}
+		return sdk.AccAddress(key).String(), len(key), nil
+	case KeyString:
+		return string(key), len(key), nil
+	case KeyBytes:
+		return fmt.Sprintf("%X", key), len(key), nil
+	default:
+		return "", 0, fmt.Errorf("unknown key segment kind %d", kind)
+	}
+}
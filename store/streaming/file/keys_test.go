@@ -0,0 +1,38 @@
+package file
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+func TestDecodeKey(t *testing.T) {
+	addr := sdk.AccAddress([]byte("addr1_______________"))
+	layouts := []KeyLayout{
+		{Name: "params", Prefix: []byte{0x00}},
+		{Name: "balance", Prefix: []byte{0x02}, Segments: []KeySegment{{Name: "address", Kind: KeyAccAddress}, {Name: "denom", Kind: KeyString}}},
+		{Name: "checkpoint", Prefix: []byte{0x02, 0xff}, Segments: []KeySegment{{Name: "height", Kind: KeyUint64}}},
+	}
+
+	decoded, ok := DecodeKey(layouts, append(append([]byte{0x02}, address.MustLengthPrefix(addr)...), "uatom"...))
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"type": "balance", "address": addr.String(), "denom": "uatom"}, decoded)
+
+	decoded, ok = DecodeKey(layouts, []byte{0x00})
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"type": "params"}, decoded)
+
+	// the layout of the longest matching prefix is used
+	decoded, ok = DecodeKey(layouts, append([]byte{0x02, 0xff}, sdk.Uint64ToBigEndian(10)...))
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"type": "checkpoint", "height": "10"}, decoded)
+
+	// keys without a layout, with trailing bytes or too short segments are not decoded
+	for _, key := range [][]byte{{0x01}, {0x00, 0x01}, {0x02, 0x14, 0x01}, {0x02, 0xff, 0x01}} {
+		_, ok = DecodeKey(layouts, key)
+		require.False(t, ok, key)
+	}
+}
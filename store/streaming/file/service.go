@@ -21,7 +21,6 @@ var _ baseapp.StreamingService = &StreamingService{}
 // StreamingService is a concrete implementation of StreamingService that writes state changes out to files
 type StreamingService struct {
 	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
-	srcChan            <-chan []byte                            // the channel that all the WriteListeners write their data out to
 	filePrefix         string                                   // optional prefix for each of the generated files
 	writeDir           string                                   // directory to write files into
 	codec              codec.BinaryCodec                        // marshaller used for re-marshalling the ABCI messages to write them out to the destination files
@@ -29,52 +28,65 @@ type StreamingService struct {
 	stateCacheLock     *sync.Mutex                              // mutex for the state cache
 	currentBlockNumber int64                                    // the current block number
 	currentTxIndex     int64                                    // the index of the current tx
+	haltOnError        bool                                     // halt the node when writing the files fails
+	fsync              bool                                     // sync every file to disk before closing it
+	format             OutputFormat                             // encoding of the written files
+	decoders           sdk.StoreDecoderRegistry                 // decoders of the state changes, used by the JSON Lines format
+	keyLayouts         KeyLayoutRegistry                        // key layouts of the stores, used by the JSON Lines format
 	quitChan           chan struct{}                            // channel to synchronize closure
 }
 
-// IntermediateWriter is used so that we do not need to update the underlying io.Writer
-// inside the StoreKVPairWriteListener everytime we begin writing to a new file
-type IntermediateWriter struct {
-	outChan chan<- []byte
-}
-
-// NewIntermediateWriter create an instance of an intermediateWriter that sends to the provided channel
-func NewIntermediateWriter(outChan chan<- []byte) *IntermediateWriter {
-	return &IntermediateWriter{
-		outChan: outChan,
-	}
+// stateCacheWriter is the io.Writer of the StoreKVPairWriteListener, it caches the length-prefixed binary encoded
+// StoreKVPairs synchronously as they are written, so they are available to the following ABCI listening hook
+type stateCacheWriter struct {
+	fss *StreamingService
 }
 
 // Write satisfies io.Writer
-func (iw *IntermediateWriter) Write(b []byte) (int, error) {
-	iw.outChan <- b
+func (w stateCacheWriter) Write(b []byte) (int, error) {
+	w.fss.stateCacheLock.Lock()
+	w.fss.stateCache = append(w.fss.stateCache, b)
+	w.fss.stateCacheLock.Unlock()
 	return len(b), nil
 }
 
 // NewStreamingService creates a new StreamingService for the provided writeDir, (optional) filePrefix, and storeKeys
-func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec) (*StreamingService, error) {
-	listenChan := make(chan []byte)
-	iw := NewIntermediateWriter(listenChan)
-	listener := types.NewStoreKVPairWriteListener(iw, c)
-	listeners := make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
-	// in this case, we are using the same listener for each Store
-	for _, key := range storeKeys {
-		listeners[key] = append(listeners[key], listener)
-	}
+// If haltOnError is true the node halts when the files cannot be written, if fsync is true every file is synced to disk
+// The JSON Lines format requires a codec.ProtoCodecMarshaler, which encodes the messages to JSON
+func NewStreamingService(writeDir, filePrefix string, storeKeys []types.StoreKey, c codec.BinaryCodec, haltOnError, fsync bool, format OutputFormat) (*StreamingService, error) {
 	// check that the writeDir exists and is writeable so that we can catch the error here at initialization if it is not
 	// we don't open a dstFile until we receive our first ABCI message
 	if err := isDirWriteable(writeDir); err != nil {
 		return nil, err
 	}
-	return &StreamingService{
-		listeners:      listeners,
-		srcChan:        listenChan,
+	fss := &StreamingService{
 		filePrefix:     filePrefix,
 		writeDir:       writeDir,
 		codec:          c,
 		stateCache:     make([][]byte, 0),
 		stateCacheLock: new(sync.Mutex),
-	}, nil
+		haltOnError:    haltOnError,
+		fsync:          fsync,
+		format:         format,
+	}
+	var listener types.WriteListener
+	switch format {
+	case OutputFormatBinary:
+		listener = types.NewStoreKVPairWriteListener(stateCacheWriter{fss: fss}, c)
+	case OutputFormatJSONLines:
+		if _, ok := c.(codec.ProtoCodecMarshaler); !ok {
+			return nil, fmt.Errorf("the %s output format requires a codec.ProtoCodecMarshaler, got %T", format, c)
+		}
+		listener = newJSONLinesWriteListener(stateCacheWriter{fss: fss}, fss)
+	default:
+		return nil, fmt.Errorf("unrecognized file streaming output format %d", format)
+	}
+	fss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	// in this case, we are using the same listener for each Store
+	for _, key := range storeKeys {
+		fss.listeners[key] = append(fss.listeners[key], listener)
+	}
+	return fss, nil
 }
 
 // Listeners satisfies the baseapp.StreamingService interface
@@ -94,7 +106,7 @@ func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestB
 		return err
 	}
 	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
+	lengthPrefixedReqBytes, err := fss.encodeMessage(requestLine, &req)
 	if err != nil {
 		return err
 	}
@@ -114,7 +126,7 @@ func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestB
 	fss.stateCache = nil
 	fss.stateCacheLock.Unlock()
 	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	lengthPrefixedResBytes, err := fss.encodeMessage(responseLine, &res)
 	if err != nil {
 		return err
 	}
@@ -122,17 +134,14 @@ func (fss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestB
 		return err
 	}
 	// close file
-	return dstFile.Close()
+	return fss.closeFile(dstFile)
 }
 
 func (fss *StreamingService) openBeginBlockFile(req abci.RequestBeginBlock) (*os.File, error) {
 	fss.currentBlockNumber = req.GetHeader().Height
 	fss.currentTxIndex = 0
-	fileName := fmt.Sprintf("block-%d-begin", fss.currentBlockNumber)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	fileName := fss.fileName(fmt.Sprintf("block-%d-begin", fss.currentBlockNumber))
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
 }
 
 // ListenDeliverTx satisfies the baseapp.ABCIListener interface
@@ -145,7 +154,7 @@ func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDe
 		return err
 	}
 	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
+	lengthPrefixedReqBytes, err := fss.encodeMessage(requestLine, &req)
 	if err != nil {
 		return err
 	}
@@ -165,7 +174,7 @@ func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDe
 	fss.stateCache = nil
 	fss.stateCacheLock.Unlock()
 	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	lengthPrefixedResBytes, err := fss.encodeMessage(responseLine, &res)
 	if err != nil {
 		return err
 	}
@@ -173,16 +182,13 @@ func (fss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDe
 		return err
 	}
 	// close file
-	return dstFile.Close()
+	return fss.closeFile(dstFile)
 }
 
 func (fss *StreamingService) openDeliverTxFile() (*os.File, error) {
-	fileName := fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
-	}
+	fileName := fss.fileName(fmt.Sprintf("block-%d-tx-%d", fss.currentBlockNumber, fss.currentTxIndex))
 	fss.currentTxIndex++
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
 }
 
 // ListenEndBlock satisfies the baseapp.ABCIListener interface
@@ -195,7 +201,7 @@ func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEnd
 		return err
 	}
 	// write req to file
-	lengthPrefixedReqBytes, err := fss.codec.MarshalLengthPrefixed(&req)
+	lengthPrefixedReqBytes, err := fss.encodeMessage(requestLine, &req)
 	if err != nil {
 		return err
 	}
@@ -215,7 +221,7 @@ func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEnd
 	fss.stateCache = nil
 	fss.stateCacheLock.Unlock()
 	// write res to file
-	lengthPrefixedResBytes, err := fss.codec.MarshalLengthPrefixed(&res)
+	lengthPrefixedResBytes, err := fss.encodeMessage(responseLine, &res)
 	if err != nil {
 		return err
 	}
@@ -223,50 +229,133 @@ func (fss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEnd
 		return err
 	}
 	// close file
-	return dstFile.Close()
+	return fss.closeFile(dstFile)
 }
 
 func (fss *StreamingService) openEndBlockFile() (*os.File, error) {
-	fileName := fmt.Sprintf("block-%d-end", fss.currentBlockNumber)
-	if fss.filePrefix != "" {
-		fileName = fmt.Sprintf("%s-%s", fss.filePrefix, fileName)
+	fileName := fss.fileName(fmt.Sprintf("block-%d-end", fss.currentBlockNumber))
+	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It writes the state changes written when committing the block and the received Commit response
+// out to the block-{N}-commit file, which marks the end of the block. The file is written to a
+// temporary file, synced to disk and renamed, so once it exists all the files of the block are complete
+func (fss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	fileName := fss.fileName(fmt.Sprintf("block-%d-commit", fss.currentBlockNumber))
+	tmpPath := filepath.Join(fss.writeDir, fileName+".tmp")
+	dstFile, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0o600)
+	if err != nil {
+		return err
+	}
+	// write all state changes cached for this stage to file
+	fss.stateCacheLock.Lock()
+	for _, stateChange := range fss.stateCache {
+		if _, err = dstFile.Write(stateChange); err != nil {
+			fss.stateCache = nil
+			fss.stateCacheLock.Unlock()
+			dstFile.Close()
+			return err
+		}
+	}
+	// reset cache
+	fss.stateCache = nil
+	fss.stateCacheLock.Unlock()
+	// write res to file
+	lengthPrefixedResBytes, err := fss.encodeMessage(responseLine, &res)
+	if err != nil {
+		dstFile.Close()
+		return err
+	}
+	if _, err = dstFile.Write(lengthPrefixedResBytes); err != nil {
+		dstFile.Close()
+		return err
+	}
+	// the end of block marker is always synced to disk
+	if err = dstFile.Sync(); err != nil {
+		dstFile.Close()
+		return err
+	}
+	if err = dstFile.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpPath, filepath.Join(fss.writeDir, fileName)); err != nil {
+		return err
 	}
-	return os.OpenFile(filepath.Join(fss.writeDir, fileName), os.O_CREATE|os.O_WRONLY, 0o600)
+	return syncDir(fss.writeDir)
+}
+
+// HaltAppOnDeliveryError satisfies the baseapp.ABCIListener interface
+// It returns true if the node halts when the files cannot be written
+func (fss *StreamingService) HaltAppOnDeliveryError() bool {
+	return fss.haltOnError
 }
 
 // Stream satisfies the baseapp.StreamingService interface
-// It spins up a goroutine select loop which awaits length-prefixed binary encoded KV pairs
-// and caches them in the order they were received
+// The state changes are cached synchronously as they are written, so there is no background processing to spin up
 // returns an error if it is called twice
 func (fss *StreamingService) Stream(wg *sync.WaitGroup) error {
 	if fss.quitChan != nil {
 		return errors.New("`Stream` has already been called. The stream needs to be closed before it can be started again")
 	}
 	fss.quitChan = make(chan struct{})
-	wg.Add(1)
-	go func() {
-		defer wg.Done()
-		for {
-			select {
-			case <-fss.quitChan:
-				fss.quitChan = nil
-				return
-			case by := <-fss.srcChan:
-				fss.stateCacheLock.Lock()
-				fss.stateCache = append(fss.stateCache, by)
-				fss.stateCacheLock.Unlock()
-			}
-		}
-	}()
 	return nil
 }
 
 // Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
 func (fss *StreamingService) Close() error {
-	close(fss.quitChan)
+	if fss.quitChan != nil {
+		close(fss.quitChan)
+		fss.quitChan = nil
+	}
 	return nil
 }
 
+// SetStoreDecoders sets the decoders of the modules' stores, used by the JSON Lines format to decode the state changes
+func (fss *StreamingService) SetStoreDecoders(decoders sdk.StoreDecoderRegistry) {
+	fss.decoders = decoders
+}
+
+// SetKeyLayouts sets the key layouts of the modules' stores, used by the JSON Lines format to decode the keys of the
+// state changes
+func (fss *StreamingService) SetKeyLayouts(layouts KeyLayoutRegistry) {
+	fss.keyLayouts = layouts
+}
+
+func (fss *StreamingService) fileName(name string) string {
+	if fss.format == OutputFormatJSONLines {
+		name += ".jsonl"
+	}
+	if fss.filePrefix != "" {
+		return fmt.Sprintf("%s-%s", fss.filePrefix, name)
+	}
+	return name
+}
+
+// closeFile closes the file, syncing it to disk first if fsync is enabled
+func (fss *StreamingService) closeFile(f *os.File) error {
+	if fss.fsync {
+		if err := f.Sync(); err != nil {
+			f.Close()
+			return err
+		}
+	}
+	return f.Close()
+}
+
+// syncDir syncs the directory to disk, so the files created or renamed in it are durable
+func syncDir(dir string) error {
+	d, err := os.Open(dir)
+	if err != nil {
+		return err
+	}
+	if err = d.Sync(); err != nil {
+		d.Close()
+		return err
+	}
+	return d.Close()
+}
+
 // isDirWriteable checks if dir is writable by writing and removing a file
 // to dir. It returns nil if dir is writable.
 func isDirWriteable(dir string) error {
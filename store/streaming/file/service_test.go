@@ -56,6 +56,10 @@ var (
 		ConsensusParamUpdates: &abci.ConsensusParams{},
 		ValidatorUpdates:      []abci.ValidatorUpdate{},
 	}
+	testCommitRes = abci.ResponseCommit{
+		Data:         mockHash,
+		RetainHeight: 1,
+	}
 	mockTxBytes1      = []byte{9, 8, 7, 6, 5, 4, 3, 2, 1}
 	testDeliverTxReq1 = abci.RequestDeliverTx{
 		Tx: mockTxBytes1,
@@ -104,25 +108,6 @@ var (
 	mockValue3 = []byte{5, 4, 3}
 )
 
-func TestIntermediateWriter(t *testing.T) {
-	outChan := make(chan []byte, 0)
-	iw := NewIntermediateWriter(outChan)
-	require.IsType(t, &IntermediateWriter{}, iw)
-	testBytes := []byte{1, 2, 3, 4, 5}
-	var length int
-	var err error
-	waitChan := make(chan struct{}, 0)
-	go func() {
-		length, err = iw.Write(testBytes)
-		waitChan <- struct{}{}
-	}()
-	receivedBytes := <-outChan
-	<-waitChan
-	require.Equal(t, len(testBytes), length)
-	require.Equal(t, testBytes, receivedBytes)
-	require.Nil(t, err)
-}
-
 func TestFileStreamingService(t *testing.T) {
 	if os.Getenv("CI") != "" {
 		t.Skip("Skipping TestFileStreamingService in CI environment")
@@ -132,12 +117,13 @@ func TestFileStreamingService(t *testing.T) {
 	defer os.RemoveAll(testDir)
 
 	testKeys := []types.StoreKey{mockStoreKey1, mockStoreKey2}
-	testStreamingService, err = NewStreamingService(testDir, testPrefix, testKeys, testMarshaller)
+	testStreamingService, err = NewStreamingService(testDir, testPrefix, testKeys, testMarshaller, true, true, OutputFormatBinary)
 	require.Nil(t, err)
 	require.IsType(t, &StreamingService{}, testStreamingService)
 	require.Equal(t, testPrefix, testStreamingService.filePrefix)
 	require.Equal(t, testDir, testStreamingService.writeDir)
 	require.Equal(t, testMarshaller, testStreamingService.codec)
+	require.True(t, testStreamingService.HaltAppOnDeliveryError())
 	testListener1 = testStreamingService.listeners[mockStoreKey1][0]
 	testListener2 = testStreamingService.listeners[mockStoreKey2][0]
 	wg := new(sync.WaitGroup)
@@ -146,6 +132,7 @@ func TestFileStreamingService(t *testing.T) {
 	testListenDeliverTx1(t)
 	testListenDeliverTx2(t)
 	testListenEndBlock(t)
+	testListenCommit(t)
 	testStreamingService.Close()
 	wg.Wait()
 }
@@ -366,6 +353,40 @@ func testListenEndBlock(t *testing.T) {
 	require.Equal(t, expectedEndBlockResBytes, segments[4])
 }
 
+func testListenCommit(t *testing.T) {
+	expectedCommitResBytes, err := testMarshaller.Marshal(&testCommitRes)
+	require.Nil(t, err)
+
+	// write state changes
+	testListener1.OnWrite(mockStoreKey1, mockKey1, nil, true)
+
+	// expected KV pairs
+	expectedKVPair1, err := testMarshaller.Marshal(&types.StoreKVPair{
+		StoreKey: mockStoreKey1.Name(),
+		Key:      mockKey1,
+		Delete:   true,
+	})
+	require.Nil(t, err)
+
+	// send the ABCI messages
+	err = testStreamingService.ListenCommit(emptyContext, testCommitRes)
+	require.Nil(t, err)
+
+	// load the end of block marker, checking that it was created with the expected name and the temporary file is gone
+	fileName := fmt.Sprintf("%s-block-%d-commit", testPrefix, testBeginBlockReq.GetHeader().Height)
+	fileBytes, err := readInFile(fileName)
+	require.Nil(t, err)
+	_, err = readInFile(fileName + ".tmp")
+	require.True(t, os.IsNotExist(err))
+
+	// segment the file into the separate gRPC messages and check the correctness of each
+	segments, err := segmentBytes(fileBytes)
+	require.Nil(t, err)
+	require.Equal(t, 2, len(segments))
+	require.Equal(t, expectedKVPair1, segments[0])
+	require.Equal(t, expectedCommitResBytes, segments[1])
+}
+
 func readInFile(name string) ([]byte, error) {
 	path := filepath.Join(testDir, name)
 	return os.ReadFile(path)
@@ -0,0 +1,217 @@
+package streaming
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/gogo/protobuf/proto"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	serverTypes "github.com/cosmos/cosmos-sdk/server/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/spf13/cast"
+)
+
+// StoreFilter selects the writes of a KVStore which are streamed. A write is selected if its key starts with one
+// of the Prefixes and ends with one of the Suffixes, empty lists select every write. The TypeURLs are bound to the
+// Prefixes by index: TypeURLs[i] is the type of the values stored under Prefixes[i], and a write under Prefixes[i]
+// is only selected if its value is a message of this type, or an Any packing it. An empty type URL selects every
+// value of its prefix. Deletes carry no value, so the TypeURLs are not applied to them.
+type StoreFilter struct {
+	Prefixes     [][]byte
+	Suffixes     [][]byte
+	TypeURLs     []string
+	messageTypes []reflect.Type
+}
+
+// NewStoreFilter creates a new StoreFilter, returning an error if the type URLs are not bound to the prefixes or
+// one of them is not a registered message
+func NewStoreFilter(prefixes, suffixes [][]byte, typeURLs []string) (*StoreFilter, error) {
+	if len(typeURLs) > 0 && len(typeURLs) != len(prefixes) {
+		return nil, fmt.Errorf("streaming filter type URLs must be bound to the key prefixes, got %d type URLs for %d prefixes", len(typeURLs), len(prefixes))
+	}
+	f := &StoreFilter{
+		Prefixes:     prefixes,
+		Suffixes:     suffixes,
+		TypeURLs:     typeURLs,
+		messageTypes: make([]reflect.Type, len(typeURLs)),
+	}
+	for i, typeURL := range typeURLs {
+		if typeURL == "" {
+			continue
+		}
+		messageType := proto.MessageType(strings.TrimPrefix(typeURL, "/"))
+		if messageType == nil {
+			return nil, fmt.Errorf("unrecognized streaming filter type URL %s", typeURL)
+		}
+		f.messageTypes[i] = messageType
+	}
+	return f, nil
+}
+
+// Match returns true if the write is selected by the filter
+func (f *StoreFilter) Match(key []byte, value []byte, delete bool) bool {
+	if len(f.Suffixes) > 0 && !matchAny(f.Suffixes, func(suffix []byte) bool { return bytes.HasSuffix(key, suffix) }) {
+		return false
+	}
+	if len(f.Prefixes) == 0 {
+		return true
+	}
+	for i, prefix := range f.Prefixes {
+		if !bytes.HasPrefix(key, prefix) {
+			continue
+		}
+		if len(f.TypeURLs) == 0 || f.messageTypes[i] == nil || delete {
+			return true
+		}
+		if decodesTo(value, f.messageTypes[i]) || decodesToAny(value, f.TypeURLs[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+func matchAny(list [][]byte, match func([]byte) bool) bool {
+	for _, ele := range list {
+		if match(ele) {
+			return true
+		}
+	}
+	return false
+}
+
+// decodesTo returns true if the value is the canonical encoding of a message of the provided type. Since
+// decoding alone is lenient with unknown fields, the decoded message must encode back to the same value.
+func decodesTo(value []byte, messageType reflect.Type) bool {
+	msg, ok := reflect.New(messageType.Elem()).Interface().(proto.Message)
+	if !ok || proto.Unmarshal(value, msg) != nil {
+		return false
+	}
+	bz, err := proto.Marshal(msg)
+	return err == nil && bytes.Equal(bz, value)
+}
+
+// decodesToAny returns true if the value is an Any packing a message of the provided type URL, as interfaces
+// (e.g. accounts) are stored
+func decodesToAny(value []byte, typeURL string) bool {
+	var anyValue codectypes.Any
+	if proto.Unmarshal(value, &anyValue) != nil || anyValue.TypeUrl != typeURL {
+		return false
+	}
+	bz, err := proto.Marshal(&anyValue)
+	return err == nil && bytes.Equal(bz, value)
+}
+
+// FilteredWriteListener is a WriteListener which only passes the writes selected by a StoreFilter to the
+// underlying WriteListener
+type FilteredWriteListener struct {
+	listener types.WriteListener
+	filter   *StoreFilter
+}
+
+// NewFilteredWriteListener wraps the provided WriteListener with the provided StoreFilter
+func NewFilteredWriteListener(listener types.WriteListener, filter *StoreFilter) *FilteredWriteListener {
+	return &FilteredWriteListener{
+		listener: listener,
+		filter:   filter,
+	}
+}
+
+// OnWrite satisfies the WriteListener interface by passing the selected writes to the underlying WriteListener
+func (fl *FilteredWriteListener) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	if !fl.filter.Match(key, value, delete) {
+		return nil
+	}
+	return fl.listener.OnWrite(storeKey, key, value, delete)
+}
+
+// filteredStreamingService wraps a StreamingService, filtering the writes its listeners receive
+type filteredStreamingService struct {
+	baseapp.StreamingService
+	listeners map[types.StoreKey][]types.WriteListener
+}
+
+// NewFilteredStreamingService wraps the provided StreamingService, so the listeners of the stores with a StoreFilter
+// only receive the writes selected by it. The listeners of the other stores receive every write.
+func NewFilteredStreamingService(service baseapp.StreamingService, filters map[types.StoreKey]*StoreFilter) baseapp.StreamingService {
+	listeners := make(map[types.StoreKey][]types.WriteListener, len(service.Listeners()))
+	for key, storeListeners := range service.Listeners() {
+		filter, ok := filters[key]
+		if !ok {
+			listeners[key] = storeListeners
+			continue
+		}
+		for _, listener := range storeListeners {
+			listeners[key] = append(listeners[key], NewFilteredWriteListener(listener, filter))
+		}
+	}
+	return &filteredStreamingService{
+		StreamingService: service,
+		listeners:        listeners,
+	}
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the filtered WriteListeners of the underlying StreamingService
+func (fss *filteredStreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return fss.listeners
+}
+
+// SetStoreDecoders satisfies the StoreDecodingService interface
+// It sets the store decoders on the underlying StreamingService, if it decodes the state changes
+func (fss *filteredStreamingService) SetStoreDecoders(decoders sdk.StoreDecoderRegistry) {
+	SetStoreDecoders([]baseapp.StreamingService{fss.StreamingService}, decoders)
+}
+
+// SetKeyLayouts satisfies the KeyDecodingService interface
+// It sets the key layouts on the underlying StreamingService, if it decodes the keys of the state changes
+func (fss *filteredStreamingService) SetKeyLayouts(layouts file.KeyLayoutRegistry) {
+	SetKeyLayouts([]baseapp.StreamingService{fss.StreamingService}, layouts)
+}
+
+// LoadStoreFilters loads the StoreFilters of the streaming service from the AppOptions, the filter of a store is
+// configured in `streamers.x.filters.{store key}`, the prefixes and suffixes are hex encoded
+func LoadStoreFilters(opts serverTypes.AppOptions, streamerName string, keys []types.StoreKey) (map[types.StoreKey]*StoreFilter, error) {
+	filters := make(map[types.StoreKey]*StoreFilter)
+	for _, key := range keys {
+		optPrefix := fmt.Sprintf("streamers.%s.filters.%s", streamerName, key.Name())
+		prefixes, err := decodeHexList(cast.ToStringSlice(opts.Get(optPrefix + ".prefixes")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s.prefixes: %w", optPrefix, err)
+		}
+		suffixes, err := decodeHexList(cast.ToStringSlice(opts.Get(optPrefix + ".suffixes")))
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s.suffixes: %w", optPrefix, err)
+		}
+		typeURLs := cast.ToStringSlice(opts.Get(optPrefix + ".type_urls"))
+		if len(prefixes) == 0 && len(suffixes) == 0 && len(typeURLs) == 0 {
+			continue
+		}
+
+		filter, err := NewStoreFilter(prefixes, suffixes, typeURLs)
+		if err != nil {
+			return nil, err
+		}
+		filters[key] = filter
+	}
+	return filters, nil
+}
+
+func decodeHexList(list []string) ([][]byte, error) {
+	decoded := make([][]byte, 0, len(list))
+	for _, ele := range list {
+		bz, err := hex.DecodeString(ele)
+		if err != nil {
+			return nil, err
+		}
+		decoded = append(decoded, bz)
+	}
+	return decoded, nil
+}
@@ -0,0 +1,128 @@
+package streaming
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codecTypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/streaming/file"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type mapOptions map[string]interface{}
+
+func (m mapOptions) Get(key string) interface{} { return m[key] }
+
+// recordingListener records the keys of the writes it receives
+type recordingListener struct {
+	keys [][]byte
+}
+
+func (l *recordingListener) OnWrite(_ types.StoreKey, key []byte, _ []byte, _ bool) error {
+	l.keys = append(l.keys, key)
+	return nil
+}
+
+func TestStoreFilter(t *testing.T) {
+	coin := sdk.NewInt64Coin("uatom", 10)
+	coinBz, err := testMarshaller.Marshal(&coin)
+	require.NoError(t, err)
+	anyCoin, err := codecTypes.NewAnyWithValue(&coin)
+	require.NoError(t, err)
+	anyCoinBz, err := testMarshaller.Marshal(anyCoin)
+	require.NoError(t, err)
+	pair := types.StoreKVPair{StoreKey: "mockKey1", Key: []byte{1}, Value: []byte{2}}
+	pairBz, err := testMarshaller.Marshal(&pair)
+	require.NoError(t, err)
+
+	decCoin := sdk.NewInt64DecCoin("uatom", 10)
+	decCoinBz, err := testMarshaller.Marshal(&decCoin)
+	require.NoError(t, err)
+
+	_, err = NewStoreFilter([][]byte{{0x01}}, nil, []string{"/unknown.Type"})
+	require.Error(t, err)
+	// type URLs must be bound to key prefixes
+	_, err = NewStoreFilter(nil, nil, []string{"/cosmos.base.v1beta1.Coin"})
+	require.Error(t, err)
+	_, err = NewStoreFilter([][]byte{{0x01}, {0x02}}, nil, []string{"/cosmos.base.v1beta1.Coin"})
+	require.Error(t, err)
+
+	prefixFilter, err := NewStoreFilter([][]byte{{0x02}, {0x03}}, nil, nil)
+	require.NoError(t, err)
+	require.True(t, prefixFilter.Match([]byte{0x02, 0x01}, coinBz, false))
+	require.True(t, prefixFilter.Match([]byte{0x03}, nil, true))
+	require.False(t, prefixFilter.Match([]byte{0x01, 0x02}, coinBz, false))
+
+	suffixFilter, err := NewStoreFilter([][]byte{{0x02}}, [][]byte{[]byte("uatom")}, nil)
+	require.NoError(t, err)
+	require.True(t, suffixFilter.Match(append([]byte{0x02, 0x01}, "uatom"...), coinBz, false))
+	require.False(t, suffixFilter.Match(append([]byte{0x02, 0x01}, "stake"...), coinBz, false))
+	require.False(t, suffixFilter.Match(append([]byte{0x01}, "uatom"...), coinBz, false))
+
+	typeFilter, err := NewStoreFilter([][]byte{{0x01}, {0x03}}, nil, []string{"/cosmos.base.v1beta1.Coin", ""})
+	require.NoError(t, err)
+	require.True(t, typeFilter.Match([]byte{0x01}, coinBz, false))
+	require.True(t, typeFilter.Match([]byte{0x01}, anyCoinBz, false))
+	require.False(t, typeFilter.Match([]byte{0x01}, pairBz, false))
+	require.False(t, typeFilter.Match([]byte{0x01}, []byte{0xff}, false))
+	// an empty type URL selects every value of its prefix
+	require.True(t, typeFilter.Match([]byte{0x03}, pairBz, false))
+	// the type of deleted values is unknown, so deletes under the prefix are always selected
+	require.True(t, typeFilter.Match([]byte{0x01}, nil, true))
+	require.False(t, typeFilter.Match([]byte{0x02}, nil, true))
+
+	// a DecCoin has the same field layout as a Coin, so its encoding decodes to a Coin, but it is not selected
+	// outside of the prefix the Coin type is bound to
+	var decoded sdk.Coin
+	require.NoError(t, testMarshaller.Unmarshal(decCoinBz, &decoded))
+	require.True(t, typeFilter.Match([]byte{0x01}, decCoinBz, false))
+	require.False(t, typeFilter.Match([]byte{0x02}, decCoinBz, false))
+	decCoinFilter, err := NewStoreFilter([][]byte{{0x01}, {0x02}}, nil, []string{"/cosmos.base.v1beta1.Coin", "/cosmos.base.v1beta1.DecCoin"})
+	require.NoError(t, err)
+	require.True(t, decCoinFilter.Match([]byte{0x02}, decCoinBz, false))
+	require.False(t, decCoinFilter.Match([]byte{0x02}, anyCoinBz, false))
+}
+
+func TestFilteredStreamingService(t *testing.T) {
+	service, err := file.NewStreamingService(t.TempDir(), "", mockKeys, testMarshaller, false, false, file.OutputFormatBinary)
+	require.NoError(t, err)
+
+	listener := &recordingListener{}
+	filter, err := NewStoreFilter([][]byte{{0x02}}, nil, nil)
+	require.NoError(t, err)
+	filtered := NewFilteredStreamingService(service, map[types.StoreKey]*StoreFilter{mockKeys[0]: filter})
+
+	// the listeners of the filtered store are wrapped, the others are left untouched
+	require.IsType(t, &FilteredWriteListener{}, filtered.Listeners()[mockKeys[0]][0])
+	require.Equal(t, service.Listeners()[mockKeys[1]], filtered.Listeners()[mockKeys[1]])
+
+	filteredListener := NewFilteredWriteListener(listener, filter)
+	require.NoError(t, filteredListener.OnWrite(mockKeys[0], []byte{0x01}, []byte{1}, false))
+	require.NoError(t, filteredListener.OnWrite(mockKeys[0], []byte{0x02}, []byte{1}, false))
+	require.Equal(t, [][]byte{{0x02}}, listener.keys)
+}
+
+func TestLoadStoreFilters(t *testing.T) {
+	filters, err := LoadStoreFilters(mapOptions{
+		"streamers.file.filters.mockKey1.prefixes":  []interface{}{"02", "0a"},
+		"streamers.file.filters.mockKey1.suffixes":  []interface{}{"7561746f6d"},
+		"streamers.file.filters.mockKey1.type_urls": []interface{}{"/cosmos.base.v1beta1.Coin", ""},
+	}, "file", mockKeys)
+	require.NoError(t, err)
+	require.Len(t, filters, 1)
+	require.Equal(t, [][]byte{{0x02}, {0x0a}}, filters[mockKeys[0]].Prefixes)
+	require.Equal(t, [][]byte{[]byte("uatom")}, filters[mockKeys[0]].Suffixes)
+	require.Equal(t, []string{"/cosmos.base.v1beta1.Coin", ""}, filters[mockKeys[0]].TypeURLs)
+
+	_, err = LoadStoreFilters(mapOptions{"streamers.file.filters.mockKey2.prefixes": []interface{}{"zz"}}, "file", mockKeys)
+	require.Error(t, err)
+	_, err = LoadStoreFilters(mapOptions{
+		"streamers.file.filters.mockKey2.prefixes":  []interface{}{"02"},
+		"streamers.file.filters.mockKey2.type_urls": []interface{}{"/unknown.Type"},
+	}, "file", mockKeys)
+	require.Error(t, err)
+	_, err = LoadStoreFilters(mapOptions{"streamers.file.filters.mockKey2.type_urls": []interface{}{"/cosmos.base.v1beta1.Coin"}}, "file", mockKeys)
+	require.Error(t, err)
+}
@@ -0,0 +1,156 @@
+package plugin
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+const (
+	// CoreProtocolVersion is the version of the handshake printed by plugins
+	CoreProtocolVersion = 1
+	// AppProtocolVersion is the version of the ABCIListenerService
+	AppProtocolVersion = 1
+
+	// MagicCookieKey and MagicCookieValue are set in the environment of the
+	// launched plugin executable, so it can verify it is run as a plugin
+	MagicCookieKey   = "COSMOS_SDK_STREAMING_PLUGIN"
+	MagicCookieValue = "abci_listener"
+)
+
+// handshakeTimeout bounds the time the plugin executable has to print the handshake line
+var handshakeTimeout = 10 * time.Second
+
+// Serve serves the ABCIListenerService implementation as a plugin, it is meant
+// to be called from the main function of the plugin executable. It listens on a
+// random local port and prints the handshake line with its address to stdout, in
+// the format CORE-PROTOCOL-VERSION|APP-PROTOCOL-VERSION|NETWORK|ADDRESS|grpc.
+// It blocks until the server stops.
+func Serve(srv ABCIListenerServiceServer) error {
+	if os.Getenv(MagicCookieKey) != MagicCookieValue {
+		return errors.New("this binary is a plugin of the streaming service, it is not meant to be executed directly")
+	}
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return err
+	}
+
+	server := grpc.NewServer(grpc.MaxRecvMsgSize(math.MaxInt32))
+	RegisterABCIListenerServiceServer(server, srv)
+
+	fmt.Printf("%d|%d|%s|%s|grpc\n", CoreProtocolVersion, AppProtocolVersion, listener.Addr().Network(), listener.Addr().String())
+	return server.Serve(listener)
+}
+
+// pluginProcess is the plugin executable launched by the StreamingService
+type pluginProcess struct {
+	cmd     *exec.Cmd
+	network string
+	address string
+}
+
+// startPlugin launches the plugin executable and reads the address it serves on
+// from the handshake line printed to its stdout
+func startPlugin(command string) (*pluginProcess, error) {
+	args := strings.Fields(command)
+	if len(args) == 0 {
+		return nil, errors.New("plugin streaming service command is empty")
+	}
+
+	cmd := exec.Command(args[0], args[1:]...) //nolint:gosec // the command is configured by the node operator
+	cmd.Env = append(os.Environ(), fmt.Sprintf("%s=%s", MagicCookieKey, MagicCookieValue))
+	cmd.Stderr = os.Stderr
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, err
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, fmt.Errorf("failed to start streaming plugin %s: %w", args[0], err)
+	}
+
+	plugin := &pluginProcess{cmd: cmd}
+
+	lines := make(chan string, 1)
+	reader := bufio.NewReader(stdout)
+	go func() {
+		line, _ := reader.ReadString('\n')
+		lines <- line
+		// keep draining the output, so the plugin never blocks on writing to stdout
+		_, _ = io.Copy(io.Discard, reader)
+	}()
+
+	select {
+	case line := <-lines:
+		plugin.network, plugin.address, err = parseHandshake(line)
+	case <-time.After(handshakeTimeout):
+		err = fmt.Errorf("timeout waiting for the handshake of streaming plugin %s", args[0])
+	}
+	if err != nil {
+		plugin.stop()
+		return nil, err
+	}
+
+	return plugin, nil
+}
+
+// stop kills the plugin process and waits for it to exit
+func (p *pluginProcess) stop() {
+	_ = p.cmd.Process.Kill()
+	_ = p.cmd.Wait()
+}
+
+// parseHandshake parses the handshake line printed by the plugin executable
+func parseHandshake(line string) (network string, address string, err error) {
+	parts := strings.Split(strings.TrimSpace(line), "|")
+	if len(parts) != 5 {
+		return "", "", fmt.Errorf("invalid streaming plugin handshake: %q", line)
+	}
+	if parts[0] != fmt.Sprint(CoreProtocolVersion) {
+		return "", "", fmt.Errorf("unsupported streaming plugin core protocol version %s, expected %d", parts[0], CoreProtocolVersion)
+	}
+	if parts[1] != fmt.Sprint(AppProtocolVersion) {
+		return "", "", fmt.Errorf("unsupported streaming plugin app protocol version %s, expected %d", parts[1], AppProtocolVersion)
+	}
+	if parts[2] != "tcp" && parts[2] != "unix" {
+		return "", "", fmt.Errorf("unsupported streaming plugin network %s", parts[2])
+	}
+	if parts[4] != "grpc" {
+		return "", "", fmt.Errorf("unsupported streaming plugin protocol %s, expected grpc", parts[4])
+	}
+
+	return parts[2], parts[3], nil
+}
+
+// parseAddress splits the configured address of the consumer into the network
+// and the address within it
+func parseAddress(address string) (network string, addr string) {
+	if strings.HasPrefix(address, "unix://") {
+		return "unix", strings.TrimPrefix(address, "unix://")
+	}
+	return "tcp", address
+}
+
+// dial creates the client connection to the consumer, the connection is
+// established in the background, so an unavailable consumer does not prevent
+// the node from starting
+func dial(network, address string) (*grpc.ClientConn, error) {
+	return grpc.Dial(
+		address,
+		grpc.WithInsecure(),
+		grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return (&net.Dialer{}).DialContext(ctx, network, addr)
+		}),
+		grpc.WithDefaultCallOptions(grpc.MaxCallSendMsgSize(math.MaxInt32)),
+	)
+}
@@ -0,0 +1,395 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// retryInterval is the time to wait before redelivering a message which was not
+// acknowledged by the consumer, when the stall policy is used
+var retryInterval = 500 * time.Millisecond
+
+// ErrDropped is returned by the ABCI listening hooks when the message is dropped,
+// because the consumer does not keep up with the stream and the drop policy is used
+var ErrDropped = errors.New("plugin streaming service: message dropped, consumer does not keep up")
+
+var errClosed = errors.New("plugin streaming service is closed")
+
+// Policy defines how the StreamingService handles a consumer which does not keep
+// up with the stream
+type Policy int
+
+const (
+	// PolicyStall blocks the ABCI message processing while the buffer is full and
+	// redelivers messages until they are acknowledged, so nothing is lost
+	PolicyStall Policy = iota
+	// PolicyDrop drops messages which do not fit into the full buffer, or which
+	// are not acknowledged in time, so the state machine is never slowed down
+	PolicyDrop
+)
+
+// PolicyFromString returns the Policy corresponding to the provided name
+func PolicyFromString(name string) (Policy, error) {
+	switch strings.ToLower(name) {
+	case "", "stall":
+		return PolicyStall, nil
+	case "drop":
+		return PolicyDrop, nil
+	default:
+		return PolicyStall, fmt.Errorf("unrecognized plugin streaming policy %s", name)
+	}
+}
+
+// String returns the string name of a Policy
+func (p Policy) String() string {
+	switch p {
+	case PolicyStall:
+		return "stall"
+	case PolicyDrop:
+		return "drop"
+	default:
+		return "unknown"
+	}
+}
+
+// Config defines the configuration of the StreamingService
+type Config struct {
+	// Address of an already running consumer, either host:port or unix://path
+	Address string
+	// Command launching the plugin executable, which reports the address it
+	// serves on through the handshake line, used if Address is empty
+	Command string
+	// BufferSize is the number of messages buffered for the consumer
+	BufferSize int
+	// AckTimeout bounds the time the consumer has to acknowledge a message, no
+	// timeout if zero
+	AckTimeout time.Duration
+	// Policy applied when the consumer does not keep up
+	Policy Policy
+	// HaltOnError halts the node when a message is dropped, or when the commit of a block
+	// is not acknowledged because the service is closed, so no block is streamed partially
+	HaltOnError bool
+}
+
+// delivery sends a single message to the consumer and awaits the acknowledgement
+type delivery func(ctx context.Context, client ABCIListenerServiceClient) error
+
+// queuedDelivery is a delivery awaiting in the queue, done receives the outcome of
+// the delivery if it is not nil
+type queuedDelivery struct {
+	send delivery
+	done chan<- error
+}
+
+// StreamingService is a concrete implementation of StreamingService that pushes state changes and ABCI messages
+// to an out-of-process consumer over gRPC
+type StreamingService struct {
+	listeners          map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	config             Config                                   // configuration of the service
+	conn               *grpc.ClientConn                         // connection to the consumer
+	client             ABCIListenerServiceClient                // client of the consumer
+	plugin             *pluginProcess                           // plugin process launched by the service, nil if connected to Address
+	stateCache         []*types.StoreKVPair                     // cache the StoreKVPairs in the order they are received
+	stateCacheLock     *sync.Mutex                              // mutex for the state cache
+	currentBlockNumber int64                                    // the current block number
+	currentTxIndex     int64                                    // the index of the current tx
+	queue              chan queuedDelivery                      // messages awaiting delivery to the consumer
+	dropped            uint64                                   // number of dropped messages
+	blockDropped       uint64                                   // number of dropped messages when the current block began
+	streaming          bool                                     // true once Stream was called
+	quitChan           chan struct{}                            // channel to synchronize closure
+	closeOnce          sync.Once                                // ensures the service is closed once
+}
+
+// NewStreamingService creates a new StreamingService for the provided configuration and storeKeys. The plugin
+// executable is launched, if configured, and the connection to the consumer is established lazily.
+func NewStreamingService(config Config, storeKeys []types.StoreKey) (*StreamingService, error) {
+	if config.BufferSize < 0 {
+		return nil, fmt.Errorf("plugin streaming service buffer size cannot be negative: %d", config.BufferSize)
+	}
+	if config.AckTimeout < 0 {
+		return nil, fmt.Errorf("plugin streaming service ack timeout cannot be negative: %s", config.AckTimeout)
+	}
+
+	var (
+		plugin *pluginProcess
+		err    error
+	)
+	network, address := parseAddress(config.Address)
+	if len(config.Address) == 0 {
+		if len(config.Command) == 0 {
+			return nil, errors.New("plugin streaming service requires either address or command")
+		}
+		if plugin, err = startPlugin(config.Command); err != nil {
+			return nil, err
+		}
+		network, address = plugin.network, plugin.address
+	}
+
+	conn, err := dial(network, address)
+	if err != nil {
+		if plugin != nil {
+			plugin.stop()
+		}
+		return nil, err
+	}
+
+	pss := &StreamingService{
+		config:         config,
+		conn:           conn,
+		client:         NewABCIListenerServiceClient(conn),
+		plugin:         plugin,
+		stateCacheLock: new(sync.Mutex),
+		queue:          make(chan queuedDelivery, config.BufferSize),
+		quitChan:       make(chan struct{}),
+	}
+	// in this case, we are using the service itself as the listener of each Store
+	pss.listeners = make(map[types.StoreKey][]types.WriteListener, len(storeKeys))
+	for _, key := range storeKeys {
+		pss.listeners[key] = append(pss.listeners[key], pss)
+	}
+
+	return pss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (pss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return pss.listeners
+}
+
+// OnWrite satisfies the types.WriteListener interface
+// It caches the state change until the ABCI message which caused it is streamed
+func (pss *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	kvPair := &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte(nil), key...),
+		Value:    append([]byte(nil), value...),
+	}
+
+	pss.stateCacheLock.Lock()
+	pss.stateCache = append(pss.stateCache, kvPair)
+	pss.stateCacheLock.Unlock()
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It pushes the received BeginBlock request and response and the resulting state changes to the consumer
+func (pss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	pss.currentBlockNumber = req.GetHeader().Height
+	pss.currentTxIndex = 0
+	pss.blockDropped = pss.Dropped()
+
+	msg := &ListenBeginBlockRequest{
+		BlockHeight: pss.currentBlockNumber,
+		Request:     &req,
+		Response:    &res,
+		ChangeSet:   pss.flushStateCache(),
+	}
+	return pss.enqueue(func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenBeginBlock(ctx, msg)
+		return err
+	}, nil)
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It pushes the received DeliverTx request and response and the resulting state changes to the consumer
+func (pss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	msg := &ListenDeliverTxRequest{
+		BlockHeight: pss.currentBlockNumber,
+		TxIndex:     pss.currentTxIndex,
+		Request:     &req,
+		Response:    &res,
+		ChangeSet:   pss.flushStateCache(),
+	}
+	pss.currentTxIndex++
+
+	return pss.enqueue(func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenDeliverTx(ctx, msg)
+		return err
+	}, nil)
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It pushes the received EndBlock request and response and the resulting state changes to the consumer
+func (pss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	msg := &ListenEndBlockRequest{
+		BlockHeight: pss.currentBlockNumber,
+		Request:     &req,
+		Response:    &res,
+		ChangeSet:   pss.flushStateCache(),
+	}
+	return pss.enqueue(func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenEndBlock(ctx, msg)
+		return err
+	}, nil)
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It pushes the received Commit response and the state changes written when committing the block to the consumer.
+// If HaltOnError is set, it waits until the consumer acknowledged every message of the block, returning an error
+// if any of them was dropped
+func (pss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	msg := &ListenCommitRequest{
+		BlockHeight: pss.currentBlockNumber,
+		Response:    &res,
+		ChangeSet:   pss.flushStateCache(),
+	}
+	send := func(ctx context.Context, client ABCIListenerServiceClient) error {
+		_, err := client.ListenCommit(ctx, msg)
+		return err
+	}
+	if !pss.config.HaltOnError {
+		return pss.enqueue(send, nil)
+	}
+
+	// the messages are delivered in order, so the block is complete once its commit is delivered
+	done := make(chan error, 1)
+	if err := pss.enqueue(send, done); err != nil {
+		return err
+	}
+	select {
+	case err := <-done:
+		if err != nil {
+			return err
+		}
+	case <-pss.quitChan:
+		return errClosed
+	}
+	if dropped := pss.Dropped() - pss.blockDropped; dropped > 0 {
+		return fmt.Errorf("plugin streaming service: %d messages of block %d were dropped", dropped, pss.currentBlockNumber)
+	}
+	return nil
+}
+
+// HaltAppOnDeliveryError satisfies the baseapp.ABCIListener interface
+// It returns true if the node halts when the messages are not delivered to the consumer
+func (pss *StreamingService) HaltAppOnDeliveryError() bool {
+	return pss.config.HaltOnError
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// It spins up a goroutine which delivers the buffered messages to the consumer in the order they were received
+// returns an error if it is called twice
+func (pss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if pss.streaming {
+		return errors.New("`Stream` has already been called")
+	}
+	pss.streaming = true
+
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case <-pss.quitChan:
+				return
+			case d := <-pss.queue:
+				err := pss.deliver(d.send)
+				if d.done != nil {
+					d.done <- err
+				}
+			}
+		}
+	}()
+	return nil
+}
+
+// Dropped returns the number of messages dropped since the service was created
+func (pss *StreamingService) Dropped() uint64 {
+	return atomic.LoadUint64(&pss.dropped)
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+// It stops the delivery, closes the connection to the consumer and stops the plugin process, if launched
+func (pss *StreamingService) Close() error {
+	var err error
+	pss.closeOnce.Do(func() {
+		close(pss.quitChan)
+		err = pss.conn.Close()
+		if pss.plugin != nil {
+			pss.plugin.stop()
+		}
+	})
+	return err
+}
+
+func (pss *StreamingService) flushStateCache() []*types.StoreKVPair {
+	pss.stateCacheLock.Lock()
+	defer pss.stateCacheLock.Unlock()
+
+	changeSet := pss.stateCache
+	pss.stateCache = nil
+	return changeSet
+}
+
+// enqueue buffers the message for delivery, blocking while the buffer is full
+// in the stall policy and dropping the message in the drop policy
+func (pss *StreamingService) enqueue(d delivery, done chan<- error) error {
+	item := queuedDelivery{send: d, done: done}
+	if pss.config.Policy == PolicyDrop {
+		select {
+		case pss.queue <- item:
+			return nil
+		default:
+			pss.drop()
+			return ErrDropped
+		}
+	}
+
+	select {
+	case pss.queue <- item:
+		return nil
+	case <-pss.quitChan:
+		return errClosed
+	}
+}
+
+// deliver sends the message to the consumer, redelivering it until it is
+// acknowledged in the stall policy and dropping it on failure in the drop policy
+func (pss *StreamingService) deliver(d delivery) error {
+	for {
+		ctx, cancel := context.Background(), func() {}
+		if pss.config.AckTimeout > 0 {
+			ctx, cancel = context.WithTimeout(ctx, pss.config.AckTimeout)
+		}
+		err := d(ctx, pss.client)
+		cancel()
+
+		if err == nil {
+			return nil
+		}
+		if pss.config.Policy == PolicyDrop {
+			pss.drop()
+			return ErrDropped
+		}
+
+		select {
+		case <-pss.quitChan:
+			return errClosed
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
+func (pss *StreamingService) drop() {
+	atomic.AddUint64(&pss.dropped, 1)
+	telemetry.IncrCounter(1, "streaming", "plugin", "dropped")
+}
@@ -0,0 +1,288 @@
+package plugin
+
+import (
+	"context"
+	"errors"
+	"math"
+	"net"
+	"os"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	"google.golang.org/grpc"
+
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+var (
+	mockStoreKey1 = sdk.NewKVStoreKey("mockStore1")
+	mockStoreKey2 = sdk.NewKVStoreKey("mockStore2")
+	testStoreKeys = []types.StoreKey{mockStoreKey1, mockStoreKey2}
+
+	testBeginBlockReq = abci.RequestBeginBlock{Header: tmproto.Header{Height: 1}}
+	testBeginBlockRes = abci.ResponseBeginBlock{Events: []abci.Event{{Type: "testEventType1"}}}
+	testDeliverTxReq  = abci.RequestDeliverTx{Tx: []byte{1, 2, 3}}
+	testDeliverTxRes  = abci.ResponseDeliverTx{Code: 1, Log: "mockLog"}
+	testEndBlockReq   = abci.RequestEndBlock{Height: 1}
+	testEndBlockRes   = abci.ResponseEndBlock{Events: []abci.Event{{Type: "testEventType2"}}}
+	testCommitRes     = abci.ResponseCommit{Data: []byte{1, 2, 3}}
+)
+
+// TestMain runs the test binary as the streaming plugin when launched by the
+// service under test
+func TestMain(m *testing.M) {
+	if os.Getenv(MagicCookieKey) == MagicCookieValue {
+		if err := Serve(newRecordingConsumer()); err != nil {
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+// recordingConsumer records the received messages, failing the first
+// `failures` deliveries and delaying each acknowledgement by `delay`
+type recordingConsumer struct {
+	mtx      sync.Mutex
+	received []interface{}
+	failures int
+	delay    time.Duration
+}
+
+func newRecordingConsumer() *recordingConsumer {
+	return &recordingConsumer{}
+}
+
+func (c *recordingConsumer) record(msg interface{}) (*ListenResponse, error) {
+	time.Sleep(c.delay)
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if c.failures > 0 {
+		c.failures--
+		return nil, errors.New("consumer failure")
+	}
+	c.received = append(c.received, msg)
+	return &ListenResponse{}, nil
+}
+
+func (c *recordingConsumer) ListenBeginBlock(_ context.Context, req *ListenBeginBlockRequest) (*ListenResponse, error) {
+	return c.record(req)
+}
+
+func (c *recordingConsumer) ListenDeliverTx(_ context.Context, req *ListenDeliverTxRequest) (*ListenResponse, error) {
+	return c.record(req)
+}
+
+func (c *recordingConsumer) ListenEndBlock(_ context.Context, req *ListenEndBlockRequest) (*ListenResponse, error) {
+	return c.record(req)
+}
+
+func (c *recordingConsumer) ListenCommit(_ context.Context, req *ListenCommitRequest) (*ListenResponse, error) {
+	return c.record(req)
+}
+
+func (c *recordingConsumer) messages() []interface{} {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return append([]interface{}(nil), c.received...)
+}
+
+func startConsumer(t *testing.T, consumer *recordingConsumer) string {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer(grpc.MaxRecvMsgSize(math.MaxInt32))
+	RegisterABCIListenerServiceServer(server, consumer)
+	go func() { _ = server.Serve(listener) }()
+	t.Cleanup(server.Stop)
+
+	return listener.Addr().String()
+}
+
+func startService(t *testing.T, config Config) *StreamingService {
+	service, err := NewStreamingService(config, testStoreKeys)
+	require.NoError(t, err)
+
+	wg := new(sync.WaitGroup)
+	require.NoError(t, service.Stream(wg))
+	require.Error(t, service.Stream(wg))
+	t.Cleanup(func() {
+		require.NoError(t, service.Close())
+		wg.Wait()
+	})
+
+	return service
+}
+
+func TestPluginStreamingService(t *testing.T) {
+	consumer := newRecordingConsumer()
+	service := startService(t, Config{Address: startConsumer(t, consumer), BufferSize: 10, AckTimeout: time.Second})
+
+	// every store is listened by the service
+	for _, key := range testStoreKeys {
+		require.Len(t, service.Listeners()[key], 1)
+	}
+
+	ctx := sdk.Context{}
+	require.NoError(t, service.OnWrite(mockStoreKey1, []byte{1}, []byte{2}, false))
+	require.NoError(t, service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, service.OnWrite(mockStoreKey2, []byte{3}, nil, true))
+	require.NoError(t, service.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, service.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes))
+	require.NoError(t, service.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+	require.NoError(t, service.OnWrite(mockStoreKey1, []byte{4}, []byte{5}, false))
+	require.NoError(t, service.ListenCommit(ctx, testCommitRes))
+
+	require.Eventually(t, func() bool { return len(consumer.messages()) == 5 }, 5*time.Second, 10*time.Millisecond)
+	messages := consumer.messages()
+
+	beginBlock := messages[0].(*ListenBeginBlockRequest)
+	require.Equal(t, int64(1), beginBlock.BlockHeight)
+	require.Equal(t, testBeginBlockRes, *beginBlock.Response)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: "mockStore1", Key: []byte{1}, Value: []byte{2}}}, beginBlock.ChangeSet)
+
+	deliverTx := messages[1].(*ListenDeliverTxRequest)
+	require.Equal(t, int64(0), deliverTx.TxIndex)
+	require.Equal(t, testDeliverTxReq, *deliverTx.Request)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: "mockStore2", Delete: true, Key: []byte{3}}}, deliverTx.ChangeSet)
+	require.Equal(t, int64(1), messages[2].(*ListenDeliverTxRequest).TxIndex)
+	require.Empty(t, messages[2].(*ListenDeliverTxRequest).ChangeSet)
+
+	endBlock := messages[3].(*ListenEndBlockRequest)
+	require.Equal(t, int64(1), endBlock.BlockHeight)
+	require.Equal(t, testEndBlockRes, *endBlock.Response)
+
+	commit := messages[4].(*ListenCommitRequest)
+	require.Equal(t, int64(1), commit.BlockHeight)
+	require.Equal(t, testCommitRes, *commit.Response)
+	require.Equal(t, []*types.StoreKVPair{{StoreKey: "mockStore1", Key: []byte{4}, Value: []byte{5}}}, commit.ChangeSet)
+	require.Zero(t, service.Dropped())
+	require.False(t, service.HaltAppOnDeliveryError())
+}
+
+func TestPluginStreamingServiceHaltOnError(t *testing.T) {
+	consumer := newRecordingConsumer()
+	service := startService(t, Config{Address: startConsumer(t, consumer), HaltOnError: true})
+	require.True(t, service.HaltAppOnDeliveryError())
+
+	// the commit returns once every message of the block is acknowledged
+	ctx := sdk.Context{}
+	require.NoError(t, service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, service.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+	require.NoError(t, service.ListenCommit(ctx, testCommitRes))
+	require.Len(t, consumer.messages(), 3)
+}
+
+func TestPluginStreamingServiceHaltOnErrorDropped(t *testing.T) {
+	consumer := newRecordingConsumer()
+	consumer.failures = 1
+	service := startService(t, Config{Address: startConsumer(t, consumer), BufferSize: 10, Policy: PolicyDrop, HaltOnError: true})
+
+	// the commit of a block with a dropped message fails
+	ctx := sdk.Context{}
+	require.NoError(t, service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, service.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+	require.Error(t, service.ListenCommit(ctx, testCommitRes))
+
+	// the next block is complete again
+	require.NoError(t, service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, service.ListenCommit(ctx, testCommitRes))
+}
+
+func TestPluginStreamingServiceStallPolicyRedelivers(t *testing.T) {
+	retryInterval = 10 * time.Millisecond
+
+	consumer := newRecordingConsumer()
+	consumer.failures = 3
+	service := startService(t, Config{Address: startConsumer(t, consumer), Policy: PolicyStall})
+
+	ctx := sdk.Context{}
+	require.NoError(t, service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, service.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+
+	// failed deliveries are retried, nothing is lost nor reordered
+	require.Eventually(t, func() bool { return len(consumer.messages()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.IsType(t, &ListenBeginBlockRequest{}, consumer.messages()[0])
+	require.IsType(t, &ListenEndBlockRequest{}, consumer.messages()[1])
+	require.Zero(t, service.Dropped())
+}
+
+func TestPluginStreamingServiceDropPolicy(t *testing.T) {
+	consumer := newRecordingConsumer()
+	consumer.delay = 200 * time.Millisecond
+	service := startService(t, Config{
+		Address:    startConsumer(t, consumer),
+		BufferSize: 1,
+		AckTimeout: 50 * time.Millisecond,
+		Policy:     PolicyDrop,
+	})
+
+	// the slow consumer never acknowledges in time and the buffer fills up, so
+	// messages are dropped without blocking the caller
+	ctx := sdk.Context{}
+	dropped := 0
+	for i := 0; i < 10; i++ {
+		if err := service.ListenDeliverTx(ctx, testDeliverTxReq, testDeliverTxRes); err != nil {
+			require.ErrorIs(t, err, ErrDropped)
+			dropped++
+		}
+	}
+	require.Positive(t, dropped)
+	require.Eventually(t, func() bool { return service.Dropped() == 10 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestPluginStreamingServiceLaunchesPlugin(t *testing.T) {
+	executable, err := os.Executable()
+	require.NoError(t, err)
+
+	service := startService(t, Config{Command: executable, BufferSize: 10, AckTimeout: 5 * time.Second, Policy: PolicyDrop})
+	require.NotNil(t, service.plugin)
+
+	// messages are acknowledged by the plugin process
+	ctx := sdk.Context{}
+	require.NoError(t, service.ListenBeginBlock(ctx, testBeginBlockReq, testBeginBlockRes))
+	require.NoError(t, service.ListenEndBlock(ctx, testEndBlockReq, testEndBlockRes))
+	require.Never(t, func() bool { return service.Dropped() > 0 }, 500*time.Millisecond, 10*time.Millisecond)
+}
+
+func TestNewPluginStreamingServiceValidation(t *testing.T) {
+	_, err := NewStreamingService(Config{}, testStoreKeys)
+	require.Error(t, err)
+	_, err = NewStreamingService(Config{Address: "127.0.0.1:1", BufferSize: -1}, testStoreKeys)
+	require.Error(t, err)
+	_, err = NewStreamingService(Config{Command: "/nonexistent/streaming/plugin"}, testStoreKeys)
+	require.Error(t, err)
+}
+
+func TestParseHandshake(t *testing.T) {
+	network, address, err := parseHandshake("1|1|tcp|127.0.0.1:1234|grpc\n")
+	require.NoError(t, err)
+	require.Equal(t, "tcp", network)
+	require.Equal(t, "127.0.0.1:1234", address)
+
+	network, address, err = parseHandshake("1|1|unix|/tmp/plugin.sock|grpc")
+	require.NoError(t, err)
+	require.Equal(t, "unix", network)
+	require.Equal(t, "/tmp/plugin.sock", address)
+
+	for _, line := range []string{"", "1|1|tcp|127.0.0.1:1234", "2|1|tcp|127.0.0.1:1234|grpc", "1|2|tcp|127.0.0.1:1234|grpc", "1|1|udp|127.0.0.1:1234|grpc", "1|1|tcp|127.0.0.1:1234|netrpc"} {
+		_, _, err = parseHandshake(line)
+		require.Error(t, err, line)
+	}
+}
+
+func TestPolicyFromString(t *testing.T) {
+	for name, expected := range map[string]Policy{"": PolicyStall, "stall": PolicyStall, "DROP": PolicyDrop} {
+		policy, err := PolicyFromString(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, policy)
+	}
+	_, err := PolicyFromString("unknown")
+	require.Error(t, err)
+}
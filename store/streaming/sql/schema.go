@@ -0,0 +1,139 @@
+package sql
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Dialect defines the SQL dialect of the database the StreamingService writes to
+type Dialect int
+
+const (
+	// DialectSQLite is the dialect of SQLite databases, e.g. with the github.com/mattn/go-sqlite3 or modernc.org/sqlite driver
+	DialectSQLite Dialect = iota
+	// DialectPostgres is the dialect of PostgreSQL databases, e.g. with the github.com/lib/pq driver
+	DialectPostgres
+)
+
+// DialectFromDriver returns the Dialect of the provided database/sql driver name
+func DialectFromDriver(driver string) (Dialect, error) {
+	switch strings.ToLower(driver) {
+	case "sqlite", "sqlite3":
+		return DialectSQLite, nil
+	case "postgres", "postgresql", "pgx":
+		return DialectPostgres, nil
+	default:
+		return DialectSQLite, fmt.Errorf("unsupported sql streaming service driver %s, expected sqlite3 or postgres", driver)
+	}
+}
+
+// String returns the string name of a Dialect
+func (d Dialect) String() string {
+	switch d {
+	case DialectSQLite:
+		return "sqlite"
+	case DialectPostgres:
+		return "postgres"
+	default:
+		return "unknown"
+	}
+}
+
+// rebind replaces the ? placeholders of the query with the placeholders of the dialect
+func (d Dialect) rebind(query string) string {
+	if d != DialectPostgres {
+		return query
+	}
+
+	var (
+		sb strings.Builder
+		n  int
+	)
+	for _, r := range query {
+		if r != '?' {
+			sb.WriteRune(r)
+			continue
+		}
+		n++
+		fmt.Fprintf(&sb, "$%d", n)
+	}
+	return sb.String()
+}
+
+// timeType returns the column type of timestamps
+func (d Dialect) timeType() string {
+	if d == DialectPostgres {
+		return "TIMESTAMPTZ"
+	}
+	return "TIMESTAMP"
+}
+
+// schema returns the statements creating the tables of the indexer, the amounts and shares are stored as text
+// since they may overflow the integer types of the databases
+func (d Dialect) schema() []string {
+	return []string{
+		fmt.Sprintf(`CREATE TABLE IF NOT EXISTS blocks (
+	height BIGINT PRIMARY KEY,
+	chain_id TEXT NOT NULL,
+	time %s NOT NULL,
+	proposer_address TEXT NOT NULL,
+	app_hash TEXT NOT NULL,
+	num_txs BIGINT NOT NULL
+)`, d.timeType()),
+		`CREATE TABLE IF NOT EXISTS txs (
+	height BIGINT NOT NULL,
+	tx_index BIGINT NOT NULL,
+	hash TEXT NOT NULL,
+	code BIGINT NOT NULL,
+	codespace TEXT NOT NULL,
+	log TEXT NOT NULL,
+	gas_wanted BIGINT NOT NULL,
+	gas_used BIGINT NOT NULL,
+	PRIMARY KEY (height, tx_index)
+)`,
+		`CREATE INDEX IF NOT EXISTS txs_hash ON txs (hash)`,
+		`CREATE TABLE IF NOT EXISTS messages (
+	height BIGINT NOT NULL,
+	tx_index BIGINT NOT NULL,
+	msg_index BIGINT NOT NULL,
+	type_url TEXT NOT NULL,
+	json TEXT,
+	PRIMARY KEY (height, tx_index, msg_index)
+)`,
+		`CREATE INDEX IF NOT EXISTS messages_type_url ON messages (type_url)`,
+		`CREATE TABLE IF NOT EXISTS events (
+	height BIGINT NOT NULL,
+	source TEXT NOT NULL,
+	tx_index BIGINT NOT NULL,
+	event_index BIGINT NOT NULL,
+	type TEXT NOT NULL,
+	PRIMARY KEY (height, source, tx_index, event_index)
+)`,
+		`CREATE INDEX IF NOT EXISTS events_type ON events (type)`,
+		`CREATE TABLE IF NOT EXISTS event_attributes (
+	height BIGINT NOT NULL,
+	source TEXT NOT NULL,
+	tx_index BIGINT NOT NULL,
+	event_index BIGINT NOT NULL,
+	attribute_index BIGINT NOT NULL,
+	key TEXT NOT NULL,
+	value TEXT NOT NULL,
+	PRIMARY KEY (height, source, tx_index, event_index, attribute_index)
+)`,
+		`CREATE INDEX IF NOT EXISTS event_attributes_key_value ON event_attributes (key, value)`,
+		`CREATE TABLE IF NOT EXISTS balances (
+	address TEXT NOT NULL,
+	denom TEXT NOT NULL,
+	amount TEXT NOT NULL,
+	height BIGINT NOT NULL,
+	PRIMARY KEY (address, denom)
+)`,
+		`CREATE TABLE IF NOT EXISTS delegations (
+	delegator_address TEXT NOT NULL,
+	validator_address TEXT NOT NULL,
+	shares TEXT NOT NULL,
+	height BIGINT NOT NULL,
+	PRIMARY KEY (delegator_address, validator_address)
+)`,
+	}
+}
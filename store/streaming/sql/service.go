@@ -0,0 +1,357 @@
+package sql
+
+import (
+	"bytes"
+	dbsql "database/sql"
+	"errors"
+	"fmt"
+	"sync"
+
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	tmtypes "github.com/tendermint/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+var _ baseapp.StreamingService = &StreamingService{}
+
+// sources of the events
+const (
+	sourceBeginBlock = "begin_block"
+	sourceTx         = "tx"
+	sourceEndBlock   = "end_block"
+)
+
+// deliveredTx is a transaction of the block being processed
+type deliveredTx struct {
+	req abci.RequestDeliverTx
+	res abci.ResponseDeliverTx
+}
+
+// StreamingService is a concrete implementation of StreamingService that indexes blocks, transactions, messages,
+// events and the balances and delegations into a relational database
+type StreamingService struct {
+	listeners        map[types.StoreKey][]types.WriteListener // the listeners that will be initialized with BaseApp
+	db               *dbsql.DB                                // database the data is written to
+	dialect          Dialect                                  // SQL dialect of the database
+	codec            codec.ProtoCodecMarshaler                // codec used to decode the messages and the state changes
+	haltOnError      bool                                     // halt the node when indexing a block fails
+	header           tmproto.Header                           // header of the block being processed
+	beginBlockEvents []abci.Event                             // events of the BeginBlock of the block being processed
+	endBlockEvents   []abci.Event                             // events of the EndBlock of the block being processed
+	txs              []deliveredTx                            // transactions of the block being processed
+	stateCache       []*types.StoreKVPair                     // cache the indexed StoreKVPairs in the order they are received
+	stateCacheLock   *sync.Mutex                              // mutex for the state cache
+	streaming        bool                                     // true once Stream was called
+}
+
+// NewStreamingService creates a new StreamingService writing to the provided database, which is opened with a
+// database/sql driver of the provided dialect. The tables are created if they do not exist yet.
+// The balances are indexed if the bank store is in storeKeys, the delegations if the staking store is.
+func NewStreamingService(db *dbsql.DB, dialect Dialect, storeKeys []types.StoreKey, c codec.BinaryCodec, haltOnError bool) (*StreamingService, error) {
+	cdc, ok := c.(codec.ProtoCodecMarshaler)
+	if !ok {
+		return nil, fmt.Errorf("sql streaming service requires a codec.ProtoCodecMarshaler, got %T", c)
+	}
+
+	for _, stmt := range dialect.schema() {
+		if _, err := db.Exec(stmt); err != nil {
+			return nil, fmt.Errorf("failed to create the sql streaming service schema: %w", err)
+		}
+	}
+
+	sss := &StreamingService{
+		db:             db,
+		dialect:        dialect,
+		codec:          cdc,
+		haltOnError:    haltOnError,
+		stateCacheLock: new(sync.Mutex),
+	}
+	// in this case, we are using the service itself as the listener of the indexed Stores
+	sss.listeners = make(map[types.StoreKey][]types.WriteListener)
+	for _, key := range storeKeys {
+		if key.Name() == banktypes.StoreKey || key.Name() == stakingtypes.StoreKey {
+			sss.listeners[key] = append(sss.listeners[key], sss)
+		}
+	}
+
+	return sss, nil
+}
+
+// Listeners satisfies the baseapp.StreamingService interface
+// It returns the StreamingService's underlying WriteListeners
+// Use for registering the underlying WriteListeners with the BaseApp
+func (sss *StreamingService) Listeners() map[types.StoreKey][]types.WriteListener {
+	return sss.listeners
+}
+
+// OnWrite satisfies the types.WriteListener interface
+// It caches the writes of the balances and the delegations until the block is committed
+func (sss *StreamingService) OnWrite(storeKey types.StoreKey, key []byte, value []byte, delete bool) error {
+	switch {
+	case storeKey.Name() == banktypes.StoreKey && bytes.HasPrefix(key, banktypes.BalancesPrefix):
+	case storeKey.Name() == stakingtypes.StoreKey && bytes.HasPrefix(key, stakingtypes.DelegationKey):
+	default:
+		return nil
+	}
+
+	kvPair := &types.StoreKVPair{
+		StoreKey: storeKey.Name(),
+		Delete:   delete,
+		Key:      append([]byte(nil), key...),
+		Value:    append([]byte(nil), value...),
+	}
+
+	sss.stateCacheLock.Lock()
+	sss.stateCache = append(sss.stateCache, kvPair)
+	sss.stateCacheLock.Unlock()
+	return nil
+}
+
+// ListenBeginBlock satisfies the baseapp.ABCIListener interface
+// It starts a new block, the block is indexed once it is committed
+func (sss *StreamingService) ListenBeginBlock(ctx sdk.Context, req abci.RequestBeginBlock, res abci.ResponseBeginBlock) error {
+	sss.header = req.Header
+	sss.beginBlockEvents = res.Events
+	sss.endBlockEvents = nil
+	sss.txs = nil
+	return nil
+}
+
+// ListenDeliverTx satisfies the baseapp.ABCIListener interface
+// It adds the transaction to the block being processed
+func (sss *StreamingService) ListenDeliverTx(ctx sdk.Context, req abci.RequestDeliverTx, res abci.ResponseDeliverTx) error {
+	sss.txs = append(sss.txs, deliveredTx{req: req, res: res})
+	return nil
+}
+
+// ListenEndBlock satisfies the baseapp.ABCIListener interface
+// It adds the EndBlock events to the block being processed
+func (sss *StreamingService) ListenEndBlock(ctx sdk.Context, req abci.RequestEndBlock, res abci.ResponseEndBlock) error {
+	sss.endBlockEvents = res.Events
+	return nil
+}
+
+// ListenCommit satisfies the baseapp.ABCIListener interface
+// It indexes the committed block within a single database transaction, so either the whole block is indexed or none
+// of it. The rows of the block are replaced if it was indexed before, e.g. when a block is replayed.
+func (sss *StreamingService) ListenCommit(ctx sdk.Context, res abci.ResponseCommit) error {
+	sss.stateCacheLock.Lock()
+	changeSet := sss.stateCache
+	sss.stateCache = nil
+	sss.stateCacheLock.Unlock()
+
+	dbTx, err := sss.db.Begin()
+	if err != nil {
+		return err
+	}
+	if err := sss.indexBlock(dbTx, res, changeSet); err != nil {
+		_ = dbTx.Rollback()
+		return fmt.Errorf("failed to index block %d: %w", sss.header.Height, err)
+	}
+	return dbTx.Commit()
+}
+
+// HaltAppOnDeliveryError satisfies the baseapp.ABCIListener interface
+// It returns true if the node halts when a block cannot be indexed
+func (sss *StreamingService) HaltAppOnDeliveryError() bool {
+	return sss.haltOnError
+}
+
+// Stream satisfies the baseapp.StreamingService interface
+// The blocks are indexed synchronously when they are committed, so there is no background processing to spin up
+// returns an error if it is called twice
+func (sss *StreamingService) Stream(wg *sync.WaitGroup) error {
+	if sss.streaming {
+		return errors.New("`Stream` has already been called")
+	}
+	sss.streaming = true
+	return nil
+}
+
+// Close satisfies the io.Closer interface, which satisfies the baseapp.StreamingService interface
+func (sss *StreamingService) Close() error {
+	return sss.db.Close()
+}
+
+func (sss *StreamingService) exec(dbTx *dbsql.Tx, query string, args ...interface{}) error {
+	_, err := dbTx.Exec(sss.dialect.rebind(query), args...)
+	return err
+}
+
+func (sss *StreamingService) indexBlock(dbTx *dbsql.Tx, res abci.ResponseCommit, changeSet []*types.StoreKVPair) error {
+	height := sss.header.Height
+
+	// remove the rows of a previous indexing of the block
+	for _, table := range []string{"blocks", "txs", "messages", "events", "event_attributes"} {
+		if err := sss.exec(dbTx, fmt.Sprintf("DELETE FROM %s WHERE height = ?", table), height); err != nil {
+			return err
+		}
+	}
+
+	err := sss.exec(dbTx,
+		"INSERT INTO blocks (height, chain_id, time, proposer_address, app_hash, num_txs) VALUES (?, ?, ?, ?, ?, ?)",
+		height, sss.header.ChainID, sss.header.Time.UTC(), fmt.Sprintf("%X", sss.header.ProposerAddress), fmt.Sprintf("%X", res.Data), len(sss.txs),
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := sss.indexEvents(dbTx, sourceBeginBlock, -1, sss.beginBlockEvents); err != nil {
+		return err
+	}
+	for i, tx := range sss.txs {
+		if err := sss.indexTx(dbTx, int64(i), tx); err != nil {
+			return err
+		}
+	}
+	if err := sss.indexEvents(dbTx, sourceEndBlock, -1, sss.endBlockEvents); err != nil {
+		return err
+	}
+
+	for _, kvPair := range changeSet {
+		switch kvPair.StoreKey {
+		case banktypes.StoreKey:
+			err = sss.indexBalance(dbTx, kvPair)
+		case stakingtypes.StoreKey:
+			err = sss.indexDelegation(dbTx, kvPair)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (sss *StreamingService) indexTx(dbTx *dbsql.Tx, txIndex int64, tx deliveredTx) error {
+	err := sss.exec(dbTx,
+		"INSERT INTO txs (height, tx_index, hash, code, codespace, log, gas_wanted, gas_used) VALUES (?, ?, ?, ?, ?, ?, ?, ?)",
+		sss.header.Height, txIndex, fmt.Sprintf("%X", tmtypes.Tx(tx.req.Tx).Hash()), int64(tx.res.Code), tx.res.Codespace, tx.res.Log, tx.res.GasWanted, tx.res.GasUsed,
+	)
+	if err != nil {
+		return err
+	}
+
+	for i, msg := range sss.decodeMessages(tx.req.Tx) {
+		// the message is stored without its JSON representation if its type is not registered
+		var msgJSON interface{}
+		if bz, err := sss.codec.MarshalJSON(msg); err == nil {
+			msgJSON = string(bz)
+		}
+		err = sss.exec(dbTx,
+			"INSERT INTO messages (height, tx_index, msg_index, type_url, json) VALUES (?, ?, ?, ?, ?)",
+			sss.header.Height, txIndex, int64(i), msg.TypeUrl, msgJSON,
+		)
+		if err != nil {
+			return err
+		}
+	}
+
+	return sss.indexEvents(dbTx, sourceTx, txIndex, tx.res.Events)
+}
+
+// decodeMessages returns the messages of a protobuf encoded transaction, nil if it cannot be decoded. The messages
+// are not unpacked, so the transactions with messages of unregistered types are indexed too.
+func (sss *StreamingService) decodeMessages(txBytes []byte) []*codectypes.Any {
+	var raw txtypes.TxRaw
+	if err := raw.Unmarshal(txBytes); err != nil {
+		return nil
+	}
+	var body txtypes.TxBody
+	if err := body.Unmarshal(raw.BodyBytes); err != nil {
+		return nil
+	}
+	return body.Messages
+}
+
+func (sss *StreamingService) indexEvents(dbTx *dbsql.Tx, source string, txIndex int64, events []abci.Event) error {
+	for i, event := range events {
+		err := sss.exec(dbTx,
+			"INSERT INTO events (height, source, tx_index, event_index, type) VALUES (?, ?, ?, ?, ?)",
+			sss.header.Height, source, txIndex, int64(i), event.Type,
+		)
+		if err != nil {
+			return err
+		}
+		for j, attr := range event.Attributes {
+			err = sss.exec(dbTx,
+				"INSERT INTO event_attributes (height, source, tx_index, event_index, attribute_index, key, value) VALUES (?, ?, ?, ?, ?, ?, ?)",
+				sss.header.Height, source, txIndex, int64(i), int64(j), string(attr.Key), string(attr.Value),
+			)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// indexBalance upserts or removes the balance written to the bank store, the key being
+// BalancesPrefix | len(address) | address | denom
+func (sss *StreamingService) indexBalance(dbTx *dbsql.Tx, kvPair *types.StoreKVPair) error {
+	key := kvPair.Key[len(banktypes.BalancesPrefix):]
+	addr, err := banktypes.AddressFromBalancesStore(key)
+	if err != nil {
+		return err
+	}
+	address := sdk.AccAddress(addr).String()
+	denom := string(key[1+len(addr):])
+
+	if kvPair.Delete {
+		return sss.exec(dbTx, "DELETE FROM balances WHERE address = ? AND denom = ?", address, denom)
+	}
+
+	var balance sdk.Coin
+	if err := sss.codec.Unmarshal(kvPair.Value, &balance); err != nil {
+		return err
+	}
+	return sss.exec(dbTx,
+		`INSERT INTO balances (address, denom, amount, height) VALUES (?, ?, ?, ?)
+ON CONFLICT (address, denom) DO UPDATE SET amount = excluded.amount, height = excluded.height`,
+		address, denom, balance.Amount.String(), sss.header.Height,
+	)
+}
+
+// indexDelegation upserts or removes the delegation written to the staking store, the key being
+// DelegationKey | len(delegator) | delegator | len(validator) | validator
+func (sss *StreamingService) indexDelegation(dbTx *dbsql.Tx, kvPair *types.StoreKVPair) error {
+	delAddr, valAddr, err := parseDelegationKey(kvPair.Key[len(stakingtypes.DelegationKey):])
+	if err != nil {
+		return err
+	}
+	delegator, validator := delAddr.String(), valAddr.String()
+
+	if kvPair.Delete {
+		return sss.exec(dbTx, "DELETE FROM delegations WHERE delegator_address = ? AND validator_address = ?", delegator, validator)
+	}
+
+	var delegation stakingtypes.Delegation
+	if err := sss.codec.Unmarshal(kvPair.Value, &delegation); err != nil {
+		return err
+	}
+	return sss.exec(dbTx,
+		`INSERT INTO delegations (delegator_address, validator_address, shares, height) VALUES (?, ?, ?, ?)
+ON CONFLICT (delegator_address, validator_address) DO UPDATE SET shares = excluded.shares, height = excluded.height`,
+		delegator, validator, delegation.Shares.String(), sss.header.Height,
+	)
+}
+
+func parseDelegationKey(key []byte) (sdk.AccAddress, sdk.ValAddress, error) {
+	if len(key) == 0 || len(key) < 1+int(key[0])+1 {
+		return nil, nil, fmt.Errorf("invalid delegation key %X", key)
+	}
+	delLen := int(key[0])
+	valLen := int(key[1+delLen])
+	if len(key) != 2+delLen+valLen {
+		return nil, nil, fmt.Errorf("invalid delegation key %X", key)
+	}
+	return sdk.AccAddress(key[1 : 1+delLen]), sdk.ValAddress(key[2+delLen:]), nil
+}
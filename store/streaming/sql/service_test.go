@@ -0,0 +1,230 @@
+package sql
+
+import (
+	"bytes"
+	dbsql "database/sql"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	abci "github.com/tendermint/tendermint/abci/types"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	_ "modernc.org/sqlite"
+)
+
+var (
+	bankKey    = types.NewKVStoreKey(banktypes.StoreKey)
+	stakingKey = types.NewKVStoreKey(stakingtypes.StoreKey)
+	otherKey   = types.NewKVStoreKey("other")
+
+	addr1 = sdk.AccAddress(bytes.Repeat([]byte{1}, 20))
+	addr2 = sdk.AccAddress(bytes.Repeat([]byte{2}, 20))
+	val1  = sdk.ValAddress(bytes.Repeat([]byte{3}, 20))
+
+	testTime = time.Date(2022, 1, 2, 3, 4, 5, 0, time.UTC)
+)
+
+// openSQLite opens a SQLite database in a temporary directory with the pure Go modernc.org/sqlite driver
+func openSQLite(t *testing.T) *dbsql.DB {
+	db, err := dbsql.Open("sqlite", filepath.Join(t.TempDir(), "index.db"))
+	require.NoError(t, err)
+	return db
+}
+
+// queryRows returns the rows of the query, with their columns by name
+func queryRows(t *testing.T, db *dbsql.DB, query string) []map[string]interface{} {
+	rows, err := db.Query(query)
+	require.NoError(t, err)
+	defer rows.Close()
+
+	columns, err := rows.Columns()
+	require.NoError(t, err)
+	var result []map[string]interface{}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		ptrs := make([]interface{}, len(columns))
+		for i := range values {
+			ptrs[i] = &values[i]
+		}
+		require.NoError(t, rows.Scan(ptrs...))
+		row := make(map[string]interface{}, len(columns))
+		for i, column := range columns {
+			row[column] = values[i]
+		}
+		result = append(result, row)
+	}
+	require.NoError(t, rows.Err())
+	return result
+}
+
+func testCodec() codec.ProtoCodecMarshaler {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	return codec.NewProtoCodec(registry)
+}
+
+func testTx(t *testing.T, cdc codec.ProtoCodecMarshaler) []byte {
+	msg, err := codectypes.NewAnyWithValue(banktypes.NewMsgSend(addr1, addr2, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))))
+	require.NoError(t, err)
+	// the type of this message is not registered, it is indexed without its JSON representation
+	unknown := &codectypes.Any{TypeUrl: "/unknown.Msg", Value: []byte{1}}
+	body, err := cdc.Marshal(&txtypes.TxBody{Messages: []*codectypes.Any{msg, unknown}})
+	require.NoError(t, err)
+	txBytes, err := cdc.Marshal(&txtypes.TxRaw{BodyBytes: body})
+	require.NoError(t, err)
+	return txBytes
+}
+
+// runBlock runs the ABCI listener hooks and the writes of a block with a single transaction
+func runBlock(t *testing.T, sss *StreamingService, cdc codec.ProtoCodecMarshaler, txBytes []byte) {
+	ctx := sdk.Context{}
+	header := tmproto.Header{Height: 1, ChainID: "test-chain", Time: testTime, ProposerAddress: []byte{0xab}}
+	require.NoError(t, sss.ListenBeginBlock(ctx, abci.RequestBeginBlock{Header: header}, abci.ResponseBeginBlock{
+		Events: []abci.Event{{Type: "mint", Attributes: []abci.EventAttribute{{Key: []byte("amount"), Value: []byte("10stake")}}}},
+	}))
+	require.NoError(t, sss.ListenDeliverTx(ctx, abci.RequestDeliverTx{Tx: txBytes}, abci.ResponseDeliverTx{
+		GasWanted: 200000,
+		GasUsed:   50000,
+		Log:       "it's ok",
+		Events: []abci.Event{{Type: "transfer", Attributes: []abci.EventAttribute{
+			{Key: []byte("recipient"), Value: []byte(addr2.String())},
+			{Key: []byte("amount"), Value: []byte("5stake")},
+		}}},
+	}))
+	require.NoError(t, sss.ListenEndBlock(ctx, abci.RequestEndBlock{Height: 1}, abci.ResponseEndBlock{}))
+
+	balance1 := sdk.NewInt64Coin("stake", 95)
+	balance1Bz, err := cdc.Marshal(&balance1)
+	require.NoError(t, err)
+	balance2 := sdk.NewInt64Coin("stake", 5)
+	balance2Bz, err := cdc.Marshal(&balance2)
+	require.NoError(t, err)
+	delegation := stakingtypes.NewDelegation(addr1, val1, sdk.NewDec(100))
+	delegationBz, err := cdc.Marshal(&delegation)
+	require.NoError(t, err)
+
+	// the state changes are received when the block is committed
+	for _, write := range []struct {
+		key    types.StoreKey
+		k, v   []byte
+		delete bool
+	}{
+		{bankKey, banktypes.CreatePrefixedAccountStoreKey(addr1, []byte("stake")), balance1Bz, false},
+		{bankKey, banktypes.CreatePrefixedAccountStoreKey(addr2, []byte("stake")), balance2Bz, false},
+		{bankKey, banktypes.CreatePrefixedAccountStoreKey(addr1, []byte("uatom")), nil, true},
+		{bankKey, banktypes.DenomMetadataKey("stake"), []byte{1}, false},
+		{stakingKey, stakingtypes.GetDelegationKey(addr1, val1), delegationBz, false},
+	} {
+		for _, listener := range sss.Listeners()[write.key] {
+			require.NoError(t, listener.OnWrite(write.key, write.k, write.v, write.delete))
+		}
+	}
+	require.NoError(t, sss.ListenCommit(ctx, abci.ResponseCommit{Data: []byte{0x01, 0x02}}))
+}
+
+func TestSQLStreamingService(t *testing.T) {
+	cdc := testCodec()
+	db := openSQLite(t)
+
+	// the service requires a codec encoding to JSON
+	_, err := NewStreamingService(db, DialectSQLite, nil, codec.NewAminoCodec(codec.NewLegacyAmino()), false)
+	require.Error(t, err)
+
+	sss, err := NewStreamingService(db, DialectSQLite, []types.StoreKey{bankKey, stakingKey, otherKey}, cdc, true)
+	require.NoError(t, err)
+	require.True(t, sss.HaltAppOnDeliveryError())
+	require.Len(t, sss.Listeners(), 2)
+	require.NoError(t, sss.Stream(new(sync.WaitGroup)))
+	require.Error(t, sss.Stream(new(sync.WaitGroup)))
+
+	txBytes := testTx(t, cdc)
+	runBlock(t, sss, cdc, txBytes)
+	// replaying the block replaces its rows
+	runBlock(t, sss, cdc, txBytes)
+
+	blocks := queryRows(t, db, "SELECT * FROM blocks")
+	require.Len(t, blocks, 1)
+	require.Equal(t, "test-chain", blocks[0]["chain_id"])
+	require.Equal(t, "AB", blocks[0]["proposer_address"])
+	require.Equal(t, "0102", blocks[0]["app_hash"])
+	require.Equal(t, int64(1), blocks[0]["num_txs"])
+
+	txs := queryRows(t, db, "SELECT * FROM txs")
+	require.Len(t, txs, 1)
+	require.Equal(t, "it's ok", txs[0]["log"])
+	require.Equal(t, int64(50000), txs[0]["gas_used"])
+
+	msgs := queryRows(t, db, "SELECT * FROM messages ORDER BY msg_index")
+	require.Len(t, msgs, 2)
+	require.Equal(t, "/cosmos.bank.v1beta1.MsgSend", msgs[0]["type_url"])
+	require.Contains(t, msgs[0]["json"], addr2.String())
+	require.Nil(t, msgs[1]["json"])
+
+	require.Len(t, queryRows(t, db, "SELECT * FROM events"), 2)
+	attrs := queryRows(t, db, "SELECT * FROM event_attributes WHERE source = 'tx' AND key = 'recipient'")
+	require.Len(t, attrs, 1)
+	require.Equal(t, addr2.String(), attrs[0]["value"])
+	require.Equal(t, int64(0), attrs[0]["tx_index"])
+
+	// the deleted balance is removed
+	balances := queryRows(t, db, "SELECT * FROM balances ORDER BY amount")
+	require.Len(t, balances, 2)
+	require.Equal(t, addr2.String(), balances[0]["address"])
+	require.Equal(t, "5", balances[0]["amount"])
+	require.Equal(t, "95", balances[1]["amount"])
+
+	delegations := queryRows(t, db, "SELECT * FROM delegations")
+	require.Len(t, delegations, 1)
+	require.Equal(t, val1.String(), delegations[0]["validator_address"])
+	require.Equal(t, sdk.NewDec(100).String(), delegations[0]["shares"])
+
+	// a block which cannot be indexed is rolled back, the rows of its previous indexing are kept
+	_, err = db.Exec("DROP TABLE txs")
+	require.NoError(t, err)
+	require.NoError(t, sss.ListenDeliverTx(sdk.Context{}, abci.RequestDeliverTx{Tx: txBytes}, abci.ResponseDeliverTx{}))
+	require.Error(t, sss.ListenCommit(sdk.Context{}, abci.ResponseCommit{}))
+	require.Len(t, queryRows(t, db, "SELECT * FROM blocks"), 1)
+	require.Len(t, queryRows(t, db, "SELECT * FROM messages"), 2)
+
+	require.NoError(t, sss.Close())
+	require.Error(t, db.Ping())
+}
+
+func TestDialect(t *testing.T) {
+	for name, expected := range map[string]Dialect{"sqlite3": DialectSQLite, "SQLite": DialectSQLite, "postgres": DialectPostgres, "pgx": DialectPostgres} {
+		dialect, err := DialectFromDriver(name)
+		require.NoError(t, err)
+		require.Equal(t, expected, dialect)
+	}
+	_, err := DialectFromDriver("mysql")
+	require.Error(t, err)
+
+	query := "INSERT INTO blocks (height, chain_id) VALUES (?, ?)"
+	require.Equal(t, query, DialectSQLite.rebind(query))
+	require.Equal(t, "INSERT INTO blocks (height, chain_id) VALUES ($1, $2)", DialectPostgres.rebind(query))
+	require.Contains(t, DialectPostgres.schema()[0], "TIMESTAMPTZ")
+}
+
+func TestParseDelegationKey(t *testing.T) {
+	key := stakingtypes.GetDelegationKey(addr1, val1)
+	delAddr, valAddr, err := parseDelegationKey(key[len(stakingtypes.DelegationKey):])
+	require.NoError(t, err)
+	require.Equal(t, addr1, delAddr)
+	require.Equal(t, val1, valAddr)
+
+	_, _, err = parseDelegationKey([]byte{5, 1})
+	require.Error(t, err)
+	_, _, err = parseDelegationKey(key[1 : len(key)-1])
+	require.Error(t, err)
+}
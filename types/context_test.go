@@ -125,6 +125,10 @@ func (s *contextTestSuite) TestContextWithCustom() {
 	s.Require().True(ctx.IsCheckTx())
 	s.Require().True(ctx.IsReCheckTx())
 
+	// test IsGenesis
+	s.Require().False(ctx.IsGenesis())
+	s.Require().True(ctx.WithIsGenesis(true).IsGenesis())
+
 	// test consensus param
 	s.Require().Nil(ctx.ConsensusParams())
 	cp := &abci.ConsensusParams{}
@@ -15,6 +15,17 @@ type HandlerOptions struct {
 	FeegrantKeeper  FeegrantKeeper
 	SignModeHandler authsigning.SignModeHandler
 	SigGasConsumer  func(meter sdk.GasMeter, sig signing.SignatureV2, params types.Params) error
+	// TxPriority sets the priority of the txs in CheckTx, it defaults to DefaultTxPriority
+	TxPriority TxPriority
+	// MsgFeePolicies are the node-local fee policies of the message types, they
+	// take precedence over the policies set by governance
+	MsgFeePolicies []types.MsgFeePolicy
+	// MinGasPriceDecorator checks the fees against the minimum gas prices, it
+	// replaces the MsgFeePolicyDecorator, e.g. with a dynamic fee market
+	MinGasPriceDecorator sdk.AnteDecorator
+	// MaxUnorderedTxTimeout is the maximum number of blocks an unordered tx can
+	// be valid for, it defaults to DefaultMaxUnorderedTxTimeout
+	MaxUnorderedTxTimeout uint64
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -33,20 +44,35 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if err := types.ValidateMsgFeePolicies(options.MsgFeePolicies); err != nil {
+		return nil, sdkerrors.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
 	sigGasConsumer := options.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = DefaultSigVerificationGasConsumer
 	}
 
+	minGasPriceDecorator := options.MinGasPriceDecorator
+	if minGasPriceDecorator == nil {
+		minGasPriceDecorator = NewMsgFeePolicyDecorator(options.AccountKeeper, options.MsgFeePolicies)
+	}
+
+	maxUnorderedTxTimeout := options.MaxUnorderedTxTimeout
+	if maxUnorderedTxTimeout == 0 {
+		maxUnorderedTxTimeout = DefaultMaxUnorderedTxTimeout
+	}
+
 	anteDecorators := []sdk.AnteDecorator{
 		NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		NewRejectExtensionOptionsDecorator(),
-		NewMempoolFeeDecorator(),
+		minGasPriceDecorator,
 		NewValidateBasicDecorator(),
 		NewTxTimeoutHeightDecorator(),
+		NewUnorderedTxDecorator(maxUnorderedTxTimeout, options.AccountKeeper),
 		NewValidateMemoDecorator(options.AccountKeeper),
 		NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper),
+		NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, options.TxPriority),
 		NewSetPubKeyDecorator(options.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		NewValidateSigCountDecorator(options.AccountKeeper),
 		NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
@@ -12,6 +12,8 @@ type AccountKeeper interface {
 	GetAccount(ctx sdk.Context, addr sdk.AccAddress) types.AccountI
 	SetAccount(ctx sdk.Context, acc types.AccountI)
 	GetModuleAddress(moduleName string) sdk.AccAddress
+	HasUnorderedNonce(ctx sdk.Context, timeoutHeight uint64, addr sdk.AccAddress, nonce uint64) bool
+	SetUnorderedNonce(ctx sdk.Context, timeoutHeight uint64, addr sdk.AccAddress, nonce uint64)
 }
 
 // FeegrantKeeper defines the expected feegrant keeper.
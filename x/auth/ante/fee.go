@@ -2,6 +2,7 @@ package ante
 
 import (
 	"fmt"
+	"math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -54,21 +55,86 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	return next(ctx, tx, simulate)
 }
 
+// TxPriority returns the priority of a transaction in the mempool given its fee
+// and gas limit. Transactions with a higher priority are included first by the
+// prioritized mempool of Tendermint.
+type TxPriority func(ctx sdk.Context, fee sdk.Coins, gas uint64) int64
+
+// DefaultTxPriority returns the effective gas price of the fee as the priority.
+// If the fee is paid in several denoms, the lowest gas price of the denoms is
+// used, as each denom is worth the same.
+func DefaultTxPriority(_ sdk.Context, fee sdk.Coins, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	var priority int64
+	for i, c := range fee {
+		p := clampPriority(c.Amount.ToDec().QuoInt64(int64(gas)))
+		if i == 0 || p < priority {
+			priority = p
+		}
+	}
+	return priority
+}
+
+// NewWeightedTxPriority returns a TxPriority weighting the gas price of each
+// denom of the fee, so the fees paid in different denoms can be compared. The
+// priority is the sum of the weighted gas prices, the denoms without a weight
+// are ignored.
+func NewWeightedTxPriority(weights sdk.DecCoins) TxPriority {
+	return func(_ sdk.Context, fee sdk.Coins, gas uint64) int64 {
+		if gas == 0 {
+			return 0
+		}
+
+		sum := sdk.ZeroDec()
+		for _, c := range fee {
+			weight := weights.AmountOf(c.Denom)
+			if weight.IsPositive() {
+				sum = sum.Add(c.Amount.ToDec().Mul(weight))
+			}
+		}
+		return clampPriority(sum.QuoInt64(int64(gas)))
+	}
+}
+
+// clampPriority truncates a gas price to a priority, the gas prices which do
+// not fit in an int64 get the maximum priority
+func clampPriority(gasPrice sdk.Dec) int64 {
+	p := gasPrice.TruncateInt()
+	if !p.IsInt64() {
+		return math.MaxInt64
+	}
+	return p.Int64()
+}
+
 // DeductFeeDecorator deducts fees from the first signer of the tx
+// If the fees don't meet the global minimum gas prices of the params, multiplied by the gas price factor of the
+// fee policies of the params, return with InsufficientFee error. This is enforced in DeliverTx too, except at genesis.
 // If the first signer does not have the funds to pay for the fees, return with InsufficientFunds error
 // Call next AnteHandler if fees successfully deducted
+// In CheckTx, the priority of the tx is set from the fees with the TxPriority
 // CONTRACT: Tx must implement FeeTx interface to use DeductFeeDecorator
 type DeductFeeDecorator struct {
 	ak             AccountKeeper
 	bankKeeper     types.BankKeeper
 	feegrantKeeper FeegrantKeeper
+	txPriority     TxPriority
 }
 
-func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper) DeductFeeDecorator {
+// NewDeductFeeDecorator returns a DeductFeeDecorator, the DefaultTxPriority is
+// used if txPriority is nil
+func NewDeductFeeDecorator(ak AccountKeeper, bk types.BankKeeper, fk FeegrantKeeper, txPriority TxPriority) DeductFeeDecorator {
+	if txPriority == nil {
+		txPriority = DefaultTxPriority
+	}
+
 	return DeductFeeDecorator{
 		ak:             ak,
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
+		txPriority:     txPriority,
 	}
 }
 
@@ -83,6 +149,18 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 
 	fee := feeTx.GetFee()
+	if !simulate && !ctx.IsGenesis() {
+		params := dfd.ak.GetParams(ctx)
+		if !params.MinGasPrices.IsZero() {
+			if factor := gasPriceFactor(tx.GetMsgs(), msgFeePolicies(params)); !factor.IsZero() {
+				requiredFees := requiredFees(params.MinGasPrices, factor, feeTx.GetGas())
+				if !fee.IsAnyGTE(requiredFees) {
+					return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the global minimum gas prices; got: %s required: %s", fee, requiredFees)
+				}
+			}
+		}
+	}
+
 	feePayer := feeTx.FeePayer()
 	feeGranter := feeTx.FeeGranter()
 
@@ -125,6 +203,11 @@ func (dfd DeductFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bo
 	}
 	ctx.EventManager().EmitEvents(events)
 
+	// the priority is only used by the mempool
+	if ctx.IsCheckTx() {
+		ctx = ctx.WithPriority(dfd.txPriority(ctx, fee, feeTx.GetGas()))
+	}
+
 	return next(ctx, tx, simulate)
 }
 
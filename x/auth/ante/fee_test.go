@@ -1,11 +1,15 @@
 package ante_test
 
 import (
+	"math"
+
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
 func (suite *AnteTestSuite) TestEnsureMempoolFees() {
@@ -86,7 +90,7 @@ func (suite *AnteTestSuite) TestDeductFees() {
 	err = simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, coins)
 	suite.Require().NoError(err)
 
-	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil)
+	dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil)
 	antehandler := sdk.ChainAnteDecorators(dfd)
 
 	_, err = antehandler(suite.ctx, tx, false)
@@ -102,3 +106,146 @@ func (suite *AnteTestSuite) TestDeductFees() {
 
 	suite.Require().Nil(err, "Tx errored after account has been set with sufficient funds")
 }
+
+func (suite *AnteTestSuite) TestDeductFeesPriority() {
+	suite.SetupTest(false) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	// msg and signatures
+	msg := testdata.NewTestMsg(addr1)
+	suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+	suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", 3000000), sdk.NewInt64Coin("stake", 1000000)))
+	suite.txBuilder.SetGasLimit(100000)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+	coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 100000000), sdk.NewInt64Coin("stake", 100000000))
+	suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, coins))
+
+	weighted := ante.NewWeightedTxPriority(sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 2)))
+	testCases := []struct {
+		name       string
+		txPriority ante.TxPriority
+		checkTx    bool
+		expected   int64
+	}{
+		{"lowest gas price of the denoms", nil, true, 10},
+		{"weighted gas price", weighted, true, 60},
+		{"no priority in DeliverTx", nil, false, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, tc.txPriority)
+			antehandler := sdk.ChainAnteDecorators(dfd)
+
+			ctx, err := antehandler(suite.ctx.WithIsCheckTx(tc.checkTx), tx, false)
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expected, ctx.Priority())
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestTxPriority() {
+	fee := sdk.NewCoins(sdk.NewInt64Coin("atom", 500), sdk.NewInt64Coin("stake", 2000))
+	huge := sdk.NewCoins(sdk.NewCoin("atom", sdk.NewIntFromUint64(math.MaxUint64)))
+	weighted := ante.NewWeightedTxPriority(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(5, 1)),
+		sdk.NewInt64DecCoin("stake", 3),
+	))
+
+	testCases := []struct {
+		name       string
+		txPriority ante.TxPriority
+		fee        sdk.Coins
+		gas        uint64
+		expected   int64
+	}{
+		{"default no fee", ante.DefaultTxPriority, nil, 100, 0},
+		{"default no gas", ante.DefaultTxPriority, fee, 0, 0},
+		{"default lowest gas price", ante.DefaultTxPriority, fee, 100, 5},
+		{"default gas price truncated", ante.DefaultTxPriority, fee, 1000, 0},
+		{"default overflow", ante.DefaultTxPriority, huge, 1, math.MaxInt64},
+		{"weighted sum of gas prices", weighted, fee, 100, 62},
+		{"weighted unknown denom", weighted, sdk.NewCoins(sdk.NewInt64Coin("photon", 1000)), 1, 0},
+		{"weighted no gas", weighted, fee, 0, 0},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.Require().Equal(tc.expected, tc.txPriority(suite.ctx, tc.fee, tc.gas))
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestDeductFeesGlobalMinGasPrices() {
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+	free := []types.MsgFeePolicy{types.NewMsgFeePolicy(sdk.MsgTypeURL(msg), sdk.ZeroDec())}
+	global := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)))
+
+	testCases := []struct {
+		name        string
+		fee         sdk.Coins
+		global      sdk.DecCoins
+		govPolicies []types.MsgFeePolicy
+		genesis     bool
+		height      int64
+		simulate    bool
+		expPass     bool
+	}{
+		{"no global minimum gas prices", sdk.NewCoins(), nil, nil, false, 1, false, true},
+		{"enough fees", sdk.NewCoins(sdk.NewInt64Coin("atom", 1000)), global, nil, false, 1, false, true},
+		{"insufficient fees", sdk.NewCoins(sdk.NewInt64Coin("atom", 999)), global, nil, false, 1, false, false},
+		{"fees in another denom", sdk.NewCoins(sdk.NewInt64Coin("stake", 1000)), global, nil, false, 1, false, false},
+		{"free message", sdk.NewCoins(), global, free, false, 1, false, true},
+		{"genesis", sdk.NewCoins(), global, nil, true, 0, false, true},
+		{"genesis with initial height", sdk.NewCoins(), global, nil, true, 5, false, true},
+		{"first block at initial height", sdk.NewCoins(), global, nil, false, 5, false, false},
+		{"simulation", sdk.NewCoins(), global, nil, false, 1, true, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			// the global minimum gas prices are enforced in DeliverTx
+			suite.SetupTest(false) // setup
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			params := suite.app.AccountKeeper.GetParams(suite.ctx)
+			params.MinGasPrices = tc.global
+			params.MsgFeePolicies = tc.govPolicies
+			suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+			acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+			suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+			coins := sdk.NewCoins(sdk.NewInt64Coin("atom", 10000), sdk.NewInt64Coin("stake", 10000))
+			suite.Require().NoError(simapp.FundAccount(suite.app.BankKeeper, suite.ctx, addr1, coins))
+
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+			suite.txBuilder.SetFeeAmount(tc.fee)
+			suite.txBuilder.SetGasLimit(100000)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			dfd := ante.NewDeductFeeDecorator(suite.app.AccountKeeper, suite.app.BankKeeper, nil, nil)
+			antehandler := sdk.ChainAnteDecorators(dfd)
+
+			_, err = antehandler(suite.ctx.WithBlockHeight(tc.height).WithIsGenesis(tc.genesis), tx, tc.simulate)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+			}
+		})
+	}
+}
@@ -0,0 +1,125 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// MsgFeePolicyDecorator will check if the transaction's fee is at least as large
+// as the local validator's minimum gasFee, combined with the global minimum gas
+// prices of the params, multiplied by the gas price factor of
+// the fee policies of its messages. The node-local policies take precedence over
+// the policies set by governance in the auth params. The highest factor of the
+// messages is used, a message without a policy has a factor of one, so a
+// discounted message can't lower the fees of the other messages of a tx.
+// If fee is too low, decorator returns error and tx is rejected from mempool.
+// Note this only applies when ctx.CheckTx = true
+// If fee is high enough or not CheckTx, then call next AnteHandler
+// CONTRACT: Tx must implement FeeTx to use MsgFeePolicyDecorator
+type MsgFeePolicyDecorator struct {
+	ak            AccountKeeper
+	localPolicies map[string]sdk.Dec
+}
+
+// NewMsgFeePolicyDecorator returns a MsgFeePolicyDecorator with the node-local
+// policies, which are expected to be valid.
+func NewMsgFeePolicyDecorator(ak AccountKeeper, localPolicies []types.MsgFeePolicy) MsgFeePolicyDecorator {
+	policies := make(map[string]sdk.Dec, len(localPolicies))
+	for _, p := range localPolicies {
+		policies[p.MsgTypeUrl] = p.GasPriceFactor
+	}
+
+	return MsgFeePolicyDecorator{
+		ak:            ak,
+		localPolicies: policies,
+	}
+}
+
+func (mfd MsgFeePolicyDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !ctx.IsCheckTx() || simulate || ctx.MinGasPrices().IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	// the fees must also meet the global minimum gas prices of the params, see
+	// the DeductFeeDecorator
+	params := mfd.ak.GetParams(ctx)
+	minGasPrices := params.EffectiveMinGasPrices(ctx.MinGasPrices())
+	factor := gasPriceFactor(tx.GetMsgs(), mfd.localPolicies, msgFeePolicies(params))
+	if factor.IsZero() {
+		return next(ctx, tx, simulate)
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := feeTx.GetGas()
+
+	// Determine the required fees by multiplying each required minimum gas
+	// price by the factor and the gas limit, where
+	// fee = ceil(minGasPrice * factor * gasLimit).
+	requiredFees := requiredFees(minGasPrices, factor, gas)
+	if !feeCoins.IsAnyGTE(requiredFees) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees; got: %s required: %s", feeCoins, requiredFees)
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+// requiredFees returns the fees required for the gas limit, where
+// fee = ceil(minGasPrice * factor * gasLimit).
+func requiredFees(minGasPrices sdk.DecCoins, factor sdk.Dec, gas uint64) sdk.Coins {
+	glDec := sdk.NewDec(int64(gas))
+	fees := make(sdk.Coins, len(minGasPrices))
+	for i, gp := range minGasPrices {
+		fee := gp.Amount.Mul(factor).Mul(glDec)
+		fees[i] = sdk.NewCoin(gp.Denom, fee.Ceil().RoundInt())
+	}
+	return fees
+}
+
+// msgFeePolicies returns the gas price factors of the fee policies of the params
+// by message type URL
+func msgFeePolicies(params types.Params) map[string]sdk.Dec {
+	policies := make(map[string]sdk.Dec, len(params.MsgFeePolicies))
+	for _, p := range params.MsgFeePolicies {
+		policies[p.MsgTypeUrl] = p.GasPriceFactor
+	}
+	return policies
+}
+
+// GasPriceFactor returns the gas price factor of the messages from the fee
+// policies set by governance in the auth params, see gasPriceFactor. Unlike the
+// node-local policies, they can be applied in DeliverTx.
+func GasPriceFactor(params types.Params, msgs []sdk.Msg) sdk.Dec {
+	return gasPriceFactor(msgs, msgFeePolicies(params))
+}
+
+// gasPriceFactor returns the highest gas price factor of the fee policies of
+// the messages, the first policies containing the message type are used
+func gasPriceFactor(msgs []sdk.Msg, policies ...map[string]sdk.Dec) sdk.Dec {
+	factor := sdk.Dec{}
+	for _, msg := range msgs {
+		typeURL := sdk.MsgTypeURL(msg)
+
+		msgFactor := sdk.OneDec()
+		for _, p := range policies {
+			if f, ok := p[typeURL]; ok {
+				msgFactor = f
+				break
+			}
+		}
+
+		if factor.IsNil() || msgFactor.GT(factor) {
+			factor = msgFactor
+		}
+	}
+
+	if factor.IsNil() {
+		return sdk.OneDec()
+	}
+	return factor
+}
@@ -0,0 +1,122 @@
+package ante_test
+
+import (
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+func (suite *AnteTestSuite) TestMsgFeePolicies() {
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	testMsg := testdata.NewTestMsg(addr1)
+	sendMsg := banktypes.NewMsgSend(addr1, addr1, sdk.NewCoins(sdk.NewInt64Coin("atom", 1)))
+	testMsgURL := sdk.MsgTypeURL(testMsg)
+
+	free := []types.MsgFeePolicy{types.NewMsgFeePolicy(testMsgURL, sdk.ZeroDec())}
+	double := []types.MsgFeePolicy{types.NewMsgFeePolicy(testMsgURL, sdk.NewDec(2))}
+	half := []types.MsgFeePolicy{types.NewMsgFeePolicy(testMsgURL, sdk.NewDecWithPrec(5, 1))}
+
+	testCases := []struct {
+		name          string
+		msgs          []sdk.Msg
+		fee           int64
+		govPolicies   []types.MsgFeePolicy
+		localPolicies []types.MsgFeePolicy
+		checkTx       bool
+		expPass       bool
+	}{
+		{"no policy", []sdk.Msg{testMsg}, 1000, nil, nil, true, true},
+		{"no policy, insufficient fee", []sdk.Msg{testMsg}, 999, nil, nil, true, false},
+		{"free message", []sdk.Msg{testMsg}, 0, free, nil, true, true},
+		{"discounted message", []sdk.Msg{testMsg}, 500, half, nil, true, true},
+		{"discounted message, insufficient fee", []sdk.Msg{testMsg}, 499, half, nil, true, false},
+		{"higher minimum", []sdk.Msg{testMsg}, 2000, double, nil, true, true},
+		{"higher minimum, insufficient fee", []sdk.Msg{testMsg}, 1999, double, nil, true, false},
+		{"local policy overrides governance", []sdk.Msg{testMsg}, 0, double, free, true, true},
+		{"local policy overrides governance, insufficient fee", []sdk.Msg{testMsg}, 0, free, double, true, false},
+		{"highest factor of the messages", []sdk.Msg{testMsg, sendMsg}, 999, free, nil, true, false},
+		{"not enforced in DeliverTx", []sdk.Msg{testMsg}, 0, double, nil, false, true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.SetupTest(tc.checkTx) // setup
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+			params := suite.app.AccountKeeper.GetParams(suite.ctx)
+			params.MsgFeePolicies = tc.govPolicies
+			suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+			suite.Require().NoError(suite.txBuilder.SetMsgs(tc.msgs...))
+			suite.txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin("atom", tc.fee)))
+			suite.txBuilder.SetGasLimit(100000)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			mfd := ante.NewMsgFeePolicyDecorator(suite.app.AccountKeeper, tc.localPolicies)
+			antehandler := sdk.ChainAnteDecorators(mfd)
+
+			ctx := suite.ctx.WithMinGasPrices(sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2))))
+			_, err = antehandler(ctx, tx, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestMsgFeePolicyGlobalMinGasPrices() {
+	suite.SetupTest(true) // setup
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+
+	params := suite.app.AccountKeeper.GetParams(suite.ctx)
+	params.MinGasPrices = sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(1, 2)))
+	suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+	// the local minimum gas prices of the denoms of the global minimum gas prices are enforced
+	ctx := suite.ctx.WithMinGasPrices(sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 2)),
+		sdk.NewDecCoinFromDec("stake", sdk.NewDecWithPrec(2, 2)),
+	))
+	antehandler := sdk.ChainAnteDecorators(ante.NewMsgFeePolicyDecorator(suite.app.AccountKeeper, nil))
+
+	testCases := []struct {
+		name    string
+		fee     sdk.Coin
+		expPass bool
+	}{
+		{"local denom not in the global minimum gas prices", sdk.NewInt64Coin("atom", 1000), false},
+		{"global minimum gas price below the local one", sdk.NewInt64Coin("stake", 1000), false},
+		{"local minimum gas price", sdk.NewInt64Coin("stake", 2000), true},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1)))
+			suite.txBuilder.SetFeeAmount(sdk.NewCoins(tc.fee))
+			suite.txBuilder.SetGasLimit(100000)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{0}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
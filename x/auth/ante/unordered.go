@@ -0,0 +1,89 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+)
+
+// DefaultMaxUnorderedTxTimeout is the default maximum number of blocks an
+// unordered tx can be valid for.
+const DefaultMaxUnorderedTxTimeout uint64 = 1024
+
+// UnorderedTx defines the interface a tx must implement to be executed
+// unordered, i.e. without checking and incrementing the signer sequences.
+type UnorderedTx interface {
+	TxWithTimeoutHeight
+	GetUnordered() bool
+}
+
+// IsUnorderedTx returns true if the tx is an unordered tx.
+func IsUnorderedTx(tx sdk.Tx) bool {
+	unorderedTx, ok := tx.(UnorderedTx)
+	return ok && unorderedTx.GetUnordered()
+}
+
+// UnorderedTxDecorator provides the replay protection of unordered txs. The
+// sequence of each signer of an unordered tx is a nonce, which is recorded
+// until the timeout height of the tx is reached; a tx reusing the nonce of a
+// signer with the same timeout height is rejected. The timeout height of an
+// unordered tx is required and bounded, so that the recorded nonces can be
+// pruned in a timely manner.
+//
+// CONTRACT: Tx must implement SigVerifiableTx interface
+type UnorderedTxDecorator struct {
+	maxTimeout uint64
+	ak         AccountKeeper
+}
+
+func NewUnorderedTxDecorator(maxTimeout uint64, ak AccountKeeper) UnorderedTxDecorator {
+	return UnorderedTxDecorator{
+		maxTimeout: maxTimeout,
+		ak:         ak,
+	}
+}
+
+func (utd UnorderedTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if !IsUnorderedTx(tx) {
+		return next(ctx, tx, simulate)
+	}
+
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	timeoutHeight := sigTx.(UnorderedTx).GetTimeoutHeight()
+	if timeoutHeight == 0 {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxTimeoutHeight, "unordered tx must have a timeout height")
+	}
+	if maxTimeoutHeight := uint64(ctx.BlockHeight()) + utd.maxTimeout; timeoutHeight > maxTimeoutHeight {
+		return ctx, sdkerrors.Wrapf(
+			sdkerrors.ErrTxTimeoutHeight, "unordered tx timeout height %d exceeds the maximum of %d", timeoutHeight, maxTimeoutHeight,
+		)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signerAddrs := sigTx.GetSigners()
+	if len(sigs) != len(signerAddrs) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signerAddrs), len(sigs))
+	}
+
+	// the nonces are recorded in simulation mode as well, so that the gas
+	// estimate accounts for the writes
+	for i, sig := range sigs {
+		if utd.ak.HasUnorderedNonce(ctx, timeoutHeight, signerAddrs[i], sig.Sequence) {
+			return ctx, sdkerrors.Wrapf(
+				sdkerrors.ErrInvalidRequest, "nonce %d of signer %s already used by an unordered tx", sig.Sequence, signerAddrs[i],
+			)
+		}
+
+		utd.ak.SetUnorderedNonce(ctx, timeoutHeight, signerAddrs[i], sig.Sequence)
+	}
+
+	return next(ctx, tx, simulate)
+}
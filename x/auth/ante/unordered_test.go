@@ -0,0 +1,188 @@
+package ante_test
+
+import (
+	"github.com/cosmos/cosmos-sdk/client"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+func (suite *AnteTestSuite) TestUnorderedTxDecorator() {
+	suite.SetupTest(true)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewUnorderedTxDecorator(10, suite.app.AccountKeeper))
+
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	msg := testdata.NewTestMsg(addr1)
+	ctx := suite.ctx.WithBlockHeight(5)
+
+	testCases := []struct {
+		name      string
+		unordered bool
+		timeout   uint64
+		nonce     uint64
+		expErr    error
+	}{
+		{"ordered tx", false, 0, 0, nil},
+		{"ordered tx with the same sequence", false, 0, 0, nil},
+		{"no timeout", true, 0, 1, sdkerrors.ErrTxTimeoutHeight},
+		{"timeout too far", true, 16, 1, sdkerrors.ErrTxTimeoutHeight},
+		{"valid unordered tx", true, 15, 1, nil},
+		{"duplicate nonce", true, 15, 1, sdkerrors.ErrInvalidRequest},
+		{"same nonce with another timeout", true, 14, 1, nil},
+		{"another nonce", true, 15, 2, nil},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			suite.Require().NoError(suite.txBuilder.SetMsgs(msg))
+			suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+			suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+			suite.txBuilder.SetTimeoutHeight(tc.timeout)
+			suite.txBuilder.(client.UnorderedTxBuilder).SetUnordered(tc.unordered)
+
+			privs, accNums, accSeqs := []cryptotypes.PrivKey{priv1}, []uint64{0}, []uint64{tc.nonce}
+			tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+			suite.Require().NoError(err)
+
+			_, err = antehandler(ctx, tx, false)
+			if tc.expErr != nil {
+				suite.Require().ErrorIs(err, tc.expErr)
+			} else {
+				suite.Require().NoError(err)
+			}
+
+			if tc.unordered && tc.expErr == nil {
+				suite.Require().True(suite.app.AccountKeeper.HasUnorderedNonce(ctx, tc.timeout, addr1, tc.nonce))
+			}
+		})
+	}
+}
+
+func (suite *AnteTestSuite) TestIncrementSequenceDecoratorUnorderedTx() {
+	suite.SetupTest(true)
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	priv, _, addr := testdata.KeyTestPubAddr()
+	acc := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc)
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	suite.txBuilder.SetFeeAmount(testdata.NewTestFeeAmount())
+	suite.txBuilder.SetGasLimit(testdata.NewTestGasLimit())
+	suite.txBuilder.SetTimeoutHeight(10)
+	suite.txBuilder.(client.UnorderedTxBuilder).SetUnordered(true)
+
+	privs, accNums, accSeqs := []cryptotypes.PrivKey{priv}, []uint64{acc.GetAccountNumber()}, []uint64{42}
+	tx, err := suite.CreateTestTx(privs, accNums, accSeqs, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	antehandler := sdk.ChainAnteDecorators(ante.NewIncrementSequenceDecorator(suite.app.AccountKeeper))
+
+	// the nonce must have been recorded
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrLogic)
+
+	suite.app.AccountKeeper.SetUnorderedNonce(suite.ctx, 10, addr, 42)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+	suite.Require().Equal(uint64(0), suite.app.AccountKeeper.GetAccount(suite.ctx, addr).GetSequence())
+}
+
+func (suite *AnteTestSuite) TestAnteHandlerUnorderedTxs() {
+	suite.SetupTest(false)
+
+	accounts := suite.CreateTestAccounts(2)
+	feeAmount := testdata.NewTestFeeAmount()
+	gasLimit := testdata.NewTestGasLimit()
+	msgs := []sdk.Msg{testdata.NewTestMsg(accounts[0].acc.GetAddress())}
+
+	var (
+		accNums []uint64
+		privs   []cryptotypes.PrivKey
+		accSeqs []uint64
+	)
+
+	unordered := func(timeout uint64) {
+		suite.txBuilder.SetTimeoutHeight(timeout)
+		suite.txBuilder.(client.UnorderedTxBuilder).SetUnordered(true)
+	}
+
+	testCases := []TestCase{
+		{
+			"unordered tx with a nonce",
+			func() {
+				unordered(10)
+				privs, accNums, accSeqs = []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{42}
+			},
+			false,
+			true,
+			nil,
+		},
+		{
+			"replaying the unordered tx fails",
+			func() {
+				unordered(10)
+				privs, accNums, accSeqs = []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{42}
+			},
+			false,
+			false,
+			sdkerrors.ErrInvalidRequest,
+		},
+		{
+			"unordered tx with another nonce",
+			func() {
+				unordered(10)
+				privs, accNums, accSeqs = []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{7}
+			},
+			false,
+			true,
+			nil,
+		},
+		{
+			"unordered tx without timeout height fails",
+			func() {
+				unordered(0)
+				privs, accNums, accSeqs = []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{8}
+			},
+			false,
+			false,
+			sdkerrors.ErrTxTimeoutHeight,
+		},
+		{
+			"unordered tx with the wrong account number fails",
+			func() {
+				unordered(10)
+				privs, accNums, accSeqs = []cryptotypes.PrivKey{accounts[0].priv}, []uint64{1}, []uint64{9}
+			},
+			false,
+			false,
+			sdkerrors.ErrUnauthorized,
+		},
+		{
+			"ordered tx still uses the untouched account sequence",
+			func() {
+				privs, accNums, accSeqs = []cryptotypes.PrivKey{accounts[0].priv}, []uint64{0}, []uint64{0}
+			},
+			false,
+			true,
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(tc.desc, func() {
+			suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+			tc.malleate()
+
+			suite.RunTestCase(privs, msgs, feeAmount, gasLimit, accNums, accSeqs, suite.ctx.ChainID(), tc)
+		})
+	}
+
+	suite.Require().Equal(uint64(1), suite.app.AccountKeeper.GetAccount(suite.ctx, accounts[0].acc.GetAddress()).GetSequence())
+}
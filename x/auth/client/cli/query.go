@@ -43,6 +43,8 @@ func GetQueryCmd() *cobra.Command {
 		GetAccountCmd(),
 		GetAccountsCmd(),
 		QueryParamsCmd(),
+		QueryMsgFeePoliciesCmd(),
+		QueryMinGasPricesCmd(),
 	)
 
 	return cmd
@@ -79,6 +81,78 @@ $ <appd> query auth params
 	return cmd
 }
 
+// QueryMsgFeePoliciesCmd returns the command handler for message fee policies querying.
+func QueryMsgFeePoliciesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "msg-fee-policies [msg-type-url]",
+		Short: "Query the fee policies of the message types",
+		Args:  cobra.MaximumNArgs(1),
+		Long: strings.TrimSpace(`Query the fee policies of the message types set by governance, or the policy of a single message type:
+
+$ <appd> query auth msg-fee-policies
+$ <appd> query auth msg-fee-policies /cosmos.slashing.v1beta1.MsgUnjail
+
+The gas price factor of a policy multiplies the minimum gas prices of the validators for the
+transactions containing a message of the type. The validators may override the policies in app.toml.
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryMsgFeePoliciesRequest{}
+			if len(args) > 0 {
+				req.MsgTypeUrl = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MsgFeePolicies(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// QueryMinGasPricesCmd returns the command handler for minimum gas prices querying.
+func QueryMinGasPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-gas-prices",
+		Short: "Query the minimum gas prices of the node",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(`Query the minimum gas prices a transaction's fees must meet to be accepted by the node,
+combining the global minimum gas prices set by governance with the node-local ones:
+
+$ <appd> query auth min-gas-prices
+`),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MinGasPrices(cmd.Context(), &types.QueryMinGasPricesRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetAccountCmd returns a query account that will display the state of the
 // account at a given address.
 func GetAccountCmd() *cobra.Command {
@@ -25,6 +25,10 @@ func InitGenesis(ctx sdk.Context, ak keeper.AccountKeeper, data types.GenesisSta
 	}
 
 	ak.GetModuleAccount(ctx, types.FeeCollectorName)
+
+	for _, nonce := range data.UnorderedNonces {
+		ak.SetUnorderedNonce(ctx, nonce.TimeoutHeight, sdk.MustAccAddressFromBech32(nonce.Address), nonce.Nonce)
+	}
 }
 
 // ExportGenesis returns a GenesisState for a given context and keeper
@@ -38,5 +42,14 @@ func ExportGenesis(ctx sdk.Context, ak keeper.AccountKeeper) *types.GenesisState
 		return false
 	})
 
-	return types.NewGenesisState(params, genAccounts)
+	genState := types.NewGenesisState(params, genAccounts)
+
+	// the nonces of the unordered txs which have not timed out yet prevent their
+	// replay after a restart from the exported genesis
+	ak.IterateUnorderedNonces(ctx, func(nonce types.UnorderedNonce) bool {
+		genState.UnorderedNonces = append(genState.UnorderedNonces, nonce)
+		return false
+	})
+
+	return genState
 }
@@ -81,3 +81,37 @@ func (ak AccountKeeper) Params(c context.Context, req *types.QueryParamsRequest)
 
 	return &types.QueryParamsResponse{Params: params}, nil
 }
+
+// MsgFeePolicies returns the fee policies of the message types set by governance
+func (ak AccountKeeper) MsgFeePolicies(c context.Context, req *types.QueryMsgFeePoliciesRequest) (*types.QueryMsgFeePoliciesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	policies := ak.GetParams(ctx).MsgFeePolicies
+
+	if req.MsgTypeUrl != "" {
+		for _, p := range policies {
+			if p.MsgTypeUrl == req.MsgTypeUrl {
+				return &types.QueryMsgFeePoliciesResponse{Policies: []types.MsgFeePolicy{p}}, nil
+			}
+		}
+		return nil, status.Errorf(codes.NotFound, "no fee policy for %s", req.MsgTypeUrl)
+	}
+
+	return &types.QueryMsgFeePoliciesResponse{Policies: policies}, nil
+}
+
+// MinGasPrices returns the effective minimum gas prices of the node and the global minimum gas prices
+func (ak AccountKeeper) MinGasPrices(c context.Context, req *types.QueryMinGasPricesRequest) (*types.QueryMinGasPricesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+	params := ak.GetParams(ctx)
+
+	return &types.QueryMinGasPricesResponse{
+		MinGasPrices:       params.EffectiveMinGasPrices(ctx.MinGasPrices()),
+		GlobalMinGasPrices: params.MinGasPrices,
+	}, nil
+}
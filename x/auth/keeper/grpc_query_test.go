@@ -1,8 +1,10 @@
 package keeper_test
 
 import (
+	"context"
 	"fmt"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
@@ -187,3 +189,74 @@ func (suite *KeeperTestSuite) TestGRPCQueryParameters() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestGRPCQueryMsgFeePolicies() {
+	unjail := types.NewMsgFeePolicy("/cosmos.slashing.v1beta1.MsgUnjail", sdk.ZeroDec())
+	multiSend := types.NewMsgFeePolicy("/cosmos.bank.v1beta1.MsgMultiSend", sdk.NewDec(2))
+
+	testCases := []struct {
+		msg         string
+		req         *types.QueryMsgFeePoliciesRequest
+		expPass     bool
+		expPolicies []types.MsgFeePolicy
+	}{
+		{"all policies", &types.QueryMsgFeePoliciesRequest{}, true, []types.MsgFeePolicy{unjail, multiSend}},
+		{"single policy", &types.QueryMsgFeePoliciesRequest{MsgTypeUrl: multiSend.MsgTypeUrl}, true, []types.MsgFeePolicy{multiSend}},
+		{"no policy", &types.QueryMsgFeePoliciesRequest{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"}, false, nil},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.AccountKeeper.GetParams(suite.ctx)
+			params.MsgFeePolicies = []types.MsgFeePolicy{unjail, multiSend}
+			suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+			res, err := suite.queryClient.MsgFeePolicies(sdk.WrapSDKContext(suite.ctx), tc.req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expPolicies, res.Policies)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGRPCQueryMinGasPrices() {
+	local := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 1)), sdk.NewInt64DecCoin("stake", 2))
+	global := sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1))
+
+	testCases := []struct {
+		msg       string
+		global    sdk.DecCoins
+		local     sdk.DecCoins
+		expPrices sdk.DecCoins
+	}{
+		{"no minimum gas prices", nil, nil, nil},
+		{"local minimum gas prices", nil, local, local},
+		{"global minimum gas prices", global, nil, global},
+		{"global and local minimum gas prices", global, local, sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 2))},
+	}
+
+	for _, tc := range testCases {
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			params := suite.app.AccountKeeper.GetParams(suite.ctx)
+			params.MinGasPrices = tc.global
+			suite.app.AccountKeeper.SetParams(suite.ctx, params)
+
+			queryHelper := baseapp.NewQueryServerTestHelper(suite.ctx.WithMinGasPrices(tc.local), suite.app.InterfaceRegistry())
+			types.RegisterQueryServer(queryHelper, suite.app.AccountKeeper)
+			res, err := types.NewQueryClient(queryHelper).MinGasPrices(context.Background(), &types.QueryMinGasPricesRequest{})
+
+			suite.Require().NoError(err)
+			suite.Require().Equal(tc.expPrices, res.MinGasPrices)
+			suite.Require().Equal(tc.global, res.GlobalMinGasPrices)
+		})
+	}
+}
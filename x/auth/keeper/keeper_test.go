@@ -9,8 +9,10 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/keeper"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
 const (
@@ -118,6 +120,62 @@ func TestGetSetParams(t *testing.T) {
 	require.Equal(t, params, actualParams)
 }
 
+func TestUnorderedNonces(t *testing.T) {
+	app, ctx := createTestApp(true)
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	app.AccountKeeper.SetUnorderedNonce(ctx, 10, addr1, 1)
+	app.AccountKeeper.SetUnorderedNonce(ctx, 11, addr1, 2)
+	app.AccountKeeper.SetUnorderedNonce(ctx, 12, addr2, 1)
+
+	require.True(t, app.AccountKeeper.HasUnorderedNonce(ctx, 10, addr1, 1))
+	require.False(t, app.AccountKeeper.HasUnorderedNonce(ctx, 10, addr2, 1))
+	require.False(t, app.AccountKeeper.HasUnorderedNonce(ctx, 11, addr1, 1))
+
+	// the nonces are kept until their timeout height is reached
+	app.AccountKeeper.RemoveExpiredUnorderedNonces(ctx.WithBlockHeight(9))
+	require.True(t, app.AccountKeeper.HasUnorderedNonce(ctx, 10, addr1, 1))
+
+	app.AccountKeeper.RemoveExpiredUnorderedNonces(ctx.WithBlockHeight(11))
+	require.False(t, app.AccountKeeper.HasUnorderedNonce(ctx, 10, addr1, 1))
+	require.False(t, app.AccountKeeper.HasUnorderedNonce(ctx, 11, addr1, 2))
+	require.True(t, app.AccountKeeper.HasUnorderedNonce(ctx, 12, addr2, 1))
+}
+
+func TestUnorderedNoncesGenesis(t *testing.T) {
+	app, ctx := createTestApp(true)
+	addr1 := sdk.AccAddress([]byte("addr1---------------"))
+	addr2 := sdk.AccAddress([]byte("addr2---------------"))
+
+	app.AccountKeeper.SetUnorderedNonce(ctx, 12, addr2, 1)
+	app.AccountKeeper.SetUnorderedNonce(ctx, 10, addr1, 1)
+	app.AccountKeeper.SetUnorderedNonce(ctx, 10, addr1, 2)
+
+	// the nonces are exported ordered by timeout height
+	genesis := auth.ExportGenesis(ctx, app.AccountKeeper)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+	require.Equal(t, []types.UnorderedNonce{
+		{TimeoutHeight: 10, Address: addr1.String(), Nonce: 1},
+		{TimeoutHeight: 10, Address: addr1.String(), Nonce: 2},
+		{TimeoutHeight: 12, Address: addr2.String(), Nonce: 1},
+	}, genesis.UnorderedNonces)
+
+	// the unordered txs which have not timed out can't be replayed after a restart
+	app2, ctx2 := createTestApp(true)
+	auth.InitGenesis(ctx2, app2.AccountKeeper, *genesis)
+	require.True(t, app2.AccountKeeper.HasUnorderedNonce(ctx2, 10, addr1, 1))
+	require.True(t, app2.AccountKeeper.HasUnorderedNonce(ctx2, 10, addr1, 2))
+	require.True(t, app2.AccountKeeper.HasUnorderedNonce(ctx2, 12, addr2, 1))
+	require.Equal(t, genesis.UnorderedNonces, auth.ExportGenesis(ctx2, app2.AccountKeeper).UnorderedNonces)
+
+	// expired nonces are not exported
+	app.AccountKeeper.RemoveExpiredUnorderedNonces(ctx.WithBlockHeight(10))
+	require.Equal(t, []types.UnorderedNonce{
+		{TimeoutHeight: 12, Address: addr2.String(), Nonce: 1},
+	}, auth.ExportGenesis(ctx, app.AccountKeeper).UnorderedNonces)
+}
+
 func TestSupply_ValidatePermissions(t *testing.T) {
 	app, _ := createTestApp(true)
 
@@ -146,3 +204,19 @@ func TestSupply_ValidatePermissions(t *testing.T) {
 	err = app.AccountKeeper.ValidatePermissions(otherAcc)
 	require.Error(t, err)
 }
+
+func TestMigrate2to3(t *testing.T) {
+	app, ctx := createTestApp(true)
+
+	// remove the message fee policies and minimum gas prices parameters, as in a store of version 2
+	store := ctx.KVStore(app.GetKey(paramstypes.StoreKey))
+	store.Delete(append([]byte(types.ModuleName+"/"), types.KeyMsgFeePolicies...))
+	store.Delete(append([]byte(types.ModuleName+"/"), types.KeyMinGasPrices...))
+	require.Panics(t, func() { app.AccountKeeper.GetParams(ctx) })
+
+	m := keeper.NewMigrator(app.AccountKeeper, app.GRPCQueryRouter())
+	require.NoError(t, m.Migrate2to3(ctx))
+	require.Empty(t, app.AccountKeeper.GetParams(ctx).MsgFeePolicies)
+	require.Empty(t, app.AccountKeeper.GetParams(ctx).MinGasPrices)
+	require.NoError(t, app.AccountKeeper.GetParams(ctx).Validate())
+}
@@ -41,3 +41,11 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 
 	return iterErr
 }
+
+// Migrate2to3 migrates from version 2 to 3. It sets the message fee policies
+// and the global minimum gas prices parameters, which are empty by default.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	m.keeper.paramSubspace.Set(ctx, types.KeyMsgFeePolicies, []types.MsgFeePolicy{})
+	m.keeper.paramSubspace.Set(ctx, types.KeyMinGasPrices, sdk.DecCoins{})
+	return nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// HasUnorderedNonce returns whether the given signer already used the nonce in
+// an unordered tx with the given timeout height.
+func (ak AccountKeeper) HasUnorderedNonce(ctx sdk.Context, timeoutHeight uint64, addr sdk.AccAddress, nonce uint64) bool {
+	store := ctx.KVStore(ak.key)
+	return store.Has(types.UnorderedNonceKey(timeoutHeight, addr, nonce))
+}
+
+// SetUnorderedNonce records the nonce used by a signer of an unordered tx until
+// the timeout height of the tx is reached.
+func (ak AccountKeeper) SetUnorderedNonce(ctx sdk.Context, timeoutHeight uint64, addr sdk.AccAddress, nonce uint64) {
+	store := ctx.KVStore(ak.key)
+	store.Set(types.UnorderedNonceKey(timeoutHeight, addr, nonce), []byte{})
+}
+
+// IterateUnorderedNonces iterates over the nonces of the unordered txs which have
+// not timed out yet, ordered by timeout height. Iteration stops when the
+// callback returns true.
+func (ak AccountKeeper) IterateUnorderedNonces(ctx sdk.Context, cb func(nonce types.UnorderedNonce) (stop bool)) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.UnorderedNonceKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		// timeout height | length prefixed address | nonce
+		key := iterator.Key()
+		addrLen := int(key[8])
+		nonce := types.UnorderedNonce{
+			TimeoutHeight: sdk.BigEndianToUint64(key[:8]),
+			Address:       sdk.AccAddress(key[9 : 9+addrLen]).String(),
+			Nonce:         sdk.BigEndianToUint64(key[9+addrLen:]),
+		}
+
+		if cb(nonce) {
+			break
+		}
+	}
+}
+
+// RemoveExpiredUnorderedNonces removes the nonces of all unordered txs whose
+// timeout height is lower or equal to the current block height, as these txs
+// can't be included in a later block anymore.
+func (ak AccountKeeper) RemoveExpiredUnorderedNonces(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(ak.key), types.UnorderedNonceKeyPrefix)
+	end := sdk.Uint64ToBigEndian(uint64(ctx.BlockHeight()) + 1)
+
+	iterator := store.Iterator(nil, end)
+	defer iterator.Close()
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
@@ -43,6 +43,7 @@ type StdSignDoc struct {
 	AccountNumber uint64            `json:"account_number" yaml:"account_number"`
 	Sequence      uint64            `json:"sequence" yaml:"sequence"`
 	TimeoutHeight uint64            `json:"timeout_height,omitempty" yaml:"timeout_height"`
+	Unordered     bool              `json:"unordered,omitempty" yaml:"unordered"`
 	ChainID       string            `json:"chain_id" yaml:"chain_id"`
 	Memo          string            `json:"memo" yaml:"memo"`
 	Fee           json.RawMessage   `json:"fee" yaml:"fee"`
@@ -51,6 +52,16 @@ type StdSignDoc struct {
 
 // StdSignBytes returns the bytes to sign for a transaction.
 func StdSignBytes(chainID string, accnum, sequence, timeout uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytes(chainID, accnum, sequence, timeout, false, fee, msgs, memo)
+}
+
+// UnorderedStdSignBytes returns the bytes to sign for an unordered transaction,
+// whose sequence is a nonce.
+func UnorderedStdSignBytes(chainID string, accnum, nonce, timeout uint64, fee StdFee, msgs []sdk.Msg, memo string) []byte {
+	return stdSignBytes(chainID, accnum, nonce, timeout, true, fee, msgs, memo)
+}
+
+func stdSignBytes(chainID string, accnum, sequence, timeout uint64, unordered bool, fee StdFee, msgs []sdk.Msg, memo string) []byte {
 	msgsBytes := make([]json.RawMessage, 0, len(msgs))
 	for _, msg := range msgs {
 		legacyMsg, ok := msg.(LegacyMsg)
@@ -69,6 +80,7 @@ func StdSignBytes(chainID string, accnum, sequence, timeout uint64, fee StdFee,
 		Msgs:          msgsBytes,
 		Sequence:      sequence,
 		TimeoutHeight: timeout,
+		Unordered:     unordered,
 	})
 	if err != nil {
 		panic(err)
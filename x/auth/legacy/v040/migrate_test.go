@@ -234,11 +234,14 @@ func TestMigrate(t *testing.T) {
   ],
   "params": {
     "max_memo_characters": "10",
+    "min_gas_prices": [],
+    "msg_fee_policies": [],
     "sig_verify_cost_ed25519": "40",
     "sig_verify_cost_secp256k1": "50",
     "tx_sig_limit": "20",
     "tx_size_cost_per_byte": "30"
-  }
+  },
+  "unordered_nonces": []
 }`
 
 	bz, err := clientCtx.Codec.MarshalJSON(migrated)
@@ -41,6 +41,10 @@ func TestDecodeStore(t *testing.T) {
 				Key:   types.GlobalAccountNumberKey,
 				Value: cdc.MustMarshal(&globalAccNumber),
 			},
+			{
+				Key:   types.UnorderedNonceKey(10, sdk.AccAddress{0x01}, 5),
+				Value: []byte{},
+			},
 			{
 				Key:   []byte{0x99},
 				Value: []byte{0x99},
@@ -53,6 +57,7 @@ func TestDecodeStore(t *testing.T) {
 	}{
 		{"Account", fmt.Sprintf("%v\n%v", acc, acc)},
 		{"GlobalAccNumber", fmt.Sprintf("GlobalAccNumberA: %d\nGlobalAccNumberB: %d", globalAccNumber, globalAccNumber)},
+		{"UnorderedNonce", "UnorderedNonceA: 000000000000000A01010000000000000005\nUnorderedNonceB: 000000000000000A01010000000000000005"},
 		{"other", ""},
 	}
 
@@ -59,6 +59,21 @@ func TestLegacyAminoJSONHandler_GetSignBytes(t *testing.T) {
 
 	require.Equal(t, expectedSignBz, signBz)
 
+	// expect the unordered flag to be signed
+	bldr = newBuilder()
+	buildTx(t, bldr)
+	bldr.SetUnordered(true)
+	signBz, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_LEGACY_AMINO_JSON, signingData, bldr.GetTx())
+	require.NoError(t, err)
+
+	expectedSignBz = legacytx.UnorderedStdSignBytes(chainId, accNum, seqNum, timeout, legacytx.StdFee{
+		Amount: coins,
+		Gas:    gas,
+	}, []sdk.Msg{msg}, memo)
+
+	require.Equal(t, expectedSignBz, signBz)
+	require.Contains(t, string(signBz), `"unordered":true`)
+
 	// expect error with wrong sign mode
 	_, err = handler.GetSignBytes(signingtypes.SignMode_SIGN_MODE_DIRECT, signingData, tx)
 	require.Error(t, err)
@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/codec/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types1 "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	_ "github.com/regen-network/cosmos-proto"
@@ -113,6 +115,11 @@ type Params struct {
 	TxSizeCostPerByte      uint64 `protobuf:"varint,3,opt,name=tx_size_cost_per_byte,json=txSizeCostPerByte,proto3" json:"tx_size_cost_per_byte,omitempty" yaml:"tx_size_cost_per_byte"`
 	SigVerifyCostED25519   uint64 `protobuf:"varint,4,opt,name=sig_verify_cost_ed25519,json=sigVerifyCostEd25519,proto3" json:"sig_verify_cost_ed25519,omitempty" yaml:"sig_verify_cost_ed25519"`
 	SigVerifyCostSecp256k1 uint64 `protobuf:"varint,5,opt,name=sig_verify_cost_secp256k1,json=sigVerifyCostSecp256k1,proto3" json:"sig_verify_cost_secp256k1,omitempty" yaml:"sig_verify_cost_secp256k1"`
+	// msg_fee_policies are the fee policies of the message types, enforced in the mempool.
+	MsgFeePolicies []MsgFeePolicy `protobuf:"bytes,6,rep,name=msg_fee_policies,json=msgFeePolicies,proto3" json:"msg_fee_policies" yaml:"msg_fee_policies"`
+	// min_gas_prices are the global minimum gas prices, enforced in consensus. A
+	// transaction's fees must meet the minimum of any denomination.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,7,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices" yaml:"min_gas_prices"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -182,59 +189,137 @@ func (m *Params) GetSigVerifyCostSecp256k1() uint64 {
 	return 0
 }
 
+func (m *Params) GetMsgFeePolicies() []MsgFeePolicy {
+	if m != nil {
+		return m.MsgFeePolicies
+	}
+	return nil
+}
+
+func (m *Params) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+// MsgFeePolicy defines the fee policy of a message type in the mempool. The
+// minimum gas prices of the validators are multiplied by the gas price factor
+// for the transactions containing a message of the type.
+type MsgFeePolicy struct {
+	// msg_type_url is the type URL of the message, e.g. "/cosmos.bank.v1beta1.MsgSend".
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty" yaml:"msg_type_url"`
+	// gas_price_factor multiplies the minimum gas prices, a factor of zero makes
+	// the message free and a factor below one discounts it.
+	GasPriceFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=gas_price_factor,json=gasPriceFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"gas_price_factor" yaml:"gas_price_factor"`
+}
+
+func (m *MsgFeePolicy) Reset()         { *m = MsgFeePolicy{} }
+func (m *MsgFeePolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgFeePolicy) ProtoMessage()    {}
+func (*MsgFeePolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1f7e915d020d2d, []int{3}
+}
+func (m *MsgFeePolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFeePolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFeePolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFeePolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFeePolicy.Merge(m, src)
+}
+func (m *MsgFeePolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFeePolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFeePolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFeePolicy proto.InternalMessageInfo
+
+func (m *MsgFeePolicy) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*BaseAccount)(nil), "cosmos.auth.v1beta1.BaseAccount")
 	proto.RegisterType((*ModuleAccount)(nil), "cosmos.auth.v1beta1.ModuleAccount")
 	proto.RegisterType((*Params)(nil), "cosmos.auth.v1beta1.Params")
+	proto.RegisterType((*MsgFeePolicy)(nil), "cosmos.auth.v1beta1.MsgFeePolicy")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/auth.proto", fileDescriptor_7e1f7e915d020d2d) }
 
 var fileDescriptor_7e1f7e915d020d2d = []byte{
-	// 674 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x5f, 0xf2, 0xf8, 0x98, 0x00, 0x12, 0x26, 0x80, 0x93, 0xf7, 0x64, 0x5b, 0x5e, 0xe5,
-	0x49, 0x2f, 0x8e, 0x92, 0x8a, 0x4a, 0x64, 0x51, 0x15, 0xd3, 0x2e, 0x50, 0x0b, 0x42, 0x46, 0xea,
-	0xa2, 0xaa, 0xe4, 0x8e, 0x9d, 0xc1, 0x58, 0x64, 0x32, 0xc6, 0x33, 0x46, 0x31, 0xbf, 0xa0, 0xcb,
-	0x2e, 0xbb, 0xe4, 0x47, 0xf0, 0x0f, 0xba, 0xe9, 0x12, 0xb1, 0xea, 0xca, 0xad, 0xc2, 0xa6, 0xea,
-	0x32, 0xfb, 0x4a, 0x95, 0x67, 0x9c, 0x90, 0xa0, 0x74, 0x95, 0xb9, 0xe7, 0x9c, 0x7b, 0xee, 0x9d,
-	0x7b, 0xe3, 0x01, 0xaa, 0x47, 0x28, 0x26, 0xb4, 0x09, 0x63, 0x76, 0xd6, 0xbc, 0x6c, 0xb9, 0x88,
-	0xc1, 0x16, 0x0f, 0xcc, 0x30, 0x22, 0x8c, 0xc8, 0x1b, 0x82, 0x37, 0x39, 0x94, 0xf3, 0xb5, 0xaa,
-	0x00, 0x1d, 0x2e, 0x69, 0xe6, 0x0a, 0x1e, 0xd4, 0x2a, 0x3e, 0xf1, 0x89, 0xc0, 0xb3, 0x53, 0x8e,
-	0x56, 0x7d, 0x42, 0xfc, 0x1e, 0x6a, 0xf2, 0xc8, 0x8d, 0x4f, 0x9b, 0xb0, 0x9f, 0x08, 0xca, 0xf8,
-	0x25, 0x81, 0xb2, 0x05, 0x29, 0xda, 0xf3, 0x3c, 0x12, 0xf7, 0x99, 0xac, 0x80, 0x45, 0xd8, 0xed,
-	0x46, 0x88, 0x52, 0x45, 0xd2, 0xa5, 0xfa, 0xb2, 0x3d, 0x0e, 0xe5, 0x77, 0x60, 0x31, 0x8c, 0x5d,
-	0xe7, 0x1c, 0x25, 0xca, 0x5f, 0xba, 0x54, 0x2f, 0xb7, 0x2b, 0xa6, 0xb0, 0x35, 0xc7, 0xb6, 0xe6,
-	0x5e, 0x3f, 0xb1, 0x1a, 0x3f, 0x53, 0xad, 0x12, 0xc6, 0x6e, 0x2f, 0xf0, 0x32, 0xed, 0xff, 0x04,
-	0x07, 0x0c, 0xe1, 0x90, 0x25, 0xa3, 0x54, 0x5b, 0x4f, 0x20, 0xee, 0x75, 0x8c, 0x07, 0xd6, 0xb0,
-	0x17, 0xc2, 0xd8, 0x7d, 0x85, 0x12, 0xf9, 0x39, 0x58, 0x83, 0xa2, 0x05, 0xa7, 0x1f, 0x63, 0x17,
-	0x45, 0x4a, 0x51, 0x97, 0xea, 0x25, 0xab, 0x3a, 0x4a, 0xb5, 0x4d, 0x91, 0x36, 0xcb, 0x1b, 0xf6,
-	0x6a, 0x0e, 0x1c, 0xf1, 0x58, 0xae, 0x81, 0x25, 0x8a, 0x2e, 0x62, 0xd4, 0xf7, 0x90, 0x52, 0xca,
-	0x72, 0xed, 0x49, 0xdc, 0x51, 0x3e, 0x5c, 0x6b, 0x85, 0x4f, 0xd7, 0x5a, 0xe1, 0xc7, 0xb5, 0x56,
-	0xb8, 0xbb, 0x69, 0x2c, 0xe5, 0xd7, 0x3d, 0x30, 0x3e, 0x4b, 0x60, 0xf5, 0x90, 0x74, 0xe3, 0xde,
-	0x64, 0x02, 0xef, 0xc1, 0x8a, 0x0b, 0x29, 0x72, 0x72, 0x77, 0x3e, 0x86, 0x72, 0x5b, 0x37, 0xe7,
-	0x6c, 0xc2, 0x9c, 0x9a, 0x9c, 0xf5, 0xcf, 0x6d, 0xaa, 0x49, 0xa3, 0x54, 0xdb, 0x10, 0xdd, 0x4e,
-	0x7b, 0x18, 0x76, 0xd9, 0x9d, 0x9a, 0xb1, 0x0c, 0x4a, 0x7d, 0x88, 0x11, 0x1f, 0xe3, 0xb2, 0xcd,
-	0xcf, 0xb2, 0x0e, 0xca, 0x21, 0x8a, 0x70, 0x40, 0x69, 0x40, 0xfa, 0x54, 0x29, 0xea, 0xc5, 0xfa,
-	0xb2, 0x3d, 0x0d, 0x75, 0x6a, 0xe3, 0x3b, 0xdc, 0xdd, 0x34, 0xd6, 0x66, 0x5a, 0x3e, 0x30, 0xbe,
-	0x15, 0xc1, 0xc2, 0x31, 0x8c, 0x20, 0xa6, 0xf2, 0x11, 0xd8, 0xc0, 0x70, 0xe0, 0x60, 0x84, 0x89,
-	0xe3, 0x9d, 0xc1, 0x08, 0x7a, 0x0c, 0x45, 0x62, 0x99, 0x25, 0x4b, 0x1d, 0xa5, 0x5a, 0x4d, 0xf4,
-	0x37, 0x47, 0x64, 0xd8, 0xeb, 0x18, 0x0e, 0x0e, 0x11, 0x26, 0xfb, 0x13, 0x4c, 0xde, 0x05, 0x2b,
-	0x6c, 0xe0, 0xd0, 0xc0, 0x77, 0x7a, 0x01, 0x0e, 0x18, 0x6f, 0xba, 0x64, 0x6d, 0x3f, 0x5c, 0x74,
-	0x9a, 0x35, 0x6c, 0xc0, 0x06, 0x27, 0x81, 0xff, 0x3a, 0x0b, 0x64, 0x1b, 0x6c, 0x72, 0xf2, 0x0a,
-	0x39, 0x1e, 0xa1, 0xcc, 0x09, 0x51, 0xe4, 0xb8, 0x09, 0x43, 0xf9, 0x6a, 0xf5, 0x51, 0xaa, 0xfd,
-	0x3b, 0xe5, 0xf1, 0x58, 0x66, 0xd8, 0xeb, 0x99, 0xd9, 0x15, 0xda, 0x27, 0x94, 0x1d, 0xa3, 0xc8,
-	0x4a, 0x18, 0x92, 0x2f, 0xc0, 0x76, 0x56, 0xed, 0x12, 0x45, 0xc1, 0x69, 0x22, 0xf4, 0xa8, 0xdb,
-	0xde, 0xd9, 0x69, 0xed, 0x8a, 0xa5, 0x5b, 0x9d, 0x61, 0xaa, 0x55, 0x4e, 0x02, 0xff, 0x0d, 0x57,
-	0x64, 0xa9, 0x2f, 0x5f, 0x70, 0x7e, 0x94, 0x6a, 0xaa, 0xa8, 0xf6, 0x07, 0x03, 0xc3, 0xae, 0xd0,
-	0x99, 0x3c, 0x01, 0xcb, 0x09, 0xa8, 0x3e, 0xce, 0xa0, 0xc8, 0x0b, 0xdb, 0x3b, 0x4f, 0xcf, 0x5b,
-	0xca, 0xdf, 0xbc, 0xe8, 0xb3, 0x61, 0xaa, 0x6d, 0xcd, 0x14, 0x3d, 0x19, 0x2b, 0x46, 0xa9, 0xa6,
-	0xcf, 0x2f, 0x3b, 0x31, 0x31, 0xec, 0x2d, 0x3a, 0x37, 0xb7, 0xb3, 0x94, 0xff, 0x67, 0x25, 0x6b,
-	0xff, 0xcb, 0x50, 0x95, 0x6e, 0x87, 0xaa, 0xf4, 0x7d, 0xa8, 0x4a, 0x1f, 0xef, 0xd5, 0xc2, 0xed,
-	0xbd, 0x5a, 0xf8, 0x7a, 0xaf, 0x16, 0xde, 0xfe, 0xe7, 0x07, 0xec, 0x2c, 0x76, 0x4d, 0x8f, 0xe0,
-	0xfc, 0x2d, 0xc8, 0x7f, 0x1a, 0xb4, 0x7b, 0xde, 0x1c, 0x88, 0xa7, 0x85, 0x25, 0x21, 0xa2, 0xee,
-	0x02, 0xff, 0x52, 0x9f, 0xfc, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x49, 0x90, 0x16, 0xd9, 0x76, 0x04,
-	0x00, 0x00,
+	// 873 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xbf, 0x6f, 0xdb, 0x46,
+	0x14, 0x16, 0x6b, 0xc5, 0x3f, 0x4e, 0x8e, 0x11, 0xd3, 0x4a, 0x4c, 0xab, 0x81, 0x8e, 0xe5, 0x50,
+	0xa8, 0x68, 0x2d, 0xc1, 0x2a, 0x5c, 0x20, 0x1a, 0x8a, 0x86, 0x4e, 0x53, 0x18, 0x8d, 0x03, 0x83,
+	0x6e, 0x3b, 0x14, 0x05, 0xd8, 0x23, 0xf5, 0x4c, 0x1f, 0xcc, 0xe3, 0x31, 0x3c, 0x32, 0x10, 0xf3,
+	0x17, 0x74, 0xcc, 0xd8, 0xd1, 0x73, 0xe7, 0xfc, 0x07, 0xed, 0x90, 0xd1, 0xc8, 0x54, 0x74, 0x60,
+	0x03, 0x79, 0x29, 0x3a, 0x6a, 0x2f, 0x50, 0xf0, 0x48, 0xc9, 0x94, 0xa1, 0x16, 0x99, 0xc4, 0xf7,
+	0xde, 0xf7, 0xbe, 0xef, 0xfd, 0xb8, 0x3b, 0xa1, 0xb6, 0xcb, 0x05, 0xe3, 0xa2, 0x47, 0x92, 0xf8,
+	0xac, 0xf7, 0x7c, 0xcf, 0x81, 0x98, 0xec, 0x49, 0xa3, 0x1b, 0x46, 0x3c, 0xe6, 0xea, 0x56, 0x11,
+	0xef, 0x4a, 0x57, 0x19, 0x6f, 0xed, 0x14, 0x4e, 0x5b, 0x42, 0x7a, 0x25, 0x42, 0x1a, 0xad, 0x29,
+	0x9f, 0x43, 0x04, 0xcc, 0xf8, 0x5c, 0x4e, 0x83, 0x32, 0xde, 0xf4, 0xb8, 0xc7, 0x8b, 0xbc, 0xfc,
+	0xab, 0xf4, 0xee, 0x78, 0x9c, 0x7b, 0x3e, 0xf4, 0xa4, 0xe5, 0x24, 0xa7, 0x3d, 0x12, 0xa4, 0x45,
+	0xc8, 0xf8, 0x47, 0x41, 0x0d, 0x93, 0x08, 0x78, 0xe8, 0xba, 0x3c, 0x09, 0x62, 0x55, 0x43, 0x2b,
+	0x64, 0x38, 0x8c, 0x40, 0x08, 0x4d, 0xd1, 0x95, 0xce, 0x9a, 0x35, 0x35, 0xd5, 0x1f, 0xd0, 0x4a,
+	0x98, 0x38, 0xf6, 0x39, 0xa4, 0xda, 0x7b, 0xba, 0xd2, 0x69, 0xf4, 0x9b, 0xdd, 0x82, 0xb6, 0x3b,
+	0xa5, 0xed, 0x3e, 0x0c, 0x52, 0x73, 0xf7, 0xef, 0x0c, 0x37, 0xc3, 0xc4, 0xf1, 0xa9, 0x9b, 0x63,
+	0x3f, 0xe1, 0x8c, 0xc6, 0xc0, 0xc2, 0x38, 0x9d, 0x64, 0x78, 0x33, 0x25, 0xcc, 0x1f, 0x18, 0xd7,
+	0x51, 0xc3, 0x5a, 0x0e, 0x13, 0xe7, 0x6b, 0x48, 0xd5, 0x2f, 0xd0, 0x06, 0x29, 0x4a, 0xb0, 0x83,
+	0x84, 0x39, 0x10, 0x69, 0x4b, 0xba, 0xd2, 0xa9, 0x9b, 0x3b, 0x93, 0x0c, 0xdf, 0x2d, 0xd2, 0xe6,
+	0xe3, 0x86, 0x75, 0xbb, 0x74, 0x3c, 0x95, 0xb6, 0xda, 0x42, 0xab, 0x02, 0x9e, 0x25, 0x10, 0xb8,
+	0xa0, 0xd5, 0xf3, 0x5c, 0x6b, 0x66, 0x0f, 0xb4, 0x9f, 0x2e, 0x70, 0xed, 0xe7, 0x0b, 0x5c, 0xfb,
+	0xeb, 0x02, 0xd7, 0xde, 0xbc, 0xda, 0x5d, 0x2d, 0xdb, 0x3d, 0x34, 0x7e, 0x55, 0xd0, 0xed, 0x23,
+	0x3e, 0x4c, 0xfc, 0xd9, 0x04, 0x7e, 0x44, 0xeb, 0xf9, 0x74, 0xed, 0x92, 0x5d, 0x8e, 0xa1, 0xd1,
+	0xd7, 0xbb, 0x0b, 0x36, 0xd5, 0xad, 0x4c, 0xce, 0x7c, 0xff, 0x32, 0xc3, 0xca, 0x24, 0xc3, 0x5b,
+	0x45, 0xb5, 0x55, 0x0e, 0xc3, 0x6a, 0x38, 0x95, 0x19, 0xab, 0xa8, 0x1e, 0x10, 0x06, 0x72, 0x8c,
+	0x6b, 0x96, 0xfc, 0x56, 0x75, 0xd4, 0x08, 0x21, 0x62, 0x54, 0x08, 0xca, 0x03, 0xa1, 0x2d, 0xe9,
+	0x4b, 0x9d, 0x35, 0xab, 0xea, 0x1a, 0xb4, 0xa6, 0x3d, 0xbc, 0x79, 0xb5, 0xbb, 0x31, 0x57, 0xf2,
+	0xa1, 0xf1, 0xf6, 0x16, 0x5a, 0x3e, 0x26, 0x11, 0x61, 0x42, 0x7d, 0x8a, 0xb6, 0x18, 0x19, 0xd9,
+	0x0c, 0x18, 0xb7, 0xdd, 0x33, 0x12, 0x11, 0x37, 0x86, 0xa8, 0x58, 0x66, 0xdd, 0x6c, 0x4f, 0x32,
+	0xdc, 0x2a, 0xea, 0x5b, 0x00, 0x32, 0xac, 0x4d, 0x46, 0x46, 0x47, 0xc0, 0xf8, 0xc1, 0xcc, 0xa7,
+	0x3e, 0x40, 0xeb, 0xf1, 0xc8, 0x16, 0xd4, 0xb3, 0x7d, 0xca, 0x68, 0x2c, 0x8b, 0xae, 0x9b, 0xdb,
+	0xd7, 0x8d, 0x56, 0xa3, 0x86, 0x85, 0xe2, 0xd1, 0x09, 0xf5, 0x9e, 0xe4, 0x86, 0x6a, 0xa1, 0xbb,
+	0x32, 0xf8, 0x02, 0x6c, 0x97, 0x8b, 0xd8, 0x0e, 0x21, 0xb2, 0x9d, 0x34, 0x86, 0x72, 0xb5, 0xfa,
+	0x24, 0xc3, 0xf7, 0x2b, 0x1c, 0x37, 0x61, 0x86, 0xb5, 0x99, 0x93, 0xbd, 0x80, 0x03, 0x2e, 0xe2,
+	0x63, 0x88, 0xcc, 0x34, 0x06, 0xf5, 0x19, 0xda, 0xce, 0xd5, 0x9e, 0x43, 0x44, 0x4f, 0xd3, 0x02,
+	0x0f, 0xc3, 0xfe, 0xfe, 0xfe, 0xde, 0x83, 0x62, 0xe9, 0xe6, 0x60, 0x9c, 0xe1, 0xe6, 0x09, 0xf5,
+	0xbe, 0x93, 0x88, 0x3c, 0xf5, 0xcb, 0x47, 0x32, 0x3e, 0xc9, 0x70, 0xbb, 0x50, 0xfb, 0x0f, 0x02,
+	0xc3, 0x6a, 0x8a, 0xb9, 0xbc, 0xc2, 0xad, 0xa6, 0x68, 0xe7, 0x66, 0x86, 0x00, 0x37, 0xec, 0xef,
+	0x7f, 0x76, 0xbe, 0xa7, 0xdd, 0x92, 0xa2, 0x9f, 0x8f, 0x33, 0x7c, 0x6f, 0x4e, 0xf4, 0x64, 0x8a,
+	0x98, 0x64, 0x58, 0x5f, 0x2c, 0x3b, 0x23, 0x31, 0xac, 0x7b, 0x62, 0x61, 0xae, 0xea, 0xa3, 0x3b,
+	0x4c, 0x78, 0xf6, 0x29, 0x80, 0x1d, 0x72, 0x9f, 0xba, 0x14, 0x84, 0xb6, 0xac, 0x2f, 0x75, 0x1a,
+	0xfd, 0x0f, 0x16, 0x9e, 0xc7, 0x23, 0xe1, 0x3d, 0x06, 0x38, 0xce, 0xa1, 0xa9, 0x89, 0x5f, 0x67,
+	0xb8, 0x36, 0xc9, 0xf0, 0x76, 0xb9, 0xf0, 0x1b, 0x44, 0x86, 0xb5, 0xc1, 0xae, 0xe1, 0x14, 0x84,
+	0xfa, 0x52, 0x41, 0x1b, 0x8c, 0x06, 0xb6, 0x47, 0xf2, 0xb7, 0x87, 0xba, 0x20, 0xb4, 0x15, 0x29,
+	0x76, 0x7f, 0x2a, 0x96, 0x9f, 0xe2, 0x99, 0xd8, 0x23, 0x70, 0x0f, 0x38, 0x0d, 0xcc, 0x27, 0xa5,
+	0x4e, 0x79, 0x4d, 0xe7, 0x19, 0x8c, 0x5f, 0xfe, 0xc4, 0x1f, 0x7b, 0x34, 0x3e, 0x4b, 0x9c, 0xae,
+	0xcb, 0x59, 0xf9, 0x9a, 0x95, 0x3f, 0xbb, 0x62, 0x78, 0xde, 0x8b, 0xd3, 0x10, 0xc4, 0x94, 0x4c,
+	0x58, 0xeb, 0x8c, 0x06, 0x5f, 0x11, 0x71, 0x2c, 0xb3, 0x07, 0xab, 0xe5, 0xa5, 0x55, 0x8c, 0xdf,
+	0x14, 0xb4, 0x5e, 0x6d, 0x2f, 0x3f, 0x98, 0x79, 0x4b, 0x79, 0xba, 0x9d, 0x44, 0x7e, 0xf1, 0x5c,
+	0x55, 0x0f, 0x66, 0x35, 0x6a, 0x58, 0x88, 0x09, 0xef, 0x9b, 0x34, 0x84, 0x6f, 0x23, 0x5f, 0x15,
+	0xe8, 0xce, 0xac, 0x42, 0xfb, 0x94, 0xb8, 0x31, 0x8f, 0x8a, 0xcb, 0x68, 0x1e, 0xe6, 0xbd, 0xfc,
+	0x91, 0xe1, 0x0f, 0xdf, 0xad, 0xe4, 0xeb, 0xe9, 0xde, 0xe4, 0x33, 0xac, 0x0d, 0xaf, 0xec, 0xe2,
+	0xb1, 0x74, 0x0c, 0xea, 0x79, 0x1b, 0xe6, 0xc1, 0xeb, 0x71, 0x5b, 0xb9, 0x1c, 0xb7, 0x95, 0xb7,
+	0xe3, 0xb6, 0xf2, 0xf2, 0xaa, 0x5d, 0xbb, 0xbc, 0x6a, 0xd7, 0x7e, 0xbf, 0x6a, 0xd7, 0xbe, 0xff,
+	0xe8, 0x7f, 0x25, 0x47, 0xc5, 0x5f, 0x88, 0x54, 0x76, 0x96, 0xe5, 0x8b, 0xfb, 0xe9, 0xbf, 0x03,
+	0x00, 0x2d, 0xd2, 0x4a, 0xc0, 0x5e, 0x06, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -271,6 +356,49 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.SigVerifyCostSecp256k1 != that1.SigVerifyCostSecp256k1 {
 		return false
 	}
+	if len(this.MsgFeePolicies) != len(that1.MsgFeePolicies) {
+		return false
+	}
+	for i := range this.MsgFeePolicies {
+		if !this.MsgFeePolicies[i].Equal(&that1.MsgFeePolicies[i]) {
+			return false
+		}
+	}
+	if len(this.MinGasPrices) != len(that1.MinGasPrices) {
+		return false
+	}
+	for i := range this.MinGasPrices {
+		if !this.MinGasPrices[i].Equal(&that1.MinGasPrices[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgFeePolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgFeePolicy)
+	if !ok {
+		that2, ok := that.(MsgFeePolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MsgTypeUrl != that1.MsgTypeUrl {
+		return false
+	}
+	if !this.GasPriceFactor.Equal(that1.GasPriceFactor) {
+		return false
+	}
 	return true
 }
 func (m *BaseAccount) Marshal() (dAtA []byte, err error) {
@@ -396,6 +524,34 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.MsgFeePolicies) > 0 {
+		for iNdEx := len(m.MsgFeePolicies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgFeePolicies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuth(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.SigVerifyCostSecp256k1 != 0 {
 		i = encodeVarintAuth(dAtA, i, uint64(m.SigVerifyCostSecp256k1))
 		i--
@@ -424,6 +580,46 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgFeePolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFeePolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFeePolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.GasPriceFactor.Size()
+		i -= size
+		if _, err := m.GasPriceFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuth(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintAuth(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuth(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuth(v)
 	base := offset
@@ -502,6 +698,33 @@ func (m *Params) Size() (n int) {
 	if m.SigVerifyCostSecp256k1 != 0 {
 		n += 1 + sovAuth(uint64(m.SigVerifyCostSecp256k1))
 	}
+	if len(m.MsgFeePolicies) > 0 {
+		for _, e := range m.MsgFeePolicies {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovAuth(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgFeePolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovAuth(uint64(l))
+	}
+	l = m.GasPriceFactor.Size()
+	n += 1 + l + sovAuth(uint64(l))
 	return n
 }
 
@@ -941,6 +1164,190 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgFeePolicies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgFeePolicies = append(m.MsgFeePolicies, MsgFeePolicy{})
+			if err := m.MsgFeePolicies[len(m.MsgFeePolicies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types1.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuth(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFeePolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuth
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFeePolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFeePolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasPriceFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuth
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuth
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuth
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GasPriceFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuth(dAtA[iNdEx:])
@@ -9,6 +9,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 )
 
@@ -70,7 +71,11 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
-	return ValidateGenAccounts(genAccs)
+	if err := ValidateGenAccounts(genAccs); err != nil {
+		return err
+	}
+
+	return ValidateUnorderedNonces(data.UnorderedNonces)
 }
 
 // SanitizeGenesisAccounts sorts accounts and coin sets.
@@ -103,6 +108,27 @@ func ValidateGenAccounts(accounts GenesisAccounts) error {
 	return nil
 }
 
+// ValidateUnorderedNonces validates the nonces of the unordered txs and checks
+// for duplicates
+func ValidateUnorderedNonces(nonces []UnorderedNonce) error {
+	seen := make(map[UnorderedNonce]bool, len(nonces))
+
+	for _, nonce := range nonces {
+		if _, err := sdk.AccAddressFromBech32(nonce.Address); err != nil {
+			return fmt.Errorf("invalid unordered tx nonce signer address %s: %w", nonce.Address, err)
+		}
+		if nonce.TimeoutHeight == 0 {
+			return fmt.Errorf("unordered tx nonce %d of %s has no timeout height", nonce.Nonce, nonce.Address)
+		}
+		if seen[nonce] {
+			return fmt.Errorf("duplicate unordered tx nonce %d of %s with timeout height %d", nonce.Nonce, nonce.Address, nonce.TimeoutHeight)
+		}
+
+		seen[nonce] = true
+	}
+	return nil
+}
+
 // GenesisAccountIterator implements genesis account iteration.
 type GenesisAccountIterator struct{}
 
@@ -30,6 +30,9 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// accounts are the accounts present at genesis.
 	Accounts []*types.Any `protobuf:"bytes,2,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// unordered_nonces are the nonces used by the signers of the unordered txs
+	// which have not timed out yet.
+	UnorderedNonces []UnorderedNonce `protobuf:"bytes,3,rep,name=unordered_nonces,json=unorderedNonces,proto3" json:"unordered_nonces"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -79,30 +82,109 @@ func (m *GenesisState) GetAccounts() []*types.Any {
 	return nil
 }
 
+func (m *GenesisState) GetUnorderedNonces() []UnorderedNonce {
+	if m != nil {
+		return m.UnorderedNonces
+	}
+	return nil
+}
+
+// UnorderedNonce defines the nonce used by a signer of an unordered tx, which is
+// recorded until the timeout height of the tx.
+type UnorderedNonce struct {
+	// timeout_height is the timeout height of the unordered tx.
+	TimeoutHeight uint64 `protobuf:"varint,1,opt,name=timeout_height,json=timeoutHeight,proto3" json:"timeout_height,omitempty"`
+	// address is the bech32 address of the signer.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// nonce is the nonce used by the signer.
+	Nonce uint64 `protobuf:"varint,3,opt,name=nonce,proto3" json:"nonce,omitempty"`
+}
+
+func (m *UnorderedNonce) Reset()         { *m = UnorderedNonce{} }
+func (m *UnorderedNonce) String() string { return proto.CompactTextString(m) }
+func (*UnorderedNonce) ProtoMessage()    {}
+func (*UnorderedNonce) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d897ccbce9822332, []int{1}
+}
+func (m *UnorderedNonce) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UnorderedNonce) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UnorderedNonce.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UnorderedNonce) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnorderedNonce.Merge(m, src)
+}
+func (m *UnorderedNonce) XXX_Size() int {
+	return m.Size()
+}
+func (m *UnorderedNonce) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnorderedNonce.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnorderedNonce proto.InternalMessageInfo
+
+func (m *UnorderedNonce) GetTimeoutHeight() uint64 {
+	if m != nil {
+		return m.TimeoutHeight
+	}
+	return 0
+}
+
+func (m *UnorderedNonce) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *UnorderedNonce) GetNonce() uint64 {
+	if m != nil {
+		return m.Nonce
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.auth.v1beta1.GenesisState")
+	proto.RegisterType((*UnorderedNonce)(nil), "cosmos.auth.v1beta1.UnorderedNonce")
 }
 
 func init() { proto.RegisterFile("cosmos/auth/v1beta1/genesis.proto", fileDescriptor_d897ccbce9822332) }
 
 var fileDescriptor_d897ccbce9822332 = []byte{
-	// 252 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0x4f, 0x2c, 0x2d, 0xc9, 0xd0, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x92, 0x4c, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5,
-	0x07, 0x2b, 0x49, 0x2a, 0x4d, 0xd3, 0x4f, 0xcc, 0xab, 0x84, 0xa8, 0x97, 0x12, 0x49, 0xcf, 0x4f,
-	0xcf, 0x07, 0x33, 0xf5, 0x41, 0x2c, 0xa8, 0xa8, 0x1c, 0x36, 0x8b, 0xc0, 0x46, 0x82, 0xe5, 0x95,
-	0xaa, 0xb9, 0x78, 0xdc, 0x21, 0xd6, 0x06, 0x97, 0x24, 0x96, 0xa4, 0x0a, 0x59, 0x72, 0xb1, 0x15,
-	0x24, 0x16, 0x25, 0xe6, 0x16, 0x4b, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0x71,
-	0x86, 0x5e, 0x00, 0x58, 0x89, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x0d, 0x42, 0x06,
-	0x5c, 0x1c, 0x89, 0xc9, 0xc9, 0xf9, 0xa5, 0x79, 0x25, 0xc5, 0x12, 0x4c, 0x0a, 0xcc, 0x1a, 0xdc,
-	0x46, 0x22, 0x7a, 0x10, 0xe7, 0xea, 0xc1, 0x9c, 0xab, 0xe7, 0x98, 0x57, 0x19, 0x04, 0x57, 0xe5,
-	0xe4, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78,
-	0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51, 0x9a, 0xe9, 0x99, 0x25,
-	0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x50, 0x1f, 0x40, 0x28, 0xdd, 0xe2, 0x94, 0x6c,
-	0xfd, 0x0a, 0x88, 0x77, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x86, 0x1b, 0x03, 0x02,
-	0x00, 0x00, 0xff, 0xff, 0xac, 0xe2, 0xe7, 0xb6, 0x53, 0x01, 0x00, 0x00,
+	// 345 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xb1, 0x4e, 0xf3, 0x30,
+	0x14, 0x85, 0x93, 0xbf, 0xfd, 0x0b, 0xb8, 0x50, 0x50, 0xe8, 0x10, 0x8a, 0x64, 0x4a, 0x11, 0x52,
+	0x19, 0xb0, 0x69, 0x99, 0x18, 0x29, 0x03, 0x4c, 0x08, 0x05, 0x58, 0x58, 0x2a, 0x27, 0x31, 0x4e,
+	0x04, 0xb1, 0xab, 0xd8, 0x46, 0xf4, 0x2d, 0x78, 0xac, 0x8e, 0x65, 0x63, 0x42, 0xa8, 0x7d, 0x11,
+	0x14, 0x3b, 0x45, 0xaa, 0xd4, 0x29, 0xf7, 0x9e, 0x7c, 0xd7, 0xe7, 0xd8, 0x17, 0x1c, 0x46, 0x42,
+	0x66, 0x42, 0x62, 0xa2, 0x55, 0x82, 0xdf, 0x7a, 0x21, 0x55, 0xa4, 0x87, 0x19, 0xe5, 0x54, 0xa6,
+	0x12, 0x8d, 0x72, 0xa1, 0x84, 0xb7, 0x6b, 0x11, 0x54, 0x20, 0xa8, 0x44, 0x5a, 0x7b, 0x4c, 0x08,
+	0xf6, 0x4a, 0xb1, 0x41, 0x42, 0xfd, 0x8c, 0x09, 0x1f, 0x5b, 0xbe, 0xd5, 0x64, 0x82, 0x09, 0x53,
+	0xe2, 0xa2, 0x2a, 0x55, 0xb8, 0xca, 0xc8, 0x1c, 0x69, 0xfe, 0x77, 0x3e, 0x5d, 0xb0, 0x79, 0x6d,
+	0x7d, 0xef, 0x15, 0x51, 0xd4, 0xbb, 0x00, 0xb5, 0x11, 0xc9, 0x49, 0x26, 0x7d, 0xb7, 0xed, 0x76,
+	0xeb, 0xfd, 0x7d, 0xb4, 0x22, 0x07, 0xba, 0x33, 0xc8, 0xa0, 0x3a, 0xf9, 0x3e, 0x70, 0x82, 0x72,
+	0xc0, 0x3b, 0x03, 0xeb, 0x24, 0x8a, 0x84, 0xe6, 0x4a, 0xfa, 0xff, 0xda, 0x95, 0x6e, 0xbd, 0xdf,
+	0x44, 0x36, 0x2f, 0x5a, 0xe4, 0x45, 0x97, 0x7c, 0x1c, 0xfc, 0x51, 0xde, 0x03, 0xd8, 0xd1, 0x5c,
+	0xe4, 0x31, 0xcd, 0x69, 0x3c, 0xe4, 0x82, 0x47, 0x54, 0xfa, 0x15, 0x33, 0x79, 0xb4, 0xd2, 0xf6,
+	0x71, 0x01, 0xdf, 0x16, 0x6c, 0x69, 0xbf, 0xad, 0x97, 0x54, 0xd9, 0x61, 0xa0, 0xb1, 0x0c, 0x7a,
+	0xc7, 0xa0, 0xa1, 0xd2, 0x8c, 0x0a, 0xad, 0x86, 0x09, 0x4d, 0x59, 0xa2, 0xcc, 0xe5, 0xaa, 0xc1,
+	0x56, 0xa9, 0xde, 0x18, 0xd1, 0xf3, 0xc1, 0x1a, 0x89, 0xe3, 0x9c, 0xca, 0x22, 0xbf, 0xdb, 0xdd,
+	0x08, 0x16, 0xad, 0xd7, 0x04, 0xff, 0x4d, 0x3c, 0xbf, 0x62, 0xe6, 0x6c, 0x33, 0xb8, 0x9a, 0xcc,
+	0xa0, 0x3b, 0x9d, 0x41, 0xf7, 0x67, 0x06, 0xdd, 0x8f, 0x39, 0x74, 0xa6, 0x73, 0xe8, 0x7c, 0xcd,
+	0xa1, 0xf3, 0x74, 0xc2, 0x52, 0x95, 0xe8, 0x10, 0x45, 0x22, 0xc3, 0xe5, 0x06, 0xec, 0xe7, 0x54,
+	0xc6, 0x2f, 0xf8, 0xdd, 0xae, 0x43, 0x8d, 0x47, 0x54, 0x86, 0x35, 0xf3, 0x36, 0xe7, 0xbf, 0x03,
+	0x00, 0x8d, 0xd9, 0xd0, 0xeb, 0x13, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -125,6 +207,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.UnorderedNonces) > 0 {
+		for iNdEx := len(m.UnorderedNonces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UnorderedNonces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Accounts) > 0 {
 		for iNdEx := len(m.Accounts) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -152,6 +248,46 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *UnorderedNonce) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UnorderedNonce) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UnorderedNonce) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.TimeoutHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.TimeoutHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -177,6 +313,31 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.UnorderedNonces) > 0 {
+		for _, e := range m.UnorderedNonces {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UnorderedNonce) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TimeoutHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.TimeoutHeight))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovGenesis(uint64(m.Nonce))
+	}
 	return n
 }
 
@@ -282,6 +443,160 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnorderedNonces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UnorderedNonces = append(m.UnorderedNonces, UnorderedNonce{})
+			if err := m.UnorderedNonces[len(m.UnorderedNonces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UnorderedNonce) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UnorderedNonce: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UnorderedNonce: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutHeight", wireType)
+			}
+			m.TimeoutHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
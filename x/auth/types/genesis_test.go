@@ -50,6 +50,16 @@ func TestValidateGenesisDuplicateAccounts(t *testing.T) {
 	require.Error(t, types.ValidateGenAccounts(genAccs))
 }
 
+func TestValidateUnorderedNonces(t *testing.T) {
+	addr := sdk.AccAddress(addr1).String()
+	nonce := types.UnorderedNonce{TimeoutHeight: 10, Address: addr, Nonce: 1}
+
+	require.NoError(t, types.ValidateUnorderedNonces([]types.UnorderedNonce{nonce, {TimeoutHeight: 10, Address: addr, Nonce: 2}}))
+	require.Error(t, types.ValidateUnorderedNonces([]types.UnorderedNonce{nonce, nonce}))
+	require.Error(t, types.ValidateUnorderedNonces([]types.UnorderedNonce{{TimeoutHeight: 10, Address: "invalid", Nonce: 1}}))
+	require.Error(t, types.ValidateUnorderedNonces([]types.UnorderedNonce{{Address: addr, Nonce: 1}}))
+}
+
 func TestGenesisAccountIterator(t *testing.T) {
 	acc1 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr1))
 	acc2 := types.NewBaseAccountWithAddress(sdk.AccAddress(addr2))
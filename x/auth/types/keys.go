@@ -2,6 +2,7 @@ package types
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 )
 
 const (
@@ -22,6 +23,10 @@ var (
 	// AddressStoreKeyPrefix prefix for account-by-address store
 	AddressStoreKeyPrefix = []byte{0x01}
 
+	// UnorderedNonceKeyPrefix prefix for the signer nonces of the processed
+	// unordered txs, indexed by timeout height
+	UnorderedNonceKeyPrefix = []byte{0x02}
+
 	// param key for global account number
 	GlobalAccountNumberKey = []byte("globalAccountNumber")
 )
@@ -30,3 +35,16 @@ var (
 func AddressStoreKey(addr sdk.AccAddress) []byte {
 	return append(AddressStoreKeyPrefix, addr.Bytes()...)
 }
+
+// UnorderedNonceByTimeoutPrefix returns the prefix of all unordered tx nonces
+// with the given timeout height.
+func UnorderedNonceByTimeoutPrefix(timeoutHeight uint64) []byte {
+	return append(UnorderedNonceKeyPrefix, sdk.Uint64ToBigEndian(timeoutHeight)...)
+}
+
+// UnorderedNonceKey returns the key under which the nonce used by a signer of
+// an unordered tx is recorded.
+func UnorderedNonceKey(timeoutHeight uint64, addr sdk.AccAddress, nonce uint64) []byte {
+	key := append(UnorderedNonceByTimeoutPrefix(timeoutHeight), address.MustLengthPrefix(addr)...)
+	return append(key, sdk.Uint64ToBigEndian(nonce)...)
+}
@@ -0,0 +1,76 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMsgFeePolicy creates a new MsgFeePolicy object
+func NewMsgFeePolicy(msgTypeURL string, gasPriceFactor sdk.Dec) MsgFeePolicy {
+	return MsgFeePolicy{
+		MsgTypeUrl:     msgTypeURL,
+		GasPriceFactor: gasPriceFactor,
+	}
+}
+
+// Validate checks that the policy has a message type URL and a non-negative
+// gas price factor.
+func (p MsgFeePolicy) Validate() error {
+	if !strings.HasPrefix(p.MsgTypeUrl, "/") {
+		return fmt.Errorf("invalid message type URL: %q", p.MsgTypeUrl)
+	}
+	if p.GasPriceFactor.IsNil() || p.GasPriceFactor.IsNegative() {
+		return fmt.Errorf("invalid gas price factor of %s: %s", p.MsgTypeUrl, p.GasPriceFactor)
+	}
+
+	return nil
+}
+
+// ValidateMsgFeePolicies checks that the policies are valid and that there is
+// at most one policy per message type.
+func ValidateMsgFeePolicies(policies []MsgFeePolicy) error {
+	seen := make(map[string]bool, len(policies))
+	for _, p := range policies {
+		if err := p.Validate(); err != nil {
+			return err
+		}
+		if seen[p.MsgTypeUrl] {
+			return fmt.Errorf("duplicate fee policy for %s", p.MsgTypeUrl)
+		}
+		seen[p.MsgTypeUrl] = true
+	}
+
+	return nil
+}
+
+// ParseMsgFeePolicies parses the policies of the msg-fee-policies config of the
+// nodes, a list of <msg type URL>=<gas price factor> separated by semicolons,
+// e.g. "/cosmos.slashing.v1beta1.MsgUnjail=0;/cosmos.bank.v1beta1.MsgMultiSend=2".
+func ParseMsgFeePolicies(policiesStr string) ([]MsgFeePolicy, error) {
+	policiesStr = strings.TrimSpace(policiesStr)
+	if policiesStr == "" {
+		return nil, nil
+	}
+
+	var policies []MsgFeePolicy
+	for _, policyStr := range strings.Split(policiesStr, ";") {
+		parts := strings.Split(strings.TrimSpace(policyStr), "=")
+		if len(parts) != 2 {
+			return nil, fmt.Errorf("invalid message fee policy: %q", policyStr)
+		}
+
+		factor, err := sdk.NewDecFromStr(strings.TrimSpace(parts[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid gas price factor in %q: %w", policyStr, err)
+		}
+		policies = append(policies, NewMsgFeePolicy(strings.TrimSpace(parts[0]), factor))
+	}
+
+	if err := ValidateMsgFeePolicies(policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
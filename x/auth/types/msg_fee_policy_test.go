@@ -0,0 +1,48 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+func TestParseMsgFeePolicies(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected []types.MsgFeePolicy
+		expErr   bool
+	}{
+		{"empty", "", nil, false},
+		{"blank", "  ", nil, false},
+		{"single", "/cosmos.slashing.v1beta1.MsgUnjail=0", []types.MsgFeePolicy{
+			types.NewMsgFeePolicy("/cosmos.slashing.v1beta1.MsgUnjail", sdk.ZeroDec()),
+		}, false},
+		{"multiple with spaces", "/cosmos.slashing.v1beta1.MsgUnjail=0.5; /cosmos.bank.v1beta1.MsgMultiSend = 2", []types.MsgFeePolicy{
+			types.NewMsgFeePolicy("/cosmos.slashing.v1beta1.MsgUnjail", sdk.NewDecWithPrec(5, 1)),
+			types.NewMsgFeePolicy("/cosmos.bank.v1beta1.MsgMultiSend", sdk.NewDec(2)),
+		}, false},
+		{"missing factor", "/cosmos.slashing.v1beta1.MsgUnjail", nil, true},
+		{"invalid factor", "/cosmos.slashing.v1beta1.MsgUnjail=free", nil, true},
+		{"negative factor", "/cosmos.slashing.v1beta1.MsgUnjail=-1", nil, true},
+		{"invalid type URL", "cosmos.slashing.v1beta1.MsgUnjail=0", nil, true},
+		{"duplicate", "/cosmos.slashing.v1beta1.MsgUnjail=0;/cosmos.slashing.v1beta1.MsgUnjail=1", nil, true},
+		{"trailing separator", "/cosmos.slashing.v1beta1.MsgUnjail=0;", nil, true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			policies, err := types.ParseMsgFeePolicies(tt.input)
+			if tt.expErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, policies)
+		})
+	}
+}
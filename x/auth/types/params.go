@@ -5,6 +5,7 @@ import (
 
 	yaml "gopkg.in/yaml.v2"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
 
@@ -24,6 +25,8 @@ var (
 	KeyTxSizeCostPerByte      = []byte("TxSizeCostPerByte")
 	KeySigVerifyCostED25519   = []byte("SigVerifyCostED25519")
 	KeySigVerifyCostSecp256k1 = []byte("SigVerifyCostSecp256k1")
+	KeyMsgFeePolicies         = []byte("MsgFeePolicies")
+	KeyMinGasPrices           = []byte("MinGasPrices")
 )
 
 var _ paramtypes.ParamSet = &Params{}
@@ -55,6 +58,8 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyTxSizeCostPerByte, &p.TxSizeCostPerByte, validateTxSizeCostPerByte),
 		paramtypes.NewParamSetPair(KeySigVerifyCostED25519, &p.SigVerifyCostED25519, validateSigVerifyCostED25519),
 		paramtypes.NewParamSetPair(KeySigVerifyCostSecp256k1, &p.SigVerifyCostSecp256k1, validateSigVerifyCostSecp256k1),
+		paramtypes.NewParamSetPair(KeyMsgFeePolicies, &p.MsgFeePolicies, validateMsgFeePolicies),
+		paramtypes.NewParamSetPair(KeyMinGasPrices, &p.MinGasPrices, validateMinGasPrices),
 	}
 }
 
@@ -81,6 +86,24 @@ func (p Params) SigVerifyCostSecp256r1() uint64 {
 	return p.SigVerifyCostSecp256k1 / 2
 }
 
+// EffectiveMinGasPrices returns the minimum gas prices a transaction's fees
+// must meet to be accepted by a node with the local minimum gas prices. If there
+// are no global minimum gas prices, the local ones are returned. Otherwise only
+// the denoms of the global minimum gas prices are accepted, with the highest of
+// the global and local prices, so the transactions accepted by the node also
+// meet the global minimum gas prices.
+func (p Params) EffectiveMinGasPrices(local sdk.DecCoins) sdk.DecCoins {
+	if p.MinGasPrices.Empty() {
+		return local
+	}
+
+	prices := make(sdk.DecCoins, len(p.MinGasPrices))
+	for i, gp := range p.MinGasPrices {
+		prices[i] = sdk.NewDecCoinFromDec(gp.Denom, sdk.MaxDec(gp.Amount, local.AmountOf(gp.Denom)))
+	}
+	return prices
+}
+
 // String implements the stringer interface.
 func (p Params) String() string {
 	out, _ := yaml.Marshal(p)
@@ -152,6 +175,28 @@ func validateTxSizeCostPerByte(i interface{}) error {
 	return nil
 }
 
+func validateMsgFeePolicies(i interface{}) error {
+	v, ok := i.([]MsgFeePolicy)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return ValidateMsgFeePolicies(v)
+}
+
+func validateMinGasPrices(i interface{}) error {
+	v, ok := i.(sdk.DecCoins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid minimum gas prices: %w", err)
+	}
+
+	return nil
+}
+
 // Validate checks that the parameters have valid values.
 func (p Params) Validate() error {
 	if err := validateTxSigLimit(p.TxSigLimit); err != nil {
@@ -169,6 +214,12 @@ func (p Params) Validate() error {
 	if err := validateTxSizeCostPerByte(p.TxSizeCostPerByte); err != nil {
 		return err
 	}
+	if err := ValidateMsgFeePolicies(p.MsgFeePolicies); err != nil {
+		return err
+	}
+	if err := validateMinGasPrices(p.MinGasPrices); err != nil {
+		return err
+	}
 
 	return nil
 }
@@ -6,6 +6,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/auth/types"
 )
 
@@ -18,6 +19,18 @@ func TestParamsEqual(t *testing.T) {
 	require.NotEqual(t, p1, p2)
 }
 
+func withMsgFeePolicies(policies ...types.MsgFeePolicy) types.Params {
+	params := types.DefaultParams()
+	params.MsgFeePolicies = policies
+	return params
+}
+
+func withMinGasPrices(prices sdk.DecCoins) types.Params {
+	params := types.DefaultParams()
+	params.MinGasPrices = prices
+	return params
+}
+
 func TestParams_Validate(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -35,6 +48,16 @@ func TestParams_Validate(t *testing.T) {
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid max memo characters: 0")},
 		{"invalid tx size cost per byte", types.NewParams(types.DefaultMaxMemoCharacters, types.DefaultTxSigLimit, 0,
 			types.DefaultSigVerifyCostED25519, types.DefaultSigVerifyCostSecp256k1), fmt.Errorf("invalid tx size cost per byte: 0")},
+		{"duplicate message fee policy", withMsgFeePolicies(
+			types.NewMsgFeePolicy("/cosmos.bank.v1beta1.MsgSend", sdk.OneDec()),
+			types.NewMsgFeePolicy("/cosmos.bank.v1beta1.MsgSend", sdk.ZeroDec()),
+		), fmt.Errorf("duplicate fee policy for /cosmos.bank.v1beta1.MsgSend")},
+		{"negative gas price factor", withMsgFeePolicies(
+			types.NewMsgFeePolicy("/cosmos.bank.v1beta1.MsgSend", sdk.NewDec(-1)),
+		), fmt.Errorf("invalid gas price factor of /cosmos.bank.v1beta1.MsgSend: -1.000000000000000000")},
+		{"invalid minimum gas prices", withMinGasPrices(sdk.DecCoins{
+			sdk.NewInt64DecCoin("stake", 1), sdk.NewInt64DecCoin("atom", 1),
+		}), fmt.Errorf("invalid minimum gas prices: %w", fmt.Errorf("denomination atom is not sorted"))},
 	}
 	for _, tt := range tests {
 		tt := tt
@@ -48,3 +71,29 @@ func TestParams_Validate(t *testing.T) {
 		})
 	}
 }
+
+func TestParams_EffectiveMinGasPrices(t *testing.T) {
+	local := sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 1)), sdk.NewDecCoinFromDec("photon", sdk.NewDecWithPrec(5, 1)))
+
+	tests := []struct {
+		name     string
+		global   sdk.DecCoins
+		local    sdk.DecCoins
+		expected sdk.DecCoins
+	}{
+		{"no minimum gas prices", nil, nil, nil},
+		{"local minimum gas prices", nil, local, local},
+		{"global minimum gas prices", sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)), nil,
+			sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1))},
+		{"local denoms not in the global minimum gas prices", sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1)), local,
+			sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 1))},
+		{"highest price of the denoms", sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(5, 2)), sdk.NewInt64DecCoin("photon", 1)), local,
+			sdk.NewDecCoins(sdk.NewDecCoinFromDec("atom", sdk.NewDecWithPrec(1, 1)), sdk.NewInt64DecCoin("photon", 1))},
+	}
+	for _, tt := range tests {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			require.Equal(t, tt.expected, withMinGasPrices(tt.global).EffectiveMinGasPrices(tt.local))
+		})
+	}
+}
@@ -0,0 +1,56 @@
+package feemarket
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/keeper"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// EndBlocker burns the base fees charged to the txs of the block, if enabled,
+// and adjusts the base gas price of the next block towards the target block gas.
+func EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	var blockGas uint64
+	if meter := ctx.BlockGasMeter(); meter != nil {
+		blockGas = meter.GasConsumedToLimit()
+	}
+	k.SetLastBlockGas(ctx, blockGas)
+
+	params := k.GetParams(ctx)
+	if params.BurnBaseFee {
+		burned, err := k.BurnBaseFee(ctx)
+		if err != nil {
+			panic(err)
+		}
+
+		if !burned.IsZero() {
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeBurnBaseFee,
+					sdk.NewAttribute(sdk.AttributeKeyAmount, burned.String()),
+				),
+			)
+		}
+	}
+
+	if !params.IsEnabled() {
+		return
+	}
+
+	baseGasPrice := k.GetBaseGasPrice(ctx)
+	nextBaseGasPrice := params.NextBaseGasPrice(baseGasPrice, blockGas)
+	k.SetBaseGasPrice(ctx, nextBaseGasPrice)
+
+	ctx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBaseGasPrice,
+			sdk.NewAttribute(types.AttributeKeyBaseGasPrice, nextBaseGasPrice.String()),
+			sdk.NewAttribute(types.AttributeKeyBlockGas, fmt.Sprintf("%d", blockGas)),
+		),
+	)
+}
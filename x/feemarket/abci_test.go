@@ -0,0 +1,96 @@
+package feemarket_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestEndBlocker(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := app.FeeMarketKeeper
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000))
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, authtypes.FeeCollectorName, fees))
+
+	endBlock := func(blockGas uint64) sdk.Context {
+		ctx := ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+		ctx.BlockGasMeter().ConsumeGas(blockGas, "test")
+		feemarket.EndBlocker(ctx, k)
+		return ctx
+	}
+
+	// the disabled fee market only records the block gas
+	endBlock(2_000_000)
+	require.Equal(t, uint64(2_000_000), k.GetLastBlockGas(ctx))
+	require.Equal(t, types.DefaultParams().MinBaseGasPrice, k.GetBaseGasPrice(ctx))
+	require.Equal(t, fees, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.TargetBlockGas = 1_000_000
+	k.SetParams(ctx, params)
+	k.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(8, 3))
+
+	// the price increases above the target
+	endBlock(2_000_000)
+	require.Equal(t, sdk.NewDecWithPrec(9, 3), k.GetBaseGasPrice(ctx))
+
+	// the price decreases below the target
+	endBlock(500_000)
+	require.Equal(t, sdk.NewDecWithPrec(84375, 7), k.GetBaseGasPrice(ctx))
+
+	// no base fee was charged, so none is burned, whatever the gas used
+	require.Equal(t, fees, app.BankKeeper.GetAllBalances(ctx, feeCollector))
+}
+
+func TestEndBlockerBurnBaseFee(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := app.FeeMarketKeeper
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 100_000))
+	require.NoError(t, simapp.FundModuleAccount(app.BankKeeper, ctx, authtypes.FeeCollectorName, fees))
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	k.SetParams(ctx, params)
+
+	// every block has its own transient store
+	endBlock := func(params types.Params, baseFee int64) sdk.Context {
+		ctx, _ := ctx.WithBlockGasMeter(sdk.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager()).CacheContext()
+		k.SetParams(ctx, params)
+		ctx.BlockGasMeter().ConsumeGas(2_000_000, "test")
+		k.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, baseFee))
+		feemarket.EndBlocker(ctx, k)
+		return ctx
+	}
+
+	// exactly the base fees charged to the txs are burned, not the base gas price times the block gas
+	blockCtx := endBlock(params, 12_345)
+	require.Equal(t, sdk.NewInt(87_655), app.BankKeeper.GetBalance(blockCtx, feeCollector, sdk.DefaultBondDenom).Amount)
+	events := blockCtx.EventManager().Events()
+	require.Equal(t, types.EventTypeBurnBaseFee, events[len(events)-2].Type)
+	require.Equal(t, types.EventTypeBaseGasPrice, events[len(events)-1].Type)
+
+	// the base fees charged before the fee market was disabled are burned too
+	params.Enabled = false
+	blockCtx = endBlock(params, 12_345)
+	require.Equal(t, sdk.NewInt(87_655), app.BankKeeper.GetBalance(blockCtx, feeCollector, sdk.DefaultBondDenom).Amount)
+
+	// the base fee is distributed with the other fees
+	params.Enabled = true
+	params.BurnBaseFee = false
+	blockCtx = endBlock(params, 12_345)
+	require.Equal(t, fees, app.BankKeeper.GetAllBalances(blockCtx, feeCollector))
+}
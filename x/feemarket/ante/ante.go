@@ -0,0 +1,92 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// FeeMarketKeeper defines the expected fee market keeper
+type FeeMarketKeeper interface {
+	Enabled(ctx sdk.Context) bool
+	GetParams(ctx sdk.Context) types.Params
+	GetBaseGasPrice(ctx sdk.Context) sdk.Dec
+	AddBlockBaseFee(ctx sdk.Context, fee sdk.Coin)
+}
+
+// AccountKeeper defines the expected account keeper, holding the message fee
+// policies
+type AccountKeeper interface {
+	GetParams(ctx sdk.Context) authtypes.Params
+}
+
+// BaseGasPriceDecorator replaces the static minimum gas price check of the ante
+// chain while the fee market is enabled: the fees of a tx must reach the base
+// gas price, at least the minimum base gas price, multiplied by its gas limit
+// and by the gas price factor of the fee policies of its messages set by
+// governance, in the fee denom. Unlike the
+// static minimum gas prices, the base gas price is enforced in DeliverTx too,
+// except at genesis and in simulations. The base fee charged to the txs in
+// DeliverTx is recorded, to be burned at the end of the block.
+// The fallback decorator is called in any case, e.g. the MsgFeePolicyDecorator
+// checking the node-local minimum gas prices in CheckTx, so the nodes can still
+// require more than the base gas price.
+// CONTRACT: Tx must implement FeeTx to use BaseGasPriceDecorator
+type BaseGasPriceDecorator struct {
+	k        FeeMarketKeeper
+	ak       AccountKeeper
+	fallback sdk.AnteDecorator
+}
+
+// NewBaseGasPriceDecorator returns a BaseGasPriceDecorator, the fallback
+// decorator is optional.
+func NewBaseGasPriceDecorator(k FeeMarketKeeper, ak AccountKeeper, fallback sdk.AnteDecorator) BaseGasPriceDecorator {
+	return BaseGasPriceDecorator{
+		k:        k,
+		ak:       ak,
+		fallback: fallback,
+	}
+}
+
+func (bgd BaseGasPriceDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (newCtx sdk.Context, err error) {
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, sdkerrors.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	if !bgd.k.Enabled(ctx) || simulate || ctx.IsGenesis() {
+		return bgd.next(ctx, tx, simulate, next)
+	}
+
+	params := bgd.k.GetParams(ctx)
+	// the stored base gas price is only adjusted at the end of the block, so it may
+	// be below a minimum raised by governance earlier in the block
+	baseGasPrice := sdk.MaxDec(bgd.k.GetBaseGasPrice(ctx), params.MinBaseGasPrice)
+	// fee = ceil(baseGasPrice * factor * gasLimit)
+	factor := authante.GasPriceFactor(bgd.ak.GetParams(ctx), tx.GetMsgs())
+	requiredFee := sdk.NewCoin(params.FeeDenom, baseGasPrice.Mul(factor).MulInt(sdk.NewIntFromUint64(feeTx.GetGas())).Ceil().RoundInt())
+
+	fee := feeTx.GetFee()
+	if fee.AmountOf(params.FeeDenom).LT(requiredFee.Amount) {
+		return ctx, sdkerrors.Wrapf(sdkerrors.ErrInsufficientFee, "insufficient fees for the base gas price; got: %s required: %s", fee, requiredFee)
+	}
+
+	// record the base fee charged to the tx, so exactly the base fees are burned
+	// at the end of the block. The record is not charged to the tx, so the gas
+	// estimated by simulations remains accurate.
+	if !ctx.IsCheckTx() {
+		bgd.k.AddBlockBaseFee(ctx.WithGasMeter(sdk.NewInfiniteGasMeter()), requiredFee)
+	}
+
+	return bgd.next(ctx, tx, simulate, next)
+}
+
+// next calls the fallback decorator, if any, or the next AnteHandler
+func (bgd BaseGasPriceDecorator) next(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	if bgd.fallback != nil {
+		return bgd.fallback.AnteHandle(ctx, tx, simulate, next)
+	}
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,175 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/ante"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// fallbackDecorator records whether it has been called
+type fallbackDecorator struct {
+	called *bool
+}
+
+func (fd fallbackDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	*fd.called = true
+	return next(ctx, tx, simulate)
+}
+
+func TestBaseGasPriceDecorator(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := app.FeeMarketKeeper
+
+	var fallbackCalled bool
+	anteHandler := sdk.ChainAnteDecorators(ante.NewBaseGasPriceDecorator(k, app.AccountKeeper, fallbackDecorator{&fallbackCalled}))
+
+	_, _, addr := testdata.KeyTestPubAddr()
+	newTx := func(fee sdk.Coins, gas uint64, msgs ...sdk.Msg) sdk.Tx {
+		txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+		if len(msgs) == 0 {
+			msgs = []sdk.Msg{testdata.NewTestMsg(addr)}
+		}
+		require.NoError(t, txBuilder.SetMsgs(msgs...))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gas)
+		return txBuilder.GetTx()
+	}
+
+	// the fallback decorator is used while the fee market is disabled
+	_, err := anteHandler(ctx, newTx(sdk.Coins{}, 100_000), false)
+	require.NoError(t, err)
+	require.True(t, fallbackCalled)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	k.SetParams(ctx, params)
+	k.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 4))
+
+	testCases := []struct {
+		name     string
+		ctx      sdk.Context
+		fee      sdk.Coins
+		simulate bool
+		expErr   bool
+	}{
+		{"no fee", ctx, sdk.Coins{}, false, true},
+		{"insufficient fee", ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)), false, true},
+		{"fee in other denom", ctx, sdk.NewCoins(sdk.NewInt64Coin("other", 1000)), false, true},
+		{"sufficient fee", ctx, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 251)), false, false},
+		{"sufficient fee in check tx", ctx.WithIsCheckTx(true), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 251)), false, false},
+		{"insufficient fee in check tx", ctx.WithIsCheckTx(true), sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)), false, true},
+		{"simulation", ctx, sdk.Coins{}, true, false},
+		{"genesis", ctx.WithIsGenesis(true), sdk.Coins{}, false, false},
+		{"genesis with initial height", ctx.WithBlockHeight(5).WithIsGenesis(true), sdk.Coins{}, false, false},
+		{"first block at initial height", ctx.WithBlockHeight(5), sdk.Coins{}, false, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fallbackCalled = false
+
+			// fee = ceil(0.0025 * 100_001) = 251
+			_, err := anteHandler(tc.ctx, newTx(tc.fee, 100_001), tc.simulate)
+			if tc.expErr {
+				require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+			} else {
+				require.NoError(t, err)
+			}
+			// the fallback decorator is called in any case, so the node-local minimum gas prices still apply
+			require.Equal(t, !tc.expErr, fallbackCalled)
+		})
+	}
+
+	// the base fees charged in DeliverTx are recorded, to be burned at the end of the block
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 251)), k.GetBlockBaseFees(ctx))
+
+	// the base gas price is multiplied by the gas price factor of the fee policies of the messages
+	authParams := app.AccountKeeper.GetParams(ctx)
+	authParams.MsgFeePolicies = []authtypes.MsgFeePolicy{authtypes.NewMsgFeePolicy(sdk.MsgTypeURL(&testdata.TestMsg{}), sdk.NewDec(2))}
+	app.AccountKeeper.SetParams(ctx, authParams)
+
+	// fee = ceil(0.0025 * 2 * 100_001) = 501
+	_, err = anteHandler(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)), 100_001), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	_, err = anteHandler(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 501)), 100_001), false)
+	require.NoError(t, err)
+	// the highest factor of the messages is used
+	_, err = anteHandler(ctx, newTx(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 251)), 100_001, testdata.NewTestMsg(addr), &testdata.MsgCreateDog{Dog: &testdata.Dog{Name: "spot"}}), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+}
+
+func TestBaseGasPriceDecoratorMinBaseGasPriceChangedInBlock(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+	k := app.FeeMarketKeeper
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewBaseGasPriceDecorator(k, app.AccountKeeper, nil))
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	_, _, addr := testdata.KeyTestPubAddr()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 251)))
+	txBuilder.SetGasLimit(100_001)
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	params.MinBaseGasPrice = sdk.NewDecWithPrec(25, 4)
+	k.SetParams(ctx, params)
+	k.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 4))
+
+	_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+	require.NoError(t, err)
+
+	// governance raises the minimum in the block, before the base gas price is adjusted
+	params.MinBaseGasPrice = sdk.NewDecWithPrec(5, 3)
+	k.SetParams(ctx, params)
+	require.Equal(t, sdk.NewDecWithPrec(25, 4), k.GetBaseGasPrice(ctx))
+
+	// fee = ceil(0.005 * 100_001) = 501
+	_, err = anteHandler(ctx, txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	_, err = anteHandler(ctx.WithIsCheckTx(true), txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 501)))
+	_, err = anteHandler(ctx, txBuilder.GetTx(), false)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 752)), k.GetBlockBaseFees(ctx))
+}
+
+func TestBaseGasPriceDecoratorNodeMinGasPrices(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1}).WithIsCheckTx(true)
+	k := app.FeeMarketKeeper
+
+	params := types.DefaultParams()
+	params.Enabled = true
+	k.SetParams(ctx, params)
+	k.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 4))
+
+	anteHandler := sdk.ChainAnteDecorators(ante.NewBaseGasPriceDecorator(k, app.AccountKeeper, authante.NewMsgFeePolicyDecorator(app.AccountKeeper, nil)))
+	txBuilder := simapp.MakeTestEncodingConfig().TxConfig.NewTxBuilder()
+	_, _, addr := testdata.KeyTestPubAddr()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 251)))
+	txBuilder.SetGasLimit(100_001)
+
+	// the fee meets the base gas price, but not the node-local minimum gas prices in CheckTx
+	_, err := anteHandler(ctx, txBuilder.GetTx(), false)
+	require.NoError(t, err)
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(1, 2)))
+	_, err = anteHandler(ctx.WithMinGasPrices(minGasPrices), txBuilder.GetTx(), false)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	// they are not checked in DeliverTx
+	_, err = anteHandler(ctx.WithIsCheckTx(false).WithMinGasPrices(minGasPrices), txBuilder.GetTx(), false)
+	require.NoError(t, err)
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"github.com/tendermint/tendermint/libs/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Keeper of the fee market store
+type Keeper struct {
+	cdc              codec.BinaryCodec
+	storeKey         sdk.StoreKey
+	tStoreKey        sdk.StoreKey
+	paramSpace       paramtypes.Subspace
+	authKeeper       types.AccountKeeper
+	bankKeeper       types.BankKeeper
+	feeCollectorName string
+}
+
+// NewKeeper creates a new fee market Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec, key, tkey sdk.StoreKey, paramSpace paramtypes.Subspace,
+	ak types.AccountKeeper, bk types.BankKeeper, feeCollectorName string,
+) Keeper {
+	// ensure fee market module account is set, it burns the base fee
+	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
+		panic("the fee market module account has not been set")
+	}
+
+	// set KeyTable if it has not already been set
+	if !paramSpace.HasKeyTable() {
+		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
+	}
+
+	return Keeper{
+		cdc:              cdc,
+		storeKey:         key,
+		tStoreKey:        tkey,
+		paramSpace:       paramSpace,
+		authKeeper:       ak,
+		bankKeeper:       bk,
+		feeCollectorName: feeCollectorName,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx sdk.Context) log.Logger {
+	return ctx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetParams returns the total set of fee market parameters.
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	k.paramSpace.GetParamSet(ctx, &params)
+	return params
+}
+
+// SetParams sets the total set of fee market parameters.
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) {
+	k.paramSpace.SetParamSet(ctx, &params)
+}
+
+// Enabled returns whether the fee market is enabled with a positive minimum
+// base gas price, see Params.IsEnabled. It only reads the two params.
+func (k Keeper) Enabled(ctx sdk.Context) bool {
+	var (
+		enabled         bool
+		minBaseGasPrice sdk.Dec
+	)
+	k.paramSpace.Get(ctx, types.KeyEnabled, &enabled)
+	if !enabled {
+		return false
+	}
+	k.paramSpace.Get(ctx, types.KeyMinBaseGasPrice, &minBaseGasPrice)
+	return minBaseGasPrice.IsPositive()
+}
+
+// GetBaseGasPrice returns the current base gas price, zero if it has not been
+// set.
+func (k Keeper) GetBaseGasPrice(ctx sdk.Context) sdk.Dec {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.BaseGasPriceKey)
+	if bz == nil {
+		return sdk.ZeroDec()
+	}
+
+	var price sdk.Dec
+	if err := price.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return price
+}
+
+// SetBaseGasPrice sets the current base gas price.
+func (k Keeper) SetBaseGasPrice(ctx sdk.Context, price sdk.Dec) {
+	bz, err := price.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BaseGasPriceKey, bz)
+}
+
+// GetLastBlockGas returns the gas used by the last block.
+func (k Keeper) GetLastBlockGas(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.LastBlockGasKey)
+	if bz == nil {
+		return 0
+	}
+	return sdk.BigEndianToUint64(bz)
+}
+
+// SetLastBlockGas sets the gas used by the last block.
+func (k Keeper) SetLastBlockGas(ctx sdk.Context, gas uint64) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastBlockGasKey, sdk.Uint64ToBigEndian(gas))
+}
+
+// AddBlockBaseFee adds the base fee charged to a tx to the base fees of the
+// current block in the transient store.
+func (k Keeper) AddBlockBaseFee(ctx sdk.Context, fee sdk.Coin) {
+	if fee.IsZero() {
+		return
+	}
+
+	store := ctx.TransientStore(k.tStoreKey)
+	key := types.BlockBaseFeeKey(fee.Denom)
+
+	total := fee.Amount
+	if bz := store.Get(key); bz != nil {
+		var amount sdk.Int
+		if err := amount.Unmarshal(bz); err != nil {
+			panic(err)
+		}
+		total = total.Add(amount)
+	}
+
+	bz, err := total.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store.Set(key, bz)
+}
+
+// GetBlockBaseFees returns the base fees charged to the txs of the current
+// block.
+func (k Keeper) GetBlockBaseFees(ctx sdk.Context) sdk.Coins {
+	store := ctx.TransientStore(k.tStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.BlockBaseFeeKeyPrefix)
+	defer iterator.Close()
+
+	fees := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		fees = fees.Add(sdk.NewCoin(string(iterator.Key()[len(types.BlockBaseFeeKeyPrefix):]), amount))
+	}
+	return fees
+}
+
+// BurnBaseFee burns the base fees charged to the txs of the block, as recorded
+// by the ante handler, from the fees collected by the fee collector. At most
+// the balance of the fee collector is burned. It returns the burned amount.
+func (k Keeper) BurnBaseFee(ctx sdk.Context) (sdk.Coins, error) {
+	feeCollector := k.authKeeper.GetModuleAddress(k.feeCollectorName)
+
+	burned := sdk.NewCoins()
+	for _, fee := range k.GetBlockBaseFees(ctx) {
+		balance := k.bankKeeper.GetBalance(ctx, feeCollector, fee.Denom)
+		burned = burned.Add(sdk.NewCoin(fee.Denom, sdk.MinInt(fee.Amount, balance.Amount)))
+	}
+	if burned.IsZero() {
+		return burned, nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollectorName, types.ModuleName, burned); err != nil {
+		return nil, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, burned); err != nil {
+		return nil, err
+	}
+
+	return burned, nil
+}
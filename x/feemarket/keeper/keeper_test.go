@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	gocontext "context"
+	"testing"
+
+	"github.com/stretchr/testify/suite"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+type KeeperTestSuite struct {
+	suite.Suite
+
+	app         *simapp.SimApp
+	ctx         sdk.Context
+	queryClient types.QueryClient
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 1})
+
+	queryHelper := baseapp.NewQueryServerTestHelper(ctx, app.InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, app.FeeMarketKeeper)
+
+	suite.app = app
+	suite.ctx = ctx
+	suite.queryClient = types.NewQueryClient(queryHelper)
+}
+
+func (suite *KeeperTestSuite) TestGenesisState() {
+	app, ctx := suite.app, suite.ctx
+	k := app.FeeMarketKeeper
+
+	params := types.DefaultParams()
+	suite.Require().Equal(params, k.GetParams(ctx))
+	suite.Require().False(k.Enabled(ctx))
+	suite.Require().Equal(params.MinBaseGasPrice, k.GetBaseGasPrice(ctx))
+	suite.Require().Zero(k.GetLastBlockGas(ctx))
+
+	params.Enabled = true
+	k.SetParams(ctx, params)
+	suite.Require().True(k.Enabled(ctx))
+
+	// a param change proposal can set a zero min base gas price, which keeps the fee market disabled
+	subspace := app.GetSubspace(types.ModuleName)
+	subspace.Set(ctx, types.KeyMinBaseGasPrice, sdk.ZeroDec())
+	suite.Require().False(k.Enabled(ctx))
+	suite.Require().False(k.GetParams(ctx).IsEnabled())
+	subspace.Set(ctx, types.KeyMinBaseGasPrice, params.MinBaseGasPrice)
+	suite.Require().True(k.Enabled(ctx))
+
+	k.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 3))
+	suite.Require().Equal(sdk.NewDecWithPrec(25, 3), k.GetBaseGasPrice(ctx))
+
+	k.SetLastBlockGas(ctx, 123)
+	suite.Require().Equal(uint64(123), k.GetLastBlockGas(ctx))
+}
+
+func (suite *KeeperTestSuite) TestBurnBaseFee() {
+	app, ctx := suite.app, suite.ctx
+	k := app.FeeMarketKeeper
+	feeCollector := app.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+
+	fees := sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("other", 1000))
+	suite.Require().NoError(simapp.FundModuleAccount(app.BankKeeper, ctx, authtypes.FeeCollectorName, fees))
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom)
+
+	// nothing is burned without base fees charged in the block
+	burned, err := k.BurnBaseFee(ctx)
+	suite.Require().NoError(err)
+	suite.Require().True(burned.IsZero())
+
+	// exactly the base fees charged to the txs are burned
+	k.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 150))
+	k.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	k.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 0))
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)), k.GetBlockBaseFees(ctx))
+	burned, err = k.BurnBaseFee(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 250)), burned)
+	suite.Require().Equal(sdk.NewInt(750), app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).Amount)
+	suite.Require().Equal(supply.Amount.SubRaw(250), app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount)
+	suite.Require().Equal(sdk.NewInt(1000), app.BankKeeper.GetBalance(ctx, feeCollector, "other").Amount)
+
+	// at most the collected fees are burned
+	k.AddBlockBaseFee(ctx, sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+	burned, err = k.BurnBaseFee(ctx)
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 750)), burned)
+	suite.Require().True(app.BankKeeper.GetBalance(ctx, feeCollector, sdk.DefaultBondDenom).IsZero())
+}
+
+func (suite *KeeperTestSuite) TestGRPCQuery() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params, err := queryClient.Params(gocontext.Background(), &types.QueryParamsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(app.FeeMarketKeeper.GetParams(ctx), params.Params)
+
+	app.FeeMarketKeeper.SetBaseGasPrice(ctx, sdk.NewDecWithPrec(25, 3))
+	app.FeeMarketKeeper.SetLastBlockGas(ctx, 42)
+
+	res, err := queryClient.BaseGasPrice(gocontext.Background(), &types.QueryBaseGasPriceRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryBaseGasPriceResponse{
+		BaseGasPrice: sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(25, 3)),
+		Enabled:      false,
+		LastBlockGas: 42,
+	}, res)
+
+	// the base gas price is at least the minimum, which may have been raised in the block
+	params.Params.MinBaseGasPrice = sdk.NewDecWithPrec(5, 2)
+	app.FeeMarketKeeper.SetParams(ctx, params.Params)
+	res, err = queryClient.BaseGasPrice(gocontext.Background(), &types.QueryBaseGasPriceRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewDecCoinFromDec(sdk.DefaultBondDenom, sdk.NewDecWithPrec(5, 2)), res.BaseGasPrice)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	suite.Run(t, new(KeeperTestSuite))
+}
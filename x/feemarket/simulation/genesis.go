@@ -0,0 +1,51 @@
+package simulation
+
+// DONTCOVER
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	TargetBlockGas                = "target_block_gas"
+	BaseGasPriceChangeDenominator = "base_gas_price_change_denominator"
+)
+
+// GenTargetBlockGas randomized TargetBlockGas
+func GenTargetBlockGas(r *rand.Rand) uint64 {
+	return uint64(r.Int63n(10_000_000) + 1)
+}
+
+// GenBaseGasPriceChangeDenominator randomized BaseGasPriceChangeDenominator
+func GenBaseGasPriceChangeDenominator(r *rand.Rand) uint32 {
+	return uint32(r.Intn(16) + 1)
+}
+
+// RandomizedGenState generates a random GenesisState for the fee market. The
+// fee market is disabled, as the simulated txs pay random fees.
+func RandomizedGenState(simState *module.SimulationState) {
+	params := types.DefaultParams()
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, TargetBlockGas, &params.TargetBlockGas, simState.Rand,
+		func(r *rand.Rand) { params.TargetBlockGas = GenTargetBlockGas(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, BaseGasPriceChangeDenominator, &params.BaseGasPriceChangeDenominator, simState.Rand,
+		func(r *rand.Rand) { params.BaseGasPriceChangeDenominator = GenBaseGasPriceChangeDenominator(r) },
+	)
+
+	feemarketGenesis := types.NewGenesisState(params, params.MinBaseGasPrice, 0)
+
+	bz, err := json.MarshalIndent(&feemarketGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated fee market parameters:\n%s\n", bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(feemarketGenesis)
+}
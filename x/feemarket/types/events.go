@@ -0,0 +1,10 @@
+package types
+
+// Fee market module event types
+const (
+	EventTypeBaseGasPrice = "base_gas_price"
+	EventTypeBurnBaseFee  = "burn_base_fee"
+
+	AttributeKeyBaseGasPrice = "base_gas_price"
+	AttributeKeyBlockGas     = "block_gas"
+)
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(params Params, baseGasPrice sdk.Dec, lastBlockGas uint64) *GenesisState {
+	return &GenesisState{
+		Params:       params,
+		BaseGasPrice: baseGasPrice,
+		LastBlockGas: lastBlockGas,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object, the base gas
+// price starts at its minimum
+func DefaultGenesisState() *GenesisState {
+	params := DefaultParams()
+	return NewGenesisState(params, params.MinBaseGasPrice, 0)
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := data.Params.Validate(); err != nil {
+		return err
+	}
+
+	if data.BaseGasPrice.IsNil() || data.BaseGasPrice.IsNegative() {
+		return fmt.Errorf("base gas price cannot be nil or negative: %s", data.BaseGasPrice)
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package types
+
+var (
+	// BaseGasPriceKey is the key of the current base gas price
+	BaseGasPriceKey = []byte{0x00}
+	// LastBlockGasKey is the key of the gas used by the last block
+	LastBlockGasKey = []byte{0x01}
+
+	// BlockBaseFeeKeyPrefix is the prefix of the base fees charged to the txs
+	// of the current block by denomination in the transient store
+	BlockBaseFeeKeyPrefix = []byte{0x00}
+)
+
+const (
+	// ModuleName is the name of the fee market module
+	ModuleName = "feemarket"
+
+	// StoreKey is the default store key for the fee market
+	StoreKey = ModuleName
+
+	// TStoreKey is the transient store key for the fee market
+	TStoreKey = "transient_" + ModuleName
+
+	// RouterKey is the message route for the fee market module
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for the fee market store.
+	QuerierRoute = StoreKey
+)
+
+// BlockBaseFeeKey returns the transient store key of the base fees charged in
+// the given denomination in the current block.
+func BlockBaseFeeKey(denom string) []byte {
+	return append(BlockBaseFeeKeyPrefix, []byte(denom)...)
+}
@@ -0,0 +1,197 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+
+	yaml "gopkg.in/yaml.v2"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
+)
+
+// Parameter store keys
+var (
+	KeyEnabled                       = []byte("Enabled")
+	KeyFeeDenom                      = []byte("FeeDenom")
+	KeyMinBaseGasPrice               = []byte("MinBaseGasPrice")
+	KeyTargetBlockGas                = []byte("TargetBlockGas")
+	KeyBaseGasPriceChangeDenominator = []byte("BaseGasPriceChangeDenominator")
+	KeyBurnBaseFee                   = []byte("BurnBaseFee")
+)
+
+// ParamKeyTable for the fee market module.
+func ParamKeyTable() paramtypes.KeyTable {
+	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
+}
+
+func NewParams(
+	enabled bool, feeDenom string, minBaseGasPrice sdk.Dec, targetBlockGas uint64,
+	baseGasPriceChangeDenominator uint32, burnBaseFee bool,
+) Params {
+	return Params{
+		Enabled:                       enabled,
+		FeeDenom:                      feeDenom,
+		MinBaseGasPrice:               minBaseGasPrice,
+		TargetBlockGas:                targetBlockGas,
+		BaseGasPriceChangeDenominator: baseGasPriceChangeDenominator,
+		BurnBaseFee:                   burnBaseFee,
+	}
+}
+
+// default fee market module parameters, the fee market is disabled
+func DefaultParams() Params {
+	return Params{
+		Enabled:                       false,
+		FeeDenom:                      sdk.DefaultBondDenom,
+		MinBaseGasPrice:               sdk.NewDecWithPrec(1, 3),
+		TargetBlockGas:                10_000_000,
+		BaseGasPriceChangeDenominator: 8,
+		BurnBaseFee:                   true,
+	}
+}
+
+// validate params
+func (p Params) Validate() error {
+	if err := validateEnabled(p.Enabled); err != nil {
+		return err
+	}
+	if err := validateFeeDenom(p.FeeDenom); err != nil {
+		return err
+	}
+	if err := validateMinBaseGasPrice(p.MinBaseGasPrice); err != nil {
+		return err
+	}
+	if err := validateTargetBlockGas(p.TargetBlockGas); err != nil {
+		return err
+	}
+	if err := validateBaseGasPriceChangeDenominator(p.BaseGasPriceChangeDenominator); err != nil {
+		return err
+	}
+	if err := validateBurnBaseFee(p.BurnBaseFee); err != nil {
+		return err
+	}
+	if p.Enabled && !p.MinBaseGasPrice.IsPositive() {
+		// the base gas price changes in proportion to its value, so it would
+		// never increase from zero
+		return errors.New("the fee market requires a positive min_base_gas_price")
+	}
+	return nil
+}
+
+// IsEnabled returns whether the fee market is enabled with a positive minimum
+// base gas price. Param change proposals validate the params one by one, so they
+// can enable the fee market with a zero minimum, which would keep the base gas
+// price at zero: the fee market stays disabled in that case.
+func (p Params) IsEnabled() bool {
+	return p.Enabled && p.MinBaseGasPrice.IsPositive()
+}
+
+// NextBaseGasPrice returns the base gas price of the next block given the gas
+// used by the current block. The price moves towards the equilibrium where the
+// blocks use the target gas, by the relative distance of the gas used from
+// the target divided by the change denominator, and never falls below the
+// minimum base gas price.
+func (p Params) NextBaseGasPrice(baseGasPrice sdk.Dec, blockGas uint64) sdk.Dec {
+	if baseGasPrice.LT(p.MinBaseGasPrice) {
+		baseGasPrice = p.MinBaseGasPrice
+	}
+
+	// delta = baseGasPrice * (blockGas - target) / target / denominator
+	target := sdk.NewIntFromUint64(p.TargetBlockGas).ToDec()
+	distance := sdk.NewIntFromUint64(blockGas).ToDec().Sub(target)
+	delta := baseGasPrice.Mul(distance).Quo(target).QuoInt64(int64(p.BaseGasPriceChangeDenominator))
+
+	next := baseGasPrice.Add(delta)
+	if next.LT(p.MinBaseGasPrice) {
+		return p.MinBaseGasPrice
+	}
+	return next
+}
+
+// String implements the Stringer interface.
+func (p Params) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Implements params.ParamSet
+func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
+	return paramtypes.ParamSetPairs{
+		paramtypes.NewParamSetPair(KeyEnabled, &p.Enabled, validateEnabled),
+		paramtypes.NewParamSetPair(KeyFeeDenom, &p.FeeDenom, validateFeeDenom),
+		paramtypes.NewParamSetPair(KeyMinBaseGasPrice, &p.MinBaseGasPrice, validateMinBaseGasPrice),
+		paramtypes.NewParamSetPair(KeyTargetBlockGas, &p.TargetBlockGas, validateTargetBlockGas),
+		paramtypes.NewParamSetPair(KeyBaseGasPriceChangeDenominator, &p.BaseGasPriceChangeDenominator, validateBaseGasPriceChangeDenominator),
+		paramtypes.NewParamSetPair(KeyBurnBaseFee, &p.BurnBaseFee, validateBurnBaseFee),
+	}
+}
+
+func validateEnabled(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateFeeDenom(i interface{}) error {
+	v, ok := i.(string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return sdk.ValidateDenom(v)
+}
+
+func validateMinBaseGasPrice(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return errors.New("min base gas price cannot be nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min base gas price cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateTargetBlockGas(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("target block gas must be positive")
+	}
+
+	return nil
+}
+
+func validateBaseGasPriceChangeDenominator(i interface{}) error {
+	v, ok := i.(uint32)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return errors.New("base gas price change denominator must be positive")
+	}
+
+	return nil
+}
+
+func validateBurnBaseFee(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
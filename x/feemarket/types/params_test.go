@@ -0,0 +1,98 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/feemarket/types"
+)
+
+func TestParamsValidate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params func(p *types.Params)
+		expErr bool
+	}{
+		{"default", func(p *types.Params) {}, false},
+		{"enabled", func(p *types.Params) { p.Enabled = true }, false},
+		{"invalid fee denom", func(p *types.Params) { p.FeeDenom = "" }, true},
+		{"nil min base gas price", func(p *types.Params) { p.MinBaseGasPrice = sdk.Dec{} }, true},
+		{"negative min base gas price", func(p *types.Params) { p.MinBaseGasPrice = sdk.NewDec(-1) }, true},
+		{"zero min base gas price", func(p *types.Params) { p.MinBaseGasPrice = sdk.ZeroDec() }, false},
+		{"zero min base gas price enabled", func(p *types.Params) {
+			p.Enabled = true
+			p.MinBaseGasPrice = sdk.ZeroDec()
+		}, true},
+		{"zero target block gas", func(p *types.Params) { p.TargetBlockGas = 0 }, true},
+		{"zero change denominator", func(p *types.Params) { p.BaseGasPriceChangeDenominator = 0 }, true},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			tc.params(&params)
+
+			err := params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParams_IsEnabled(t *testing.T) {
+	params := types.DefaultParams()
+	require.False(t, params.IsEnabled())
+
+	params.Enabled = true
+	require.True(t, params.IsEnabled())
+
+	// the base gas price would never increase from a zero minimum
+	params.MinBaseGasPrice = sdk.ZeroDec()
+	require.False(t, params.IsEnabled())
+}
+
+func TestParams_NextBaseGasPrice(t *testing.T) {
+	params := types.DefaultParams()
+	params.MinBaseGasPrice = sdk.NewDecWithPrec(1, 3)
+	params.TargetBlockGas = 1_000_000
+	params.BaseGasPriceChangeDenominator = 8
+
+	baseGasPrice := sdk.NewDecWithPrec(8, 2)
+
+	testCases := []struct {
+		name         string
+		baseGasPrice sdk.Dec
+		blockGas     uint64
+		exp          sdk.Dec
+	}{
+		{"target", baseGasPrice, 1_000_000, baseGasPrice},
+		{"twice the target", baseGasPrice, 2_000_000, sdk.NewDecWithPrec(9, 2)},
+		{"half the target", baseGasPrice, 500_000, sdk.NewDecWithPrec(75, 3)},
+		{"empty block", baseGasPrice, 0, sdk.NewDecWithPrec(7, 2)},
+		{"min base gas price", params.MinBaseGasPrice, 0, params.MinBaseGasPrice},
+		{"below min base gas price", sdk.ZeroDec(), 2_000_000, sdk.NewDecWithPrec(1125, 6)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.exp, params.NextBaseGasPrice(tc.baseGasPrice, tc.blockGas))
+		})
+	}
+}
+
+func TestValidateGenesis(t *testing.T) {
+	require.NoError(t, types.ValidateGenesis(*types.DefaultGenesisState()))
+
+	genesis := types.DefaultGenesisState()
+	genesis.BaseGasPrice = sdk.NewDec(-1)
+	require.Error(t, types.ValidateGenesis(*genesis))
+
+	genesis = types.DefaultGenesisState()
+	genesis.Params.TargetBlockGas = 0
+	require.Error(t, types.ValidateGenesis(*genesis))
+}
@@ -12,55 +12,147 @@ import (
 )
 
 // HandleMunicipalInflation iterates through all other native tokens specified in the minter.MunicipalInflation structure, and processes
-// the minting of new coins in line with the respective inflation rate of each denomination
+// the minting of new coins, or burning of existing coins in burn mode, in line with the respective inflation rate of each denomination
 func HandleMunicipalInflation(minter *types.Minter, params *types.Params, ctx *sdk.Context, k *keeper.Keeper) {
-	cache.GMunicipalInflationCache.RefreshIfNecessary(&minter.MunicipalInflation, params.BlocksPerYear)
+	recordScheduleActivations(minter, ctx.BlockHeight(), ctx.BlockTime())
+
+	snapshot := k.RefreshMunicipalInflationCache(*ctx, &minter.MunicipalInflation, params.BlocksPerYear)
 
 	// iterate through native denominations
 	for _, pair := range minter.MunicipalInflation {
-		targetAddress := pair.Inflation.TargetAddress
-
-		// gather supply value & calculate number of new tokens created from relevant inflation
-		totalDenomSupply := k.BankKeeper.GetSupply(*ctx, pair.Denom)
-
-		cacheItem := cache.GMunicipalInflationCache.GetInflation(pair.Denom)
+		cacheItem := snapshot.GetInflation(pair.Denom)
 
 		if cacheItem == nil {
 			panic(fmt.Errorf("numicipal inflation: missing cache item for the \"%s\" denomination", pair.Denom))
 		}
 
-		coinsToMint := types.CalculateInflationIssuance(cacheItem.PerBlockInflation, totalDenomSupply)
+		// skip denominations with inactive inflation schedule
+		if !cacheItem.Segment.IsActive() {
+			continue
+		}
 
-		err := k.MintCoins(*ctx, coinsToMint)
-		if err != nil {
-			panic(err)
+		if pair.Inflation.IsBurn() {
+			burnMunicipalInflation(pair, cacheItem, ctx, k)
+			continue
 		}
 
-		// send these new tokens to respective target address
-		// TODO(JS): investigate whether this should be carried out in distribution module or not
+		mintMunicipalInflation(pair, cacheItem, params, ctx, k)
+	}
+}
 
-		// Convert targetAddress to sdk.AccAddress
-		acc, err := sdk.AccAddressFromBech32(targetAddress)
-		if err != nil {
-			panic(err)
+// mintMunicipalInflation mints the per block issuance of the denomination with
+// municipal inflation in the mint mode, and splits it between the targets.
+func mintMunicipalInflation(pair *types.MunicipalInflationPair, cacheItem *cache.MunicipalInflationCacheItem, params *types.Params, ctx *sdk.Context, k *keeper.Keeper) {
+	// gather supply value & calculate number of new tokens created from relevant inflation
+	totalDenomSupply := k.BankKeeper.GetSupply(*ctx, pair.Denom)
+
+	coinsToMint := types.CalculateInflationIssuance(cacheItem.PerBlockInflation, totalDenomSupply)
+
+	// respect maximum supply of the denomination, if defined
+	coinsToMint = k.ClampToMaxSupply(*ctx, *params, coinsToMint)
+
+	// record the issuance to be verified by invariants, with the amounts minted
+	// to the targets by this issuance only
+	targets := pair.Inflation.EffectiveTargets()
+	issuance := types.MunicipalIssuance{
+		Denom:  pair.Denom,
+		Supply: totalDenomSupply,
+	}
+	for _, target := range targets {
+		issuance.Recipients = append(issuance.Recipients, types.MunicipalIssuanceRecipient{
+			Recipient: target.Recipient(),
+			Minted:    k.GetRecipientMintedOf(*ctx, target.Recipient(), pair.Denom),
+		})
+	}
+	defer func() {
+		for i := range issuance.Recipients {
+			recipient := &issuance.Recipients[i]
+			recipient.Minted = k.GetRecipientMintedOf(*ctx, recipient.Recipient, pair.Denom).Sub(recipient.Minted)
 		}
+		k.SetMunicipalIssuance(*ctx, issuance)
+	}()
+
+	if coinsToMint.Empty() {
+		return
+	}
 
-		err = k.BankKeeper.SendCoinsFromModuleToAccount(*ctx, types.ModuleName, acc, coinsToMint)
+	err := k.MintCoins(*ctx, coinsToMint)
+	if err != nil {
+		panic(err)
+	}
+
+	// split these new tokens between respective targets according to their weights
+	// TODO(JS): investigate whether this should be carried out in distribution module or not
+	shares := pair.Inflation.SplitIssuance(coinsToMint)
+
+	for i, target := range targets {
+		err = k.SendMunicipalInflationShare(*ctx, target, shares[i])
 		if err != nil {
 			panic(err)
 		}
-		ctx.EventManager().EmitEvent(
-			sdk.NewEvent(
-				types.EventTypeMunicipalMint,
-				sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
-				sdk.NewAttribute(types.AttributeKeyInflation, pair.Inflation.Value.String()),
-				sdk.NewAttribute(types.AttributeKeyTargetAddr, pair.Inflation.TargetAddress),
-				sdk.NewAttribute(sdk.AttributeKeyAmount, coinsToMint.String()),
-			),
-		)
+		k.RecordMinted(*ctx, target.Recipient(), shares[i])
+
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyInflation, cacheItem.Segment.Rate.String()),
+		}
+		attrs = append(attrs, target.Attributes()...)
+		attrs = append(attrs, sdk.NewAttribute(sdk.AttributeKeyAmount, shares[i].String()))
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMunicipalMint, attrs...))
 	}
 }
 
+// recordScheduleActivations records the height of the block in which the
+// schedules started by time become active. The pairs are replaced rather than
+// modified, as they may be shared with the municipal inflation cache.
+func recordScheduleActivations(minter *types.Minter, height int64, blockTime time.Time) {
+	var inflations []*types.MunicipalInflationPair
+	for i, pair := range minter.MunicipalInflation {
+		activated, recorded := pair.Inflation.RecordActivation(height, blockTime)
+		if !recorded {
+			continue
+		}
+
+		if inflations == nil {
+			inflations = make([]*types.MunicipalInflationPair, len(minter.MunicipalInflation))
+			copy(inflations, minter.MunicipalInflation)
+		}
+		inflations[i] = &types.MunicipalInflationPair{Denom: pair.Denom, Inflation: activated}
+	}
+
+	if inflations != nil {
+		minter.MunicipalInflation = inflations
+	}
+}
+
+// burnMunicipalInflation burns the per block share of the balance held by the
+// burn source of the denomination with municipal inflation in burn mode.
+func burnMunicipalInflation(pair *types.MunicipalInflationPair, cacheItem *cache.MunicipalInflationCacheItem, ctx *sdk.Context, k *keeper.Keeper) {
+	source := pair.Inflation.EffectiveTargets()[0]
+	balance := k.GetBurnSourceBalance(*ctx, source, pair.Denom)
+
+	coinsToBurn := types.CalculateBurnAmount(cacheItem.PerBlockInflation, balance)
+	if coinsToBurn.Empty() {
+		return
+	}
+
+	err := k.BurnFromMunicipalInflationSource(*ctx, source, coinsToBurn)
+	if err != nil {
+		panic(err)
+	}
+	k.RecordBurned(*ctx, coinsToBurn)
+
+	attrs := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+		sdk.NewAttribute(types.AttributeKeyInflation, cacheItem.Segment.Rate.String()),
+	}
+	attrs = append(attrs, source.Attributes()...)
+	attrs = append(attrs, sdk.NewAttribute(sdk.AttributeKeyAmount, coinsToBurn.String()))
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeMunicipalBurn, attrs...))
+}
+
 // BeginBlocker mints new tokens for the previous block.
 func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyBeginBlocker)
@@ -69,17 +161,22 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	minter := k.GetMinter(ctx)
 	params := k.GetParams(ctx)
 
+	// observe the block time & use the calibrated blocks per year, if enabled
+	k.ObserveBlockTime(ctx, params)
+	params.BlocksPerYear = k.GetBlocksPerYear(ctx, params)
+
 	HandleMunicipalInflation(&minter, &params, &ctx, &k)
 
 	// recalculate inflation rate
 	totalStakingSupply := k.StakingTokenSupply(ctx)
-	minter.Inflation = minter.NextInflationRate(params)
+	bondedRatio := k.BondedRatio(ctx)
+	minter.Inflation = minter.NextInflationRate(params, bondedRatio)
 	minter.AnnualProvisions = minter.NextAnnualProvisions(params, totalStakingSupply)
 	k.SetMinter(ctx, minter)
 
-	// mint coins, update supply
-	mintedCoin := minter.BlockProvision(params)
-	mintedCoins := sdk.NewCoins(mintedCoin)
+	// mint coins up to the maximum supply, update supply
+	mintedCoins := k.ClampToMaxSupply(ctx, params, sdk.NewCoins(minter.BlockProvision(params)))
+	mintedCoin := sdk.NewCoin(params.MintDenom, mintedCoins.AmountOf(params.MintDenom))
 
 	err := k.MintCoins(ctx, mintedCoins)
 	if err != nil {
@@ -87,11 +184,17 @@ func BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 	}
 
 	// send the minted coins to the fee collector account
-	err = k.AddCollectedFees(ctx, mintedCoins)
-	if err != nil {
-		panic(err)
+	if !mintedCoins.Empty() {
+		err = k.AddCollectedFees(ctx, mintedCoins)
+		if err != nil {
+			panic(err)
+		}
+		k.RecordMinted(ctx, types.ModuleRecipient(k.FeeCollectorName()), mintedCoins)
 	}
 
+	// record the total minted amounts periodically
+	k.CheckpointIfNecessary(ctx, params)
+
 	if mintedCoin.Amount.IsInt64() {
 		defer telemetry.ModuleSetGauge(types.ModuleName, float32(mintedCoin.Amount.Int64()), "minted_tokens")
 	}
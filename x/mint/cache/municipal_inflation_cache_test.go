@@ -0,0 +1,75 @@
+package cache_test
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/cache"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestMunicipalInflationCacheConcurrentSnapshots(t *testing.T) {
+	c := cache.NewMunicipalInflationCache()
+	target := sdk.AccAddress("target______________").String()
+	blockTime := time.Unix(1600000000, 0)
+
+	inflations1 := []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(target, sdk.NewDecWithPrec(1, 2))},
+	}
+	inflations2 := []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(target, sdk.NewDecWithPrec(2, 2))},
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 100; j++ {
+				// readers must always get the snapshot of the requested inflations
+				snapshot := c.Snapshot(&inflations1, 100, 1, blockTime)
+				require.Equal(t, sdk.NewDecWithPrec(1, 2), snapshot.GetStatus("denom0").CurrentRate)
+			}
+		}()
+	}
+	for j := 0; j < 100; j++ {
+		inflations := &inflations1
+		if j%2 == 0 {
+			inflations = &inflations2
+		}
+		c.RefreshIfNecessary(inflations, 100, int64(j), blockTime)
+		if j%10 == 0 {
+			c.Invalidate()
+		}
+	}
+	wg.Wait()
+}
+
+func TestMunicipalInflationCacheSnapshotOfAnotherBlock(t *testing.T) {
+	c := cache.NewMunicipalInflationCache()
+	target := sdk.AccAddress("target______________").String()
+	blockTime := time.Unix(1600000000, 0)
+
+	inflation := types.NewMunicipalInflation(target, sdk.NewDecWithPrec(1, 2))
+	inflation.Schedule = &types.MunicipalInflationSchedule{
+		Steps: []types.MunicipalInflationStep{{Height: 10, Value: sdk.NewDecWithPrec(2, 2)}},
+	}
+	inflations := []*types.MunicipalInflationPair{{Denom: "denom0", Inflation: inflation}}
+
+	cached := c.RefreshIfNecessary(&inflations, 100, 5, blockTime)
+	require.Same(t, cached, c.Snapshot(&inflations, 100, 5, blockTime))
+
+	// the scheduled step is due, so the cached snapshot must not be served
+	snapshot := c.Snapshot(&inflations, 100, 10, blockTime)
+	require.NotSame(t, cached, snapshot)
+	require.Equal(t, sdk.NewDecWithPrec(2, 2), snapshot.GetStatus("denom0").CurrentRate)
+
+	// nothing is due, but the snapshot is still built for the requested block
+	snapshot = c.Snapshot(&inflations, 100, 6, blockTime)
+	require.NotSame(t, cached, snapshot)
+	require.Equal(t, sdk.NewDecWithPrec(1, 2), snapshot.GetStatus("denom0").CurrentRate)
+}
@@ -1,7 +1,9 @@
 package cache
 
 import (
+	"bytes"
 	"sync/atomic"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
@@ -10,36 +12,48 @@ import (
 type MunicipalInflationCacheItem struct {
 	PerBlockInflation sdk.Dec
 	AnnualInflation   *types.MunicipalInflation
+	// Segment of the inflation schedule active at the time of the last refresh
+	Segment types.MunicipalInflationSegment
 }
 
-type MunicipalInflationCacheInternal struct {
+// MunicipalInflationSnapshot is an immutable view of municipal inflations with
+// pre-calculated per block inflation rates. Once built, it is *NEVER* modified,
+// so it can be read concurrently without any locking.
+type MunicipalInflationSnapshot struct {
 	blocksPerYear uint64
-	original      *[]*types.MunicipalInflationPair
-	inflations    map[string]*MunicipalInflationCacheItem // {denom: inflationPerBlock}
+	height        int64
+	blockTime     time.Time
+	// source is the serialised form of the inflations the snapshot was built
+	// from, what allows to detect that the snapshot is stale
+	source     []byte
+	original   *[]*types.MunicipalInflationPair
+	inflations map[string]*MunicipalInflationCacheItem // {denom: inflationPerBlock}
 }
 
 // MunicipalInflationCache Cache optimised for concurrent reading performance.
 // *NO* support for concurrent writing operations.
+//
+// The cache is owned by the mint keeper. It is never trusted blindly: whenever
+// the snapshot held by the cache has been built from inflations or params which
+// differ from the ones passed in (e.g. after the minter or params have been
+// changed, the block has been discarded, or the app has been restarted at
+// a different height), the snapshot is rebuilt.
 type MunicipalInflationCache struct {
 	internal atomic.Value
 }
 
-// GMunicipalInflationCache Thread safety:
-// As the things stand now from design & impl. perspective:
-//  1. This global variable is supposed to be initialised(= its value set)
-//     just *ONCE* here, at this place,
-//  2. This global variable shall *NOT* be used anywhere else in the initialisation
-//     context of the *global scope* - e.g. as input for initialisation of
-//     another global variable, etc. ...
-//  3. All *exported* methods of `MunicipalInflationCache` type *ARE* thread safe,
-//     and so can be called from anywhere, *EXCEPT* from the initialisation context
-//     of the global scope(implication of the point 2 above)!
-var GMunicipalInflationCache = MunicipalInflationCache{}
+// NewMunicipalInflationCache returns an empty cache, which is built by the first
+// refresh.
+func NewMunicipalInflationCache() *MunicipalInflationCache {
+	return &MunicipalInflationCache{}
+}
 
-func (cache *MunicipalInflationCache) Refresh(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64) {
-	newCache := MunicipalInflationCacheInternal{}
-	newCache.refresh(inflations, blocksPerYear)
-	cache.internal.Store(&newCache)
+// Refresh rebuilds the cache from the given inflations, selecting schedule
+// segments active in the block with the given height and time.
+func (cache *MunicipalInflationCache) Refresh(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64, height int64, blockTime time.Time) *MunicipalInflationSnapshot {
+	snapshot := NewMunicipalInflationSnapshot(inflations, blocksPerYear, height, blockTime)
+	cache.internal.Store(snapshot)
+	return snapshot
 }
 
 // RefreshIfNecessary
@@ -51,61 +65,136 @@ func (cache *MunicipalInflationCache) Refresh(inflations *[]*types.MunicipalInfl
 // Most of the read operations are assumed to be done from RPC (querying municipal inflation),
 // and since threading models of the RPC implementation is not know, the worst scenario(= heavily
 // concurrent threading model) for read operation is assumed.
-func (cache *MunicipalInflationCache) RefreshIfNecessary(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64) {
-	if val := cache.internal.Load(); val == nil || val.(*MunicipalInflationCacheInternal).isRefreshRequired(blocksPerYear) {
-		cache.Refresh(inflations, blocksPerYear)
+func (cache *MunicipalInflationCache) RefreshIfNecessary(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64, height int64, blockTime time.Time) *MunicipalInflationSnapshot {
+	if current := cache.load(); current != nil && !current.isRefreshRequired(inflations, blocksPerYear, height, blockTime) {
+		return current
 	}
+
+	return cache.Refresh(inflations, blocksPerYear, height, blockTime)
 }
 
-func (cache *MunicipalInflationCache) GetInflation(denom string) *MunicipalInflationCacheItem {
-	val := cache.internal.Load()
-	if val == nil {
-		return nil
+// Snapshot returns the cached snapshot if it has been built for the block with
+// the given height from the given inflations & blocks per year, and no scheduled
+// change is due, otherwise a new snapshot is built for the block with the given
+// height and time. The cache itself is *NOT* modified, so this method is safe to
+// be called concurrently, e.g. from RPC queries.
+func (cache *MunicipalInflationCache) Snapshot(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64, height int64, blockTime time.Time) *MunicipalInflationSnapshot {
+	if current := cache.load(); current != nil && current.height == height && !current.isRefreshRequired(inflations, blocksPerYear, height, blockTime) {
+		return current
 	}
 
-	infl, exists := val.(*MunicipalInflationCacheInternal).inflations[denom]
-
-	if exists {
-		return infl
-	}
+	return NewMunicipalInflationSnapshot(inflations, blocksPerYear, height, blockTime)
+}
 
-	return nil
+// Invalidate drops the cached snapshot, so it will be rebuilt by the next refresh.
+func (cache *MunicipalInflationCache) Invalidate() {
+	cache.internal.Store((*MunicipalInflationSnapshot)(nil))
 }
 
-func (cache *MunicipalInflationCache) GetOriginal() *[]*types.MunicipalInflationPair {
+func (cache *MunicipalInflationCache) load() *MunicipalInflationSnapshot {
 	val := cache.internal.Load()
 	if val == nil {
-		return &[]*types.MunicipalInflationPair{}
+		return nil
 	}
 
-	current := val.(*MunicipalInflationCacheInternal)
-	return current.original
+	return val.(*MunicipalInflationSnapshot)
 }
 
-// NOTE(pb): *NOT* thread safe
-func (cache *MunicipalInflationCacheInternal) refresh(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64) {
+// NewMunicipalInflationSnapshot builds the snapshot from the given inflations,
+// selecting schedule segments active in the block with the given height and time.
+func NewMunicipalInflationSnapshot(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64, height int64, blockTime time.Time) *MunicipalInflationSnapshot {
 	if err := types.ValidateMunicipalInflations(inflations); err != nil {
 		panic(err)
 	}
 
-	cache.blocksPerYear = blocksPerYear
-	cache.original = inflations
-	cache.inflations = map[string]*MunicipalInflationCacheItem{}
+	snapshot := &MunicipalInflationSnapshot{
+		blocksPerYear: blocksPerYear,
+		height:        height,
+		blockTime:     blockTime,
+		source:        serialise(inflations),
+		original:      inflations,
+		inflations:    map[string]*MunicipalInflationCacheItem{},
+	}
 
 	for _, pair := range *inflations {
-		inflationPerBlock, err := types.CalculateInflationPerBlock(pair.Inflation.Value, blocksPerYear)
+		segment := pair.Inflation.ActiveSegment(height, blockTime)
+
+		inflationPerBlock, err := types.CalculateInflationPerBlock(segment.Rate, blocksPerYear)
 		if err != nil {
 			panic(err)
 		}
 
-		cache.inflations[pair.Denom] = &MunicipalInflationCacheItem{
+		snapshot.inflations[pair.Denom] = &MunicipalInflationCacheItem{
 			inflationPerBlock,
 			pair.Inflation,
+			segment,
 		}
 	}
+
+	return snapshot
+}
+
+func (snapshot *MunicipalInflationSnapshot) GetInflation(denom string) *MunicipalInflationCacheItem {
+	infl, exists := snapshot.inflations[denom]
+
+	if exists {
+		return infl
+	}
+
+	return nil
 }
 
-// NOTE(pb): *NOT* thread safe
-func (cache *MunicipalInflationCacheInternal) isRefreshRequired(blocksPerYear uint64) bool {
-	return cache.blocksPerYear != blocksPerYear
+func (snapshot *MunicipalInflationSnapshot) GetOriginal() *[]*types.MunicipalInflationPair {
+	return snapshot.original
+}
+
+// GetStatus returns the active rate and the next scheduled change of the municipal
+// inflation for the given denomination, as of the block the snapshot was built for.
+func (snapshot *MunicipalInflationSnapshot) GetStatus(denom string) *types.MunicipalInflationStatus {
+	infl, exists := snapshot.inflations[denom]
+	if !exists {
+		return nil
+	}
+
+	status := &types.MunicipalInflationStatus{
+		Denom:            denom,
+		CurrentRate:      infl.Segment.Rate,
+		NextChangeHeight: infl.Segment.NextChangeHeight,
+		NextChangeTime:   infl.Segment.NextChangeTime,
+		NextRate:         infl.Segment.Rate,
+	}
+	if next, exists := infl.AnnualInflation.NextSegment(infl.Segment, snapshot.height, snapshot.blockTime, snapshot.blocksPerYear); exists {
+		status.NextRate = next.Rate
+	}
+
+	return status
+}
+
+func (snapshot *MunicipalInflationSnapshot) isBuiltFrom(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64) bool {
+	return snapshot.blocksPerYear == blocksPerYear && bytes.Equal(snapshot.source, serialise(inflations))
+}
+
+func (snapshot *MunicipalInflationSnapshot) isRefreshRequired(inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64, height int64, blockTime time.Time) bool {
+	if !snapshot.isBuiltFrom(inflations, blocksPerYear) {
+		return true
+	}
+
+	for _, item := range snapshot.inflations {
+		if item.Segment.IsChangeDue(height, blockTime) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// serialise returns deterministic binary representation of the inflations.
+func serialise(inflations *[]*types.MunicipalInflationPair) []byte {
+	minter := types.Minter{MunicipalInflation: *inflations}
+	bz, err := minter.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	return bz
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/spf13/cobra"
 )
@@ -24,6 +25,12 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryInflation(),
 		GetCmdQueryMunicipalInflation(),
 		GetCmdQueryAnnualProvisions(),
+		GetCmdQuerySupplyHeadroom(),
+		GetCmdQueryMintedTotal(),
+		GetCmdQueryRecipientMinted(),
+		GetCmdQueryMintCheckpoints(),
+		GetCmdQueryBlocksPerYear(),
+		GetCmdQueryBurnedTotal(),
 	)
 
 	return mintingQueryCmd
@@ -153,3 +160,209 @@ func GetCmdQueryAnnualProvisions() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdQuerySupplyHeadroom implements a command to return the remaining amount
+// of capped denominations which can be minted.
+func GetCmdQuerySupplyHeadroom() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "supply-headroom [denomination]",
+		Short: "Query the remaining amount which can be minted before reaching the max supply",
+		Long: `If there is NO 'denomination' value is provided, then query returns headroom
+of all denominations with the max supply defined in the minting parameters.
+Otherwise it returns only the headroom for the provided 'denomination' value.`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := types.QuerySupplyHeadroomRequest{}
+			if len(args) > 0 {
+				req.XDenom = &types.QuerySupplyHeadroomRequest_Denom{Denom: args[0]}
+			}
+
+			res, err := queryClient.SupplyHeadroom(cmd.Context(), &req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryMintedTotal implements a command to return the total amounts of
+// tokens minted per denomination.
+func GetCmdQueryMintedTotal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minted-total",
+		Short: "Query the total amounts of tokens minted per denomination",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MintedTotal(cmd.Context(), &types.QueryMintedTotalRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "minted total")
+
+	return cmd
+}
+
+// GetCmdQueryRecipientMinted implements a command to return the total amounts
+// of tokens minted to a recipient.
+func GetCmdQueryRecipientMinted() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "minted-by-recipient [recipient]",
+		Short: "Query the total amounts of tokens minted to a recipient",
+		Long: `Query the total amounts of tokens minted to a recipient, identified either
+by its bech32 address, or by the name of a module account prefixed with "module:".`,
+		Example: fmt.Sprintf("$ %s query mint minted-by-recipient module:fee_collector", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RecipientMinted(cmd.Context(), &types.QueryRecipientMintedRequest{
+				Recipient:  args[0],
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "minted by recipient")
+
+	return cmd
+}
+
+// GetCmdQueryMintCheckpoints implements a command to return the periodic
+// checkpoints of the total minted amounts.
+func GetCmdQueryMintCheckpoints() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "checkpoints",
+		Short: "Query the periodic checkpoints of the total minted amounts",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.MintCheckpoints(cmd.Context(), &types.QueryMintCheckpointsRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "checkpoints")
+
+	return cmd
+}
+
+// GetCmdQueryBlocksPerYear implements a command to return the effective blocks
+// per year, together with the observed block rate.
+func GetCmdQueryBlocksPerYear() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "blocks-per-year",
+		Short: "Query the effective blocks per year and the observed block rate",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.BlocksPerYear(cmd.Context(), &types.QueryBlocksPerYearRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryBurnedTotal implements a command to return the total amounts of
+// tokens burned by municipal inflation in burn mode.
+func GetCmdQueryBurnedTotal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "burned-total",
+		Short: "Query the total amounts of tokens burned per denomination by municipal inflation",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.BurnedTotal(cmd.Context(), &types.QueryBurnedTotalRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "burned total")
+
+	return cmd
+}
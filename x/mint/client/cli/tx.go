@@ -0,0 +1,153 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// NewCmdSubmitUpdateMunicipalInflationProposal implements the command to submit a
+// update-municipal-inflation proposal
+func NewCmdSubmitUpdateMunicipalInflationProposal() *cobra.Command {
+	bech32PrefixAccAddr := sdk.GetConfig().GetBech32AccountAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "update-municipal-inflation [proposal-file]",
+		Args:  cobra.ExactArgs(1),
+		Short: "Submit a proposal to add or change municipal inflation entries",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a municipal inflation update proposal along with an initial deposit.
+Each listed entry replaces the municipal inflation of its denomination, or adds
+a new one if the denomination has no municipal inflation yet. Newly minted
+tokens are either sent to a single "target_address", or split between weighted
+"targets", where shares sent to the "distribution" module fund the community pool.
+The proposal details must be supplied via a JSON file.
+
+Example:
+$ %s tx gov submit-proposal update-municipal-inflation <path/to/proposal.json> --from=<key_or_address>
+
+Where proposal.json contains:
+
+{
+  "title": "Municipal Inflation Update",
+  "description": "Mint 5%% of mytoken annually",
+  "municipal_inflation": [
+    {
+      "denom": "mytoken",
+      "inflation": {
+        "value": "0.05",
+        "targets": [
+          {"address": "%s1s5afhd6gxevu37mkqcvvsj8qeylhn0rz46zdlq", "weight": "0.8"},
+          {"module": "distribution", "weight": "0.2"}
+        ]
+      }
+    }
+  ],
+  "deposit": "1000stake"
+}
+`,
+				version.AppName, bech32PrefixAccAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			proposal, err := ParseUpdateMunicipalInflationProposalWithDeposit(clientCtx.Codec, args[0])
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(proposal.Deposit)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+			content := types.NewUpdateMunicipalInflationProposal(proposal.Title, proposal.Description, proposal.MunicipalInflation)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	return cmd
+}
+
+// NewCmdSubmitRemoveMunicipalInflationProposal implements the command to submit a
+// remove-municipal-inflation proposal
+func NewCmdSubmitRemoveMunicipalInflationProposal() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-municipal-inflation [denom]...",
+		Args:  cobra.MinimumNArgs(1),
+		Short: "Submit a proposal to remove municipal inflation entries",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Submit a proposal to remove municipal inflation of the given denominations
+along with an initial deposit.
+
+Example:
+$ %s tx gov submit-proposal remove-municipal-inflation mytoken --title="Stop mytoken inflation" --description="..." --deposit=1000stake --from=<key_or_address>
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			from := clientCtx.GetFromAddress()
+
+			depositStr, err := cmd.Flags().GetString(govcli.FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinsNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			title, err := cmd.Flags().GetString(govcli.FlagTitle)
+			if err != nil {
+				return err
+			}
+
+			description, err := cmd.Flags().GetString(govcli.FlagDescription)
+			if err != nil {
+				return err
+			}
+
+			content := types.NewRemoveMunicipalInflationProposal(title, description, args)
+
+			msg, err := govtypes.NewMsgSubmitProposal(content, deposit, from)
+			if err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(govcli.FlagTitle, "", "title of proposal")
+	cmd.Flags().String(govcli.FlagDescription, "", "description of proposal")
+	cmd.Flags().String(govcli.FlagDeposit, "", "deposit of proposal")
+	cmd.MarkFlagRequired(govcli.FlagTitle)
+	cmd.MarkFlagRequired(govcli.FlagDescription)
+
+	return cmd
+}
@@ -0,0 +1,24 @@
+package cli
+
+import (
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// ParseUpdateMunicipalInflationProposalWithDeposit reads and parses an UpdateMunicipalInflationProposalWithDeposit from a file.
+func ParseUpdateMunicipalInflationProposalWithDeposit(cdc codec.JSONCodec, proposalFile string) (types.UpdateMunicipalInflationProposalWithDeposit, error) {
+	proposal := types.UpdateMunicipalInflationProposalWithDeposit{}
+
+	contents, err := os.ReadFile(proposalFile)
+	if err != nil {
+		return proposal, err
+	}
+
+	if err = cdc.UnmarshalJSON(contents, &proposal); err != nil {
+		return proposal, err
+	}
+
+	return proposal, nil
+}
@@ -0,0 +1,12 @@
+package client
+
+import (
+	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	"github.com/cosmos/cosmos-sdk/x/mint/client/cli"
+	"github.com/cosmos/cosmos-sdk/x/mint/client/rest"
+)
+
+var (
+	UpdateMunicipalInflationProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitUpdateMunicipalInflationProposal, rest.UpdateMunicipalInflationProposalRESTHandler)
+	RemoveMunicipalInflationProposalHandler = govclient.NewProposalHandler(cli.NewCmdSubmitRemoveMunicipalInflationProposal, rest.RemoveMunicipalInflationProposalRESTHandler)
+)
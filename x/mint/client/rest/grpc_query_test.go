@@ -71,7 +71,7 @@ func (s *IntegrationTestSuite) TestQueryGRPC() {
 			map[string]string{},
 			&minttypes.QueryParamsResponse{},
 			&minttypes.QueryParamsResponse{
-				Params: minttypes.NewParams("stake", sdk.NewDecWithPrec(3, 2), (60 * 60 * 8766 / 5)),
+				Params: minttypes.DefaultParams(),
 			},
 		},
 		{
@@ -0,0 +1,107 @@
+package rest
+
+import (
+	"net/http"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/rest"
+	govrest "github.com/cosmos/cosmos-sdk/x/gov/client/rest"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+type (
+	// UpdateMunicipalInflationProposalReq defines a municipal inflation update proposal request body.
+	UpdateMunicipalInflationProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title              string                          `json:"title" yaml:"title"`
+		Description        string                          `json:"description" yaml:"description"`
+		MunicipalInflation []*types.MunicipalInflationPair `json:"municipal_inflation" yaml:"municipal_inflation"`
+		Proposer           sdk.AccAddress                  `json:"proposer" yaml:"proposer"`
+		Deposit            sdk.Coins                       `json:"deposit" yaml:"deposit"`
+	}
+
+	// RemoveMunicipalInflationProposalReq defines a municipal inflation removal proposal request body.
+	RemoveMunicipalInflationProposalReq struct {
+		BaseReq rest.BaseReq `json:"base_req" yaml:"base_req"`
+
+		Title       string         `json:"title" yaml:"title"`
+		Description string         `json:"description" yaml:"description"`
+		Denoms      []string       `json:"denoms" yaml:"denoms"`
+		Proposer    sdk.AccAddress `json:"proposer" yaml:"proposer"`
+		Deposit     sdk.Coins      `json:"deposit" yaml:"deposit"`
+	}
+)
+
+// UpdateMunicipalInflationProposalRESTHandler returns a ProposalRESTHandler that exposes the municipal
+// inflation update REST handler with a given sub-route.
+func UpdateMunicipalInflationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "update_municipal_inflation",
+		Handler:  postUpdateMunicipalInflationProposalHandlerFn(clientCtx),
+	}
+}
+
+// RemoveMunicipalInflationProposalRESTHandler returns a ProposalRESTHandler that exposes the municipal
+// inflation removal REST handler with a given sub-route.
+func RemoveMunicipalInflationProposalRESTHandler(clientCtx client.Context) govrest.ProposalRESTHandler {
+	return govrest.ProposalRESTHandler{
+		SubRoute: "remove_municipal_inflation",
+		Handler:  postRemoveMunicipalInflationProposalHandlerFn(clientCtx),
+	}
+}
+
+func postUpdateMunicipalInflationProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req UpdateMunicipalInflationProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewUpdateMunicipalInflationProposal(req.Title, req.Description, req.MunicipalInflation)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
+
+func postRemoveMunicipalInflationProposalHandlerFn(clientCtx client.Context) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		var req RemoveMunicipalInflationProposalReq
+		if !rest.ReadRESTReq(w, r, clientCtx.LegacyAmino, &req) {
+			return
+		}
+
+		req.BaseReq = req.BaseReq.Sanitize()
+		if !req.BaseReq.ValidateBasic(w) {
+			return
+		}
+
+		content := types.NewRemoveMunicipalInflationProposal(req.Title, req.Description, req.Denoms)
+
+		msg, err := govtypes.NewMsgSubmitProposal(content, req.Deposit, req.Proposer)
+		if rest.CheckBadRequestError(w, err) {
+			return
+		}
+		if rest.CheckBadRequestError(w, msg.ValidateBasic()) {
+			return
+		}
+
+		tx.WriteGeneratedTxResponse(clientCtx, w, req.BaseReq, msg)
+	}
+}
@@ -71,13 +71,24 @@ func (s *IntegrationTestSuite) TestGetCmdQueryParams() {
 		{
 			"json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"mint_denom":"stake","inflation_rate":"0.030000000000000000","blocks_per_year":"6311520"}`,
+			`{"mint_denom":"stake","inflation_rate":"0.030000000000000000","inflation_max":"0.200000000000000000","inflation_min":"0.070000000000000000","goal_bonded":"0.670000000000000000","blocks_per_year":"6311520","max_supply":[],"inflation_mode":"INFLATION_MODE_FIXED","inflation_rate_change":"0.130000000000000000","checkpoint_interval":"17280","calibrate_blocks_per_year":false,"min_blocks_per_year":"3155760","max_blocks_per_year":"31557600","calibration_window":"720"}`,
 		},
 		{
 			"text output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=text", tmcli.OutputFlag)},
 			`blocks_per_year: "6311520"
+calibrate_blocks_per_year: false
+calibration_window: "720"
+checkpoint_interval: "17280"
+goal_bonded: "0.670000000000000000"
+inflation_max: "0.200000000000000000"
+inflation_min: "0.070000000000000000"
+inflation_mode: INFLATION_MODE_FIXED
 inflation_rate: "0.030000000000000000"
+inflation_rate_change: "0.130000000000000000"
+max_blocks_per_year: "31557600"
+max_supply: []
+min_blocks_per_year: "3155760"
 mint_denom: stake`,
 		},
 	}
@@ -107,7 +118,7 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMunicipalInflation() {
 		{
 			"full - json output",
 			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"inflations":[{"denom":"denom1","inflation":{"target_address":"cosmos12kdu2sy0zcmz84qymyj6zcfvwss3a703xgpczm","value":"0.023400000000000000"}},{"denom":"denom0","inflation":{"target_address":"cosmos1d9pzg5542spe4anjgu2zmk7wxhgh04ysn2phpq","value":"1.230000000000000000"}},{"denom":"denom3","inflation":{"target_address":"cosmos1ck73rpk6eqxtla4rv7rspsq7apl3740rgjfte4","value":"0.456000000000000000"}},{"denom":"denom2","inflation":{"target_address":"cosmos1ury8qn5w7m3xkl9pdd9ehazd2c9urx7qht2jly","value":"0.345000000000000000"}}]}`,
+			`{"inflations":[{"denom":"denom1","inflation":{"target_address":"cosmos12kdu2sy0zcmz84qymyj6zcfvwss3a703xgpczm","value":"0.023400000000000000","targets":[],"schedule":null,"mode":"MUNICIPAL_INFLATION_MODE_MINT"}},{"denom":"denom0","inflation":{"target_address":"cosmos1d9pzg5542spe4anjgu2zmk7wxhgh04ysn2phpq","value":"1.230000000000000000","targets":[],"schedule":null,"mode":"MUNICIPAL_INFLATION_MODE_MINT"}},{"denom":"denom3","inflation":{"target_address":"cosmos1ck73rpk6eqxtla4rv7rspsq7apl3740rgjfte4","value":"0.456000000000000000","targets":[],"schedule":null,"mode":"MUNICIPAL_INFLATION_MODE_MINT"}},{"denom":"denom2","inflation":{"target_address":"cosmos1ury8qn5w7m3xkl9pdd9ehazd2c9urx7qht2jly","value":"0.345000000000000000","targets":[],"schedule":null,"mode":"MUNICIPAL_INFLATION_MODE_MINT"}}],"statuses":[{"denom":"denom1","current_rate":"0.023400000000000000","next_change_height":"0","next_change_time":null,"next_rate":"0.023400000000000000"},{"denom":"denom0","current_rate":"1.230000000000000000","next_change_height":"0","next_change_time":null,"next_rate":"1.230000000000000000"},{"denom":"denom3","current_rate":"0.456000000000000000","next_change_height":"0","next_change_time":null,"next_rate":"0.456000000000000000"},{"denom":"denom2","current_rate":"0.345000000000000000","next_change_height":"0","next_change_time":null,"next_rate":"0.345000000000000000"}]}`,
 		},
 		{
 			"full - text output",
@@ -115,25 +126,58 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMunicipalInflation() {
 			`inflations:
 - denom: denom1
   inflation:
+    mode: MUNICIPAL_INFLATION_MODE_MINT
+    schedule: null
     target_address: cosmos12kdu2sy0zcmz84qymyj6zcfvwss3a703xgpczm
+    targets: []
     value: "0.023400000000000000"
 - denom: denom0
   inflation:
+    mode: MUNICIPAL_INFLATION_MODE_MINT
+    schedule: null
     target_address: cosmos1d9pzg5542spe4anjgu2zmk7wxhgh04ysn2phpq
+    targets: []
     value: "1.230000000000000000"
 - denom: denom3
   inflation:
+    mode: MUNICIPAL_INFLATION_MODE_MINT
+    schedule: null
     target_address: cosmos1ck73rpk6eqxtla4rv7rspsq7apl3740rgjfte4
+    targets: []
     value: "0.456000000000000000"
 - denom: denom2
   inflation:
+    mode: MUNICIPAL_INFLATION_MODE_MINT
+    schedule: null
     target_address: cosmos1ury8qn5w7m3xkl9pdd9ehazd2c9urx7qht2jly
-    value: "0.345000000000000000"`,
+    targets: []
+    value: "0.345000000000000000"
+statuses:
+- current_rate: "0.023400000000000000"
+  denom: denom1
+  next_change_height: "0"
+  next_change_time: null
+  next_rate: "0.023400000000000000"
+- current_rate: "1.230000000000000000"
+  denom: denom0
+  next_change_height: "0"
+  next_change_time: null
+  next_rate: "1.230000000000000000"
+- current_rate: "0.456000000000000000"
+  denom: denom3
+  next_change_height: "0"
+  next_change_time: null
+  next_rate: "0.456000000000000000"
+- current_rate: "0.345000000000000000"
+  denom: denom2
+  next_change_height: "0"
+  next_change_time: null
+  next_rate: "0.345000000000000000"`,
 		},
 		{
 			"selected denom - json output",
 			[]string{"denom3", fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
-			`{"inflations":[{"denom":"denom3","inflation":{"target_address":"cosmos1ck73rpk6eqxtla4rv7rspsq7apl3740rgjfte4","value":"0.456000000000000000"}}]}`,
+			`{"inflations":[{"denom":"denom3","inflation":{"target_address":"cosmos1ck73rpk6eqxtla4rv7rspsq7apl3740rgjfte4","value":"0.456000000000000000","targets":[],"schedule":null,"mode":"MUNICIPAL_INFLATION_MODE_MINT"}}],"statuses":[{"denom":"denom3","current_rate":"0.456000000000000000","next_change_height":"0","next_change_time":null,"next_rate":"0.456000000000000000"}]}`,
 		},
 		{
 			"selected denom - text output",
@@ -141,8 +185,17 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMunicipalInflation() {
 			`inflations:
 - denom: denom3
   inflation:
+    mode: MUNICIPAL_INFLATION_MODE_MINT
+    schedule: null
     target_address: cosmos1ck73rpk6eqxtla4rv7rspsq7apl3740rgjfte4
-    value: "0.456000000000000000"`,
+    targets: []
+    value: "0.456000000000000000"
+statuses:
+- current_rate: "0.456000000000000000"
+  denom: denom3
+  next_change_height: "0"
+  next_change_time: null
+  next_rate: "0.456000000000000000"`,
 		},
 	}
 
@@ -160,6 +213,44 @@ func (s *IntegrationTestSuite) TestGetCmdQueryMunicipalInflation() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryMintedTotal() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	args := []string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryMintedTotal(), args)
+	s.Require().NoError(err)
+
+	var total minttypes.QueryMintedTotalResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &total))
+	s.Require().True(total.Minted.AmountOf(s.cfg.BondDenom).IsPositive())
+
+	// block provisions are sent to the fee collector
+	out, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryRecipientMinted(), append([]string{"module:fee_collector"}, args...))
+	s.Require().NoError(err)
+
+	var minted minttypes.QueryRecipientMintedResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &minted))
+	s.Require().Equal(total.Minted.AmountOf(s.cfg.BondDenom), minted.Minted.AmountOf(s.cfg.BondDenom))
+
+	_, err = clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryRecipientMinted(), append([]string{"invalid"}, args...))
+	s.Require().Error(err)
+}
+
+func (s *IntegrationTestSuite) TestGetCmdQueryBurnedTotal() {
+	val := s.network.Validators[0]
+	clientCtx := val.ClientCtx
+	args := []string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)}
+
+	out, err := clitestutil.ExecTestCLICmd(clientCtx, cli.GetCmdQueryBurnedTotal(), args)
+	s.Require().NoError(err)
+
+	// no municipal inflation in burn mode is defined
+	var total minttypes.QueryBurnedTotalResponse
+	s.Require().NoError(clientCtx.Codec.UnmarshalJSON(out.Bytes(), &total))
+	s.Require().True(total.Burned.Empty())
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryInflation() {
 	val := s.network.Validators[0]
 
@@ -194,6 +285,35 @@ func (s *IntegrationTestSuite) TestGetCmdQueryInflation() {
 	}
 }
 
+func (s *IntegrationTestSuite) TestGetCmdQueryBlocksPerYear() {
+	val := s.network.Validators[0]
+
+	testCases := []struct {
+		name           string
+		args           []string
+		expectedOutput string
+	}{
+		{
+			"json output",
+			[]string{fmt.Sprintf("--%s=1", flags.FlagHeight), fmt.Sprintf("--%s=json", tmcli.OutputFlag)},
+			`{"blocks_per_year":"6311520","observed_blocks_per_year":"0","average_block_interval":"0.000000000000000000","samples":"0"}`,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		s.Run(tc.name, func() {
+			cmd := cli.GetCmdQueryBlocksPerYear()
+			clientCtx := val.ClientCtx
+
+			out, err := clitestutil.ExecTestCLICmd(clientCtx, cmd, tc.args)
+			s.Require().NoError(err)
+			s.Require().Equal(tc.expectedOutput, strings.TrimSpace(out.String()))
+		})
+	}
+}
+
 func (s *IntegrationTestSuite) TestGetCmdQueryAnnualProvisions() {
 	val := s.network.Validators[0]
 
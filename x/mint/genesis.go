@@ -2,16 +2,36 @@ package mint
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/mint/cache"
 	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
 // InitGenesis new mint genesis
 func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper, data *types.GenesisState) {
-	cache.GMunicipalInflationCache.Refresh(&data.Minter.MunicipalInflation, data.Params.BlocksPerYear)
+	if err := keeper.ValidateMunicipalInflationTargets(data.Minter.MunicipalInflation); err != nil {
+		panic(err)
+	}
 	keeper.SetMinter(ctx, data.Minter)
 	keeper.SetParams(ctx, data.Params)
+	if data.BlockTimeObservation != nil {
+		keeper.SetBlockTimeObservation(ctx, *data.BlockTimeObservation)
+	}
+	keeper.InvalidateMunicipalInflationCache()
+	keeper.RefreshMunicipalInflationCache(ctx, &data.Minter.MunicipalInflation, keeper.GetBlocksPerYear(ctx, data.Params))
+
+	for _, minted := range data.MintedTotal {
+		keeper.SetMintedTotal(ctx, minted)
+	}
+	for _, burned := range data.BurnedTotal {
+		keeper.SetBurnedTotal(ctx, burned)
+	}
+	for _, recipientMinted := range data.RecipientsMinted {
+		keeper.SetRecipientMinted(ctx, recipientMinted)
+	}
+	for _, checkpoint := range data.Checkpoints {
+		keeper.SetMintCheckpoint(ctx, checkpoint)
+	}
+
 	ak.GetModuleAccount(ctx, types.ModuleName)
 }
 
@@ -19,5 +39,14 @@ func InitGenesis(ctx sdk.Context, keeper keeper.Keeper, ak types.AccountKeeper,
 func ExportGenesis(ctx sdk.Context, keeper keeper.Keeper) *types.GenesisState {
 	minter := keeper.GetMinter(ctx)
 	params := keeper.GetParams(ctx)
-	return types.NewGenesisState(minter, params)
+
+	genesis := types.NewGenesisState(minter, params)
+	genesis.MintedTotal = keeper.GetAllMintedTotal(ctx)
+	genesis.BurnedTotal = keeper.GetAllBurnedTotal(ctx)
+	genesis.RecipientsMinted = keeper.GetAllRecipientsMinted(ctx)
+	genesis.Checkpoints = keeper.GetAllMintCheckpoints(ctx)
+	if observation, found := keeper.GetBlockTimeObservation(ctx); found {
+		genesis.BlockTimeObservation = &observation
+	}
+	return genesis
 }
@@ -0,0 +1,26 @@
+package mint
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// NewMunicipalInflationProposalHandler creates a governance handler to manage
+// municipal inflation entries of the minter.
+func NewMunicipalInflationProposalHandler(k keeper.Keeper) govtypes.Handler {
+	return func(ctx sdk.Context, content govtypes.Content) error {
+		switch c := content.(type) {
+		case *types.UpdateMunicipalInflationProposal:
+			return keeper.HandleUpdateMunicipalInflationProposal(ctx, k, c)
+
+		case *types.RemoveMunicipalInflationProposal:
+			return keeper.HandleRemoveMunicipalInflationProposal(ctx, k, c)
+
+		default:
+			return sdkerrors.Wrapf(sdkerrors.ErrUnknownRequest, "unrecognized mint proposal content type: %T", c)
+		}
+	}
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// GetBurnSourceBalance returns the balance of the municipal inflation burn
+// source module account in the given denomination, which can be burned.
+func (k Keeper) GetBurnSourceBalance(ctx sdk.Context, source *types.MunicipalInflationTarget, denom string) sdk.Coin {
+	return k.BankKeeper.GetBalance(ctx, k.authKeeper.GetModuleAddress(source.Module), denom)
+}
+
+// BurnFromMunicipalInflationSource moves the coins from the burn source to the
+// mint module account and burns them there. Only the treasury module accounts
+// the keeper has been configured with can be the source.
+func (k Keeper) BurnFromMunicipalInflationSource(ctx sdk.Context, source *types.MunicipalInflationTarget, amount sdk.Coins) error {
+	if amount.Empty() {
+		return nil
+	}
+
+	if !k.burnSourceModules[source.Module] {
+		return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot be the source of burned tokens", source.Recipient())
+	}
+
+	if source.Module != types.ModuleName {
+		if err := k.BankKeeper.SendCoinsFromModuleToModule(ctx, source.Module, types.ModuleName, amount); err != nil {
+			return err
+		}
+	}
+
+	return k.BankKeeper.BurnCoins(ctx, types.ModuleName, amount)
+}
+
+// RecordBurned adds the burned coins to the total burned amounts of their
+// denominations.
+func (k Keeper) RecordBurned(ctx sdk.Context, burned sdk.Coins) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedTotalKeyPrefix)
+	for _, coin := range burned {
+		addAmount(store, coin)
+	}
+}
+
+// GetBurnedTotal returns the total amount of the denomination burned so far.
+func (k Keeper) GetBurnedTotal(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedTotalKeyPrefix)
+	return sdk.NewCoin(denom, getAmount(store, denom))
+}
+
+// GetAllBurnedTotal returns the total amounts of all denominations burned so far.
+func (k Keeper) GetAllBurnedTotal(ctx sdk.Context) sdk.Coins {
+	burned, _, err := k.GetPaginatedBurnedTotal(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	return burned
+}
+
+// GetPaginatedBurnedTotal queries for the total burned amounts of denominations
+// with a given pagination.
+func (k Keeper) GetPaginatedBurnedTotal(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedTotalKeyPrefix)
+	return paginateAmounts(store, pagination)
+}
+
+// SetBurnedTotal sets the total burned amount of the coin denomination.
+func (k Keeper) SetBurnedTotal(ctx sdk.Context, burned sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BurnedTotalKeyPrefix)
+	setAmount(store, burned)
+}
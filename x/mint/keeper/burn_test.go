@@ -0,0 +1,81 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+)
+
+func TestBurnedAccountingGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.MintKeeper
+
+	k.RecordBurned(ctx, sdk.NewCoins(sdk.NewInt64Coin("denom0", 10), sdk.NewInt64Coin("denom1", 20)))
+	k.RecordBurned(ctx, sdk.NewCoins(sdk.NewInt64Coin("denom0", 5)))
+	require.Equal(t, sdk.NewInt64Coin("denom0", 15), k.GetBurnedTotal(ctx, "denom0"))
+
+	genesis := mint.ExportGenesis(ctx, k)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom0", 15), sdk.NewInt64Coin("denom1", 20)), genesis.BurnedTotal)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
+	mint.InitGenesis(ctx2, app2.MintKeeper, app2.AccountKeeper, genesis)
+	require.Equal(t, genesis, mint.ExportGenesis(ctx2, app2.MintKeeper))
+}
+
+func TestValidationOfBurnSource(t *testing.T) {
+	app := simapp.Setup(false)
+
+	inflation := func(module string) []*types.MunicipalInflationPair {
+		burn := types.NewWeightedMunicipalInflation(sdk.NewDecWithPrec(1, 2).Neg(), types.NewMunicipalInflationModuleTarget(module, sdk.OneDec()))
+		burn.Mode = types.MunicipalInflationModeBurn
+		return []*types.MunicipalInflationPair{{Denom: "denom0", Inflation: burn}}
+	}
+
+	// only the configured treasury modules can be drained
+	require.NoError(t, app.MintKeeper.ValidateMunicipalInflationTargets(inflation(types.ModuleName)))
+	for _, module := range []string{
+		authtypes.FeeCollectorName, distrtypes.ModuleName, stakingtypes.BondedPoolName, stakingtypes.NotBondedPoolName, govtypes.ModuleName,
+	} {
+		require.Error(t, app.MintKeeper.ValidateMunicipalInflationTargets(inflation(module)), module)
+	}
+
+	// balances of other accounts cannot be burned
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	holder := sdk.AccAddress([]byte("holder______________"))
+	coins := sdk.NewCoins(sdk.NewInt64Coin("denom0", 1000))
+	require.NoError(t, simapp.FundAccount(app.BankKeeper, ctx, holder, coins))
+
+	accountSource := types.NewWeightedMunicipalInflation(sdk.NewDecWithPrec(1, 2).Neg(), types.NewMunicipalInflationAddressTarget(holder.String(), sdk.OneDec()))
+	accountSource.Mode = types.MunicipalInflationModeBurn
+	require.Error(t, app.MintKeeper.ValidateMunicipalInflationTargets([]*types.MunicipalInflationPair{{Denom: "denom0", Inflation: accountSource}}))
+	require.Error(t, app.MintKeeper.BurnFromMunicipalInflationSource(ctx, accountSource.Targets[0], coins))
+	require.Equal(t, coins, app.BankKeeper.GetAllBalances(ctx, holder))
+}
+
+func TestMigrate5to6GrantsBurnerPermission(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+
+	acc := app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	acc.Permissions = []string{authtypes.Minter}
+	app.AccountKeeper.SetModuleAccount(ctx, acc)
+
+	require.NoError(t, keeper.NewMigrator(app.MintKeeper).Migrate5to6(ctx))
+	migrated := app.AccountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	require.True(t, migrated.HasPermission(authtypes.Minter))
+	require.True(t, migrated.HasPermission(authtypes.Burner))
+}
@@ -0,0 +1,82 @@
+package keeper
+
+import (
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// GetBlockTimeObservation returns the observed block times and true, or empty
+// observation and false if no block times have been observed.
+func (k Keeper) GetBlockTimeObservation(ctx sdk.Context) (observation types.BlockTimeObservation, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	b := store.Get(types.BlockTimeObservationKey)
+	if b == nil {
+		return observation, false
+	}
+
+	k.cdc.MustUnmarshal(b, &observation)
+	return observation, true
+}
+
+// SetBlockTimeObservation stores the observed block times.
+func (k Keeper) SetBlockTimeObservation(ctx sdk.Context, observation types.BlockTimeObservation) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.BlockTimeObservationKey, k.cdc.MustMarshal(&observation))
+}
+
+// DeleteBlockTimeObservation removes the observed block times.
+func (k Keeper) DeleteBlockTimeObservation(ctx sdk.Context) {
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.BlockTimeObservationKey)
+}
+
+// ObserveBlockTime adds the time of the current block to the moving average of
+// block intervals, and recalculates the calibrated blocks per year once per
+// calibration window. Observations are dropped while the calibration is
+// disabled, so stale block times are not used once it is enabled again.
+func (k Keeper) ObserveBlockTime(ctx sdk.Context, params types.Params) {
+	if !params.CalibrateBlocksPerYear {
+		k.DeleteBlockTimeObservation(ctx)
+		return
+	}
+
+	observation, _ := k.GetBlockTimeObservation(ctx)
+	if observation.Observe(ctx.BlockTime(), params.CalibrationWindow) {
+		observation.BlocksPerYear = params.BoundBlocksPerYear(observation.ObservedBlocksPerYear())
+
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeBlocksPerYearCalibrated,
+				sdk.NewAttribute(types.AttributeKeyBlocksPerYear, strconv.FormatUint(observation.BlocksPerYear, 10)),
+				sdk.NewAttribute(types.AttributeKeyBlockInterval, observation.AverageBlockInterval.String()),
+			),
+		)
+	}
+
+	k.SetBlockTimeObservation(ctx, observation)
+}
+
+// GetBlocksPerYear returns the effective blocks per year, which is the
+// calibrated value if the calibration is enabled and has already taken place,
+// the blocks_per_year param otherwise. The blocks_per_year param is returned as
+// well if the bounded calibrated value is zero.
+func (k Keeper) GetBlocksPerYear(ctx sdk.Context, params types.Params) uint64 {
+	if !params.CalibrateBlocksPerYear {
+		return params.BlocksPerYear
+	}
+
+	observation, found := k.GetBlockTimeObservation(ctx)
+	if !found || observation.BlocksPerYear == 0 {
+		return params.BlocksPerYear
+	}
+
+	// bounds may have been changed by governance since the last calibration
+	blocksPerYear := params.BoundBlocksPerYear(observation.BlocksPerYear)
+	if blocksPerYear == 0 {
+		return params.BlocksPerYear
+	}
+
+	return blocksPerYear
+}
@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestBlocksPerYearCalibration(t *testing.T) {
+	app, ctx := createTestApp(false)
+	k := app.MintKeeper
+
+	target := sdk.AccAddress("target______________").String()
+	minter := k.GetMinter(ctx)
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(target, sdk.NewDecWithPrec(1, 2))},
+	}
+	k.SetMinter(ctx, minter)
+
+	params := k.GetParams(ctx)
+	params.CalibrateBlocksPerYear = true
+	params.CalibrationWindow = 10
+	k.SetParams(ctx, params)
+
+	// blocks produced every 2 seconds, instead of the expected 5
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for height := int64(1); height <= 10; height++ {
+		mint.BeginBlocker(ctx.WithBlockHeight(height).WithBlockTime(blockTime), k)
+		blockTime = blockTime.Add(2 * time.Second)
+	}
+
+	// the blocks per year param is used until the window is filled
+	require.Equal(t, params.BlocksPerYear, k.GetBlocksPerYear(ctx, params))
+	before := k.GetMunicipalInflationSnapshot(ctx).GetInflation("denom0").PerBlockInflation
+
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(blockTime).WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, k)
+
+	require.Equal(t, uint64(types.SecondsPerYear/2), k.GetBlocksPerYear(ctx, params))
+	calibrated := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeBlocksPerYearCalibrated {
+			calibrated++
+		}
+	}
+	require.Equal(t, 1, calibrated)
+
+	// the cache follows the calibrated value
+	after := k.GetMunicipalInflationSnapshot(ctx).GetInflation("denom0").PerBlockInflation
+	require.True(t, after.LT(before))
+
+	// the calibrated value is bounded by the params
+	params.MaxBlocksPerYear = types.SecondsPerYear / 3
+	k.SetParams(ctx, params)
+	require.Equal(t, params.MaxBlocksPerYear, k.GetBlocksPerYear(ctx, params))
+
+	// the blocks per year param is used if the bounded value is zero, e.g. with
+	// params stored before the bounds were introduced
+	unbounded := params
+	unbounded.MinBlocksPerYear, unbounded.MaxBlocksPerYear = 0, 0
+	require.Equal(t, params.BlocksPerYear, k.GetBlocksPerYear(ctx, unbounded))
+
+	// observations are dropped once the calibration is disabled
+	params.CalibrateBlocksPerYear = false
+	k.SetParams(ctx, params)
+	mint.BeginBlocker(ctx.WithBlockHeight(12).WithBlockTime(blockTime.Add(time.Second)), k)
+	require.Equal(t, params.BlocksPerYear, k.GetBlocksPerYear(ctx, params))
+	_, found := k.GetBlockTimeObservation(ctx)
+	require.False(t, found)
+}
+
+func TestBlockTimeObservationGenesis(t *testing.T) {
+	app, ctx := createTestApp(false)
+	k := app.MintKeeper
+
+	params := k.GetParams(ctx)
+	params.CalibrateBlocksPerYear = true
+	k.SetParams(ctx, params)
+
+	observation := types.BlockTimeObservation{
+		LastBlockTime:        time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+		AverageBlockInterval: sdk.NewDec(2),
+		Samples:              720,
+		BlocksPerYear:        types.SecondsPerYear / 2,
+	}
+	k.SetBlockTimeObservation(ctx, observation)
+
+	genesis := mint.ExportGenesis(ctx, k)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+	require.Equal(t, &observation, genesis.BlockTimeObservation)
+
+	app2, ctx2 := createTestApp(false)
+	mint.InitGenesis(ctx2, app2.MintKeeper, app2.AccountKeeper, genesis)
+	require.Equal(t, uint64(types.SecondsPerYear/2), app2.MintKeeper.GetBlocksPerYear(ctx2, app2.MintKeeper.GetParams(ctx2)))
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/mint/cache"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -27,20 +29,34 @@ func (k Keeper) Inflation(c context.Context, _ *types.QueryInflationRequest) (*t
 	return &types.QueryInflationResponse{Inflation: minter.Inflation}, nil
 }
 
-// MunicipalInflation returns minter.MunicipalInflation of the mint module.
+// MunicipalInflation returns minter.MunicipalInflation of the mint module, together with the currently
+// active rate and the next scheduled change of each inflation.
 func (k Keeper) MunicipalInflation(c context.Context, req *types.QueryMunicipalInflationRequest) (*types.QueryMunicipalInflationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	snapshot := k.GetMunicipalInflationSnapshot(ctx)
 	denom := req.GetDenom()
 
 	if len(denom) == 0 {
-		return &types.QueryMunicipalInflationResponse{Inflations: *cache.GMunicipalInflationCache.GetOriginal()}, nil
+		inflations := *snapshot.GetOriginal()
+		statuses := make([]types.MunicipalInflationStatus, 0, len(inflations))
+		for _, pair := range inflations {
+			if status := snapshot.GetStatus(pair.Denom); status != nil {
+				statuses = append(statuses, *status)
+			}
+		}
+
+		return &types.QueryMunicipalInflationResponse{Inflations: inflations, Statuses: statuses}, nil
 	}
 
-	infl := cache.GMunicipalInflationCache.GetInflation(denom)
+	infl := snapshot.GetInflation(denom)
 	if infl == nil {
 		return nil, fmt.Errorf("there is no municipal inflation defined for requested \"%s\" denomination", denom)
 	}
 
-	return &types.QueryMunicipalInflationResponse{Inflations: []*types.MunicipalInflationPair{{Denom: denom, Inflation: infl.AnnualInflation}}}, nil
+	return &types.QueryMunicipalInflationResponse{
+		Inflations: []*types.MunicipalInflationPair{{Denom: denom, Inflation: infl.AnnualInflation}},
+		Statuses:   []types.MunicipalInflationStatus{*snapshot.GetStatus(denom)},
+	}, nil
 }
 
 // AnnualProvisions returns minter.AnnualProvisions of the mint module.
@@ -50,3 +66,113 @@ func (k Keeper) AnnualProvisions(c context.Context, _ *types.QueryAnnualProvisio
 
 	return &types.QueryAnnualProvisionsResponse{AnnualProvisions: minter.AnnualProvisions}, nil
 }
+
+// SupplyHeadroom returns the remaining amount of capped denominations which can be minted.
+func (k Keeper) SupplyHeadroom(c context.Context, req *types.QuerySupplyHeadroomRequest) (*types.QuerySupplyHeadroomResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	denom := req.GetDenom()
+
+	if len(denom) == 0 {
+		headrooms := make([]types.SupplyHeadroom, 0, len(params.MaxSupply))
+		for _, maxSupply := range params.MaxSupply {
+			headrooms = append(headrooms, k.GetSupplyHeadroom(ctx, maxSupply))
+		}
+
+		return &types.QuerySupplyHeadroomResponse{Headrooms: headrooms}, nil
+	}
+
+	maxSupply, capped := params.MaxSupplyOf(denom)
+	if !capped {
+		return nil, fmt.Errorf("there is no max supply defined for requested \"%s\" denomination", denom)
+	}
+
+	return &types.QuerySupplyHeadroomResponse{
+		Headrooms: []types.SupplyHeadroom{k.GetSupplyHeadroom(ctx, sdk.NewCoin(denom, maxSupply))},
+	}, nil
+}
+
+// MintedTotal returns the total amounts of tokens minted per denomination.
+func (k Keeper) MintedTotal(c context.Context, req *types.QueryMintedTotalRequest) (*types.QueryMintedTotalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	minted, pageRes, err := k.GetPaginatedMintedTotal(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintedTotalResponse{Minted: minted, Pagination: pageRes}, nil
+}
+
+// RecipientMinted returns the total amounts of tokens minted to a recipient.
+func (k Keeper) RecipientMinted(c context.Context, req *types.QueryRecipientMintedRequest) (*types.QueryRecipientMintedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := types.ValidateRecipient(req.Recipient); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	minted, pageRes, err := k.GetPaginatedRecipientMinted(ctx, req.Recipient, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRecipientMintedResponse{Minted: minted, Pagination: pageRes}, nil
+}
+
+// MintCheckpoints returns the periodic checkpoints of the total minted amounts.
+func (k Keeper) MintCheckpoints(c context.Context, req *types.QueryMintCheckpointsRequest) (*types.QueryMintCheckpointsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	checkpoints, pageRes, err := k.GetPaginatedMintCheckpoints(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintCheckpointsResponse{Checkpoints: checkpoints, Pagination: pageRes}, nil
+}
+
+// BurnedTotal returns the total amounts of tokens burned by municipal inflation
+// in burn mode.
+func (k Keeper) BurnedTotal(c context.Context, req *types.QueryBurnedTotalRequest) (*types.QueryBurnedTotalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	burned, pageRes, err := k.GetPaginatedBurnedTotal(ctx, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryBurnedTotalResponse{Burned: burned, Pagination: pageRes}, nil
+}
+
+// BlocksPerYear returns the effective blocks per year of the mint module, together
+// with the observed block rate.
+func (k Keeper) BlocksPerYear(c context.Context, _ *types.QueryBlocksPerYearRequest) (*types.QueryBlocksPerYearResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	params := k.GetParams(ctx)
+	observation, _ := k.GetBlockTimeObservation(ctx)
+
+	averageBlockInterval := observation.AverageBlockInterval
+	if averageBlockInterval.IsNil() {
+		averageBlockInterval = sdk.ZeroDec()
+	}
+
+	return &types.QueryBlocksPerYearResponse{
+		BlocksPerYear:         k.GetBlocksPerYear(ctx, params),
+		ObservedBlocksPerYear: observation.ObservedBlocksPerYear(),
+		AverageBlockInterval:  averageBlockInterval,
+		Samples:               observation.Samples,
+	}, nil
+}
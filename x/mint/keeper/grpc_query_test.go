@@ -10,6 +10,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -51,6 +52,150 @@ func (suite *MintTestSuite) TestGRPCParams() {
 	suite.Require().Equal(annualProvisions.AnnualProvisions, app.MintKeeper.GetMinter(ctx).AnnualProvisions)
 }
 
+func (suite *MintTestSuite) TestGRPCMunicipalInflation() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	inflation := types.NewMunicipalInflation(sdk.AccAddress("target______________").String(), sdk.NewDecWithPrec(1, 2))
+	inflation.Schedule = &types.MunicipalInflationSchedule{StartHeight: 10, EndHeight: 20}
+	inflations := []*types.MunicipalInflationPair{{Denom: "denom0", Inflation: inflation}}
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.MunicipalInflation = inflations
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	res, err := queryClient.MunicipalInflation(gocontext.Background(), &types.QueryMunicipalInflationRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(inflations, res.Inflations)
+	suite.Require().Len(res.Statuses, 1)
+	suite.Require().Equal("denom0", res.Statuses[0].Denom)
+	suite.Require().True(res.Statuses[0].CurrentRate.IsZero())
+	suite.Require().Equal(int64(10), res.Statuses[0].NextChangeHeight)
+	suite.Require().Equal(sdk.NewDecWithPrec(1, 2), res.Statuses[0].NextRate)
+
+	res, err = queryClient.MunicipalInflation(gocontext.Background(), &types.QueryMunicipalInflationRequest{
+		XDenom: &types.QueryMunicipalInflationRequest_Denom{Denom: "denom0"},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Statuses, 1)
+
+	_, err = queryClient.MunicipalInflation(gocontext.Background(), &types.QueryMunicipalInflationRequest{
+		XDenom: &types.QueryMunicipalInflationRequest_Denom{Denom: "unknown"},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *MintTestSuite) TestGRPCSupplyHeadroom() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	supply := app.BankKeeper.GetSupply(ctx, sdk.DefaultBondDenom).Amount
+	params := app.MintKeeper.GetParams(ctx)
+	params.MaxSupply = sdk.NewCoins(
+		sdk.NewCoin("denom0", sdk.NewInt(1000)),
+		sdk.NewCoin(sdk.DefaultBondDenom, supply.AddRaw(100)),
+	)
+	app.MintKeeper.SetParams(ctx, params)
+
+	res, err := queryClient.SupplyHeadroom(gocontext.Background(), &types.QuerySupplyHeadroomRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(res.Headrooms, 2)
+	suite.Require().Equal("denom0", res.Headrooms[0].Denom)
+	suite.Require().Equal(sdk.NewInt(1000), res.Headrooms[0].Headroom)
+
+	res, err = queryClient.SupplyHeadroom(gocontext.Background(), &types.QuerySupplyHeadroomRequest{
+		XDenom: &types.QuerySupplyHeadroomRequest_Denom{Denom: sdk.DefaultBondDenom},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal([]types.SupplyHeadroom{{
+		Denom:     sdk.DefaultBondDenom,
+		MaxSupply: supply.AddRaw(100),
+		Supply:    supply,
+		Headroom:  sdk.NewInt(100),
+	}}, res.Headrooms)
+
+	_, err = queryClient.SupplyHeadroom(gocontext.Background(), &types.QuerySupplyHeadroomRequest{
+		XDenom: &types.QuerySupplyHeadroomRequest_Denom{Denom: "unknown"},
+	})
+	suite.Require().Error(err)
+}
+
+func (suite *MintTestSuite) TestGRPCMintedTotal() {
+	app, ctx, queryClient := suite.app, suite.ctx.WithBlockHeight(10), suite.queryClient
+
+	recipient := sdk.AccAddress("recipient1__________").String()
+	app.MintKeeper.RecordMinted(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("denom0", 10), sdk.NewInt64Coin("denom1", 20)))
+	app.MintKeeper.RecordMinted(ctx, types.ModuleRecipient("distribution"), sdk.NewCoins(sdk.NewInt64Coin("denom0", 5)))
+
+	params := app.MintKeeper.GetParams(ctx)
+	params.CheckpointInterval = 5
+	app.MintKeeper.CheckpointIfNecessary(ctx, params)
+
+	total, err := queryClient.MintedTotal(gocontext.Background(), &types.QueryMintedTotalRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("denom0", 15)), total.Minted)
+	suite.Require().Equal(uint64(2), total.Pagination.Total)
+
+	minted, err := queryClient.RecipientMinted(gocontext.Background(), &types.QueryRecipientMintedRequest{Recipient: recipient})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("denom0", 10), sdk.NewInt64Coin("denom1", 20)), minted.Minted)
+
+	minted, err = queryClient.RecipientMinted(gocontext.Background(), &types.QueryRecipientMintedRequest{Recipient: "module:distribution"})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("denom0", 5)), minted.Minted)
+
+	_, err = queryClient.RecipientMinted(gocontext.Background(), &types.QueryRecipientMintedRequest{Recipient: "invalid"})
+	suite.Require().Error(err)
+
+	checkpoints, err := queryClient.MintCheckpoints(gocontext.Background(), &types.QueryMintCheckpointsRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Len(checkpoints.Checkpoints, 1)
+	suite.Require().Equal(int64(10), checkpoints.Checkpoints[0].Height)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("denom0", 15), sdk.NewInt64Coin("denom1", 20)), checkpoints.Checkpoints[0].Minted)
+}
+
+func (suite *MintTestSuite) TestGRPCBurnedTotal() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	app.MintKeeper.RecordBurned(ctx, sdk.NewCoins(sdk.NewInt64Coin("denom0", 10), sdk.NewInt64Coin("denom1", 20)))
+
+	total, err := queryClient.BurnedTotal(gocontext.Background(), &types.QueryBurnedTotalRequest{
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	suite.Require().NoError(err)
+	suite.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("denom0", 10)), total.Burned)
+	suite.Require().Equal(uint64(2), total.Pagination.Total)
+}
+
+func (suite *MintTestSuite) TestGRPCBlocksPerYear() {
+	app, ctx, queryClient := suite.app, suite.ctx, suite.queryClient
+
+	params := app.MintKeeper.GetParams(ctx)
+	res, err := queryClient.BlocksPerYear(gocontext.Background(), &types.QueryBlocksPerYearRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryBlocksPerYearResponse{
+		BlocksPerYear:        params.BlocksPerYear,
+		AverageBlockInterval: sdk.ZeroDec(),
+	}, res)
+
+	params.CalibrateBlocksPerYear = true
+	params.MinBlocksPerYear = types.SecondsPerYear / 2
+	app.MintKeeper.SetParams(ctx, params)
+	app.MintKeeper.SetBlockTimeObservation(ctx, types.BlockTimeObservation{
+		AverageBlockInterval: sdk.NewDec(4),
+		Samples:              720,
+		BlocksPerYear:        types.SecondsPerYear / 4,
+	})
+
+	res, err = queryClient.BlocksPerYear(gocontext.Background(), &types.QueryBlocksPerYearRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(&types.QueryBlocksPerYearResponse{
+		BlocksPerYear:         types.SecondsPerYear / 2,
+		ObservedBlocksPerYear: types.SecondsPerYear / 4,
+		AverageBlockInterval:  sdk.NewDec(4),
+		Samples:               720,
+	}, res)
+}
+
 func TestMintTestSuite(t *testing.T) {
 	suite.Run(t, new(MintTestSuite))
 }
@@ -0,0 +1,163 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// RegisterInvariants registers the mint module invariants
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "municipal-issuance", MunicipalIssuanceInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "municipal-targets", MunicipalTargetsInvariant(k))
+}
+
+// AllInvariants runs all invariants of the mint module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := MunicipalIssuanceInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return MunicipalTargetsInvariant(k)(ctx)
+	}
+}
+
+// MunicipalIssuanceInvariant checks that the amount of each denomination minted
+// by municipal inflation in the current block matches the issuance recalculated
+// from the stored minter & params: the per block inflation of the schedule
+// segment active in the block applied to the supply before the issuance, bounded
+// by the maximum supply. Only the amounts the issuance recorded as minted to its
+// targets are compared, as the supply may change in the same block for other
+// reasons, e.g. the block provision of the mint denomination or slashing.
+func MunicipalIssuanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		inflations := municipalInflationsByDenom(k.GetMinter(ctx))
+		params := k.GetParams(ctx)
+		blocksPerYear := k.GetBlocksPerYear(ctx, params)
+
+		k.IterateMunicipalIssuances(ctx, func(issuance types.MunicipalIssuance) bool {
+			expected, err := expectedMunicipalIssuance(ctx, inflations[issuance.Denom], params, blocksPerYear, issuance.Supply)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s %s\n", issuance.Denom, err)
+				return false
+			}
+
+			minted := sdk.ZeroInt()
+			for _, recipient := range issuance.Recipients {
+				minted = minted.Add(recipient.Minted)
+			}
+			if !minted.Equal(expected.AmountOf(issuance.Denom)) {
+				count++
+				msg += fmt.Sprintf("\t%s minted %s, expected %s\n", issuance.Denom, minted, expected.AmountOf(issuance.Denom))
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "municipal-issuance",
+			fmt.Sprintf("amount of invalid municipal issuances found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+// MunicipalTargetsInvariant checks that the amounts minted to municipal inflation
+// targets by the issuance of the current block match their shares of the
+// issuance recalculated from the stored minter & params.
+func MunicipalTargetsInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		var (
+			msg   string
+			count int
+		)
+
+		inflations := municipalInflationsByDenom(k.GetMinter(ctx))
+		params := k.GetParams(ctx)
+		blocksPerYear := k.GetBlocksPerYear(ctx, params)
+
+		k.IterateMunicipalIssuances(ctx, func(issuance types.MunicipalIssuance) bool {
+			inflation := inflations[issuance.Denom]
+			expected, err := expectedMunicipalIssuance(ctx, inflation, params, blocksPerYear, issuance.Supply)
+			if err != nil {
+				count++
+				msg += fmt.Sprintf("\t%s %s\n", issuance.Denom, err)
+				return false
+			}
+
+			targets := inflation.EffectiveTargets()
+			if len(targets) != len(issuance.Recipients) {
+				count++
+				msg += fmt.Sprintf("\t%s issued to %d recipients, expected %d\n", issuance.Denom, len(issuance.Recipients), len(targets))
+				return false
+			}
+
+			shares := inflation.SplitIssuance(expected)
+			for i, target := range targets {
+				recipient := issuance.Recipients[i]
+				if recipient.Recipient != target.Recipient() {
+					count++
+					msg += fmt.Sprintf("\t%s issued to %s, expected %s\n", issuance.Denom, recipient.Recipient, target.Recipient())
+					continue
+				}
+
+				if share := shares[i].AmountOf(issuance.Denom); !recipient.Minted.Equal(share) {
+					count++
+					msg += fmt.Sprintf("\t%s minted to %s %s, expected %s\n", issuance.Denom, recipient.Recipient, recipient.Minted, share)
+				}
+			}
+
+			return false
+		})
+
+		broken := count != 0
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "municipal-targets",
+			fmt.Sprintf("amount of municipal inflation targets with invalid minted amount found %d\n%s", count, msg),
+		), broken
+	}
+}
+
+func municipalInflationsByDenom(minter types.Minter) map[string]*types.MunicipalInflation {
+	inflations := make(map[string]*types.MunicipalInflation, len(minter.MunicipalInflation))
+	for _, pair := range minter.MunicipalInflation {
+		inflations[pair.Denom] = pair.Inflation
+	}
+
+	return inflations
+}
+
+// expectedMunicipalIssuance recalculates the municipal inflation issuance of the
+// current block from the supply of the denomination before the issuance.
+func expectedMunicipalIssuance(ctx sdk.Context, inflation *types.MunicipalInflation, params types.Params, blocksPerYear uint64, supply sdk.Coin) (sdk.Coins, error) {
+	if inflation == nil || inflation.IsBurn() {
+		return nil, fmt.Errorf("issued without municipal inflation in the mint mode")
+	}
+
+	segment := inflation.ActiveSegment(ctx.BlockHeight(), ctx.BlockTime())
+	if !segment.IsActive() {
+		return nil, fmt.Errorf("issued by inactive municipal inflation schedule")
+	}
+
+	perBlockInflation, err := types.CalculateInflationPerBlock(segment.Rate, blocksPerYear)
+	if err != nil {
+		return nil, err
+	}
+
+	expected := types.CalculateInflationIssuance(perBlockInflation, supply).AmountOf(supply.Denom)
+	if maxSupply, capped := params.MaxSupplyOf(supply.Denom); capped {
+		expected = sdk.MinInt(expected, sdk.MaxInt(maxSupply.Sub(supply.Amount), sdk.ZeroInt()))
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(supply.Denom, expected)), nil
+}
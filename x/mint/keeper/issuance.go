@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// SetMunicipalIssuance records the municipal inflation issuance of the current
+// block in the transient store.
+func (k Keeper) SetMunicipalIssuance(ctx sdk.Context, issuance types.MunicipalIssuance) {
+	store := ctx.TransientStore(k.tStoreKey)
+	store.Set(types.MunicipalIssuanceKey(issuance.Denom), k.cdc.MustMarshal(&issuance))
+}
+
+// IterateMunicipalIssuances iterates over municipal inflation issuances of the
+// current block, ordered by denomination. Iteration stops when the callback
+// returns true.
+func (k Keeper) IterateMunicipalIssuances(ctx sdk.Context, cb func(issuance types.MunicipalIssuance) (stop bool)) {
+	store := ctx.TransientStore(k.tStoreKey)
+	iterator := sdk.KVStorePrefixIterator(store, types.MunicipalIssuanceKeyPrefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var issuance types.MunicipalIssuance
+		k.cdc.MustUnmarshal(iterator.Value(), &issuance)
+
+		if cb(issuance) {
+			break
+		}
+	}
+}
@@ -5,6 +5,9 @@ import (
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/cache"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 )
@@ -13,17 +16,28 @@ import (
 type Keeper struct {
 	cdc              codec.BinaryCodec
 	storeKey         sdk.StoreKey
+	tStoreKey        sdk.StoreKey
 	paramSpace       paramtypes.Subspace
 	stakingKeeper    types.StakingKeeper
+	authKeeper       types.AccountKeeper
 	BankKeeper       types.BankKeeper
+	distrKeeper      types.DistributionKeeper
 	feeCollectorName string
+
+	// burnSourceModules are the treasury module accounts allowed to be the
+	// source of tokens burned by municipal inflation in the burn mode
+	burnSourceModules map[string]bool
+
+	// municipalInflationCache is shared by all copies of the keeper, it is
+	// rebuilt from the state whenever it does not match the state anymore
+	municipalInflationCache *cache.MunicipalInflationCache
 }
 
 // NewKeeper creates a new mint Keeper instance
 func NewKeeper(
-	cdc codec.BinaryCodec, key sdk.StoreKey, paramSpace paramtypes.Subspace,
+	cdc codec.BinaryCodec, key, tkey sdk.StoreKey, paramSpace paramtypes.Subspace,
 	sk types.StakingKeeper, ak types.AccountKeeper, bk types.BankKeeper,
-	feeCollectorName string,
+	dk types.DistributionKeeper, feeCollectorName string, burnSourceModules []string,
 ) Keeper {
 	// ensure mint module account is set
 	if addr := ak.GetModuleAddress(types.ModuleName); addr == nil {
@@ -35,13 +49,25 @@ func NewKeeper(
 		paramSpace = paramSpace.WithKeyTable(types.ParamKeyTable())
 	}
 
+	burnSources := make(map[string]bool, len(burnSourceModules))
+	for _, module := range burnSourceModules {
+		burnSources[module] = true
+	}
+
 	return Keeper{
 		cdc:              cdc,
 		storeKey:         key,
+		tStoreKey:        tkey,
 		paramSpace:       paramSpace,
 		stakingKeeper:    sk,
+		authKeeper:       ak,
 		BankKeeper:       bk,
+		distrKeeper:      dk,
 		feeCollectorName: feeCollectorName,
+
+		burnSourceModules: burnSources,
+
+		municipalInflationCache: cache.NewMunicipalInflationCache(),
 	}
 }
 
@@ -69,6 +95,31 @@ func (k Keeper) SetMinter(ctx sdk.Context, minter types.Minter) {
 	store.Set(types.MinterKey, b)
 }
 
+// RefreshMunicipalInflationCache rebuilds the municipal inflation cache from the
+// given inflations, unless it has already been built from them and no scheduled
+// change of the inflation rates is due in the current block. It must *NOT* be
+// called concurrently, what is guaranteed when called from block processing.
+func (k Keeper) RefreshMunicipalInflationCache(ctx sdk.Context, inflations *[]*types.MunicipalInflationPair, blocksPerYear uint64) *cache.MunicipalInflationSnapshot {
+	return k.municipalInflationCache.RefreshIfNecessary(inflations, blocksPerYear, ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// GetMunicipalInflationSnapshot returns municipal inflations of the state
+// referenced by the context with pre-calculated per block inflation rates. The
+// cache is used when it matches the state, otherwise the snapshot is built from
+// the state without modifying the cache, so it is safe for concurrent queries.
+func (k Keeper) GetMunicipalInflationSnapshot(ctx sdk.Context) *cache.MunicipalInflationSnapshot {
+	minter := k.GetMinter(ctx)
+	params := k.GetParams(ctx)
+
+	return k.municipalInflationCache.Snapshot(&minter.MunicipalInflation, k.GetBlocksPerYear(ctx, params), ctx.BlockHeight(), ctx.BlockTime())
+}
+
+// InvalidateMunicipalInflationCache drops the municipal inflation cache, so it
+// is rebuilt from the state by the next refresh.
+func (k Keeper) InvalidateMunicipalInflationCache() {
+	k.municipalInflationCache.Invalidate()
+}
+
 // GetParams returns the total set of minting parameters.
 func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.paramSpace.GetParamSet(ctx, &params)
@@ -103,8 +154,126 @@ func (k Keeper) MintCoins(ctx sdk.Context, newCoins sdk.Coins) error {
 	return k.BankKeeper.MintCoins(ctx, types.ModuleName, newCoins)
 }
 
+// GetSupplyHeadroom returns the remaining amount of the capped denomination which
+// can be minted before its supply reaches the given maximum supply.
+func (k Keeper) GetSupplyHeadroom(ctx sdk.Context, maxSupply sdk.Coin) types.SupplyHeadroom {
+	supply := k.BankKeeper.GetSupply(ctx, maxSupply.Denom).Amount
+
+	headroom := maxSupply.Amount.Sub(supply)
+	if headroom.IsNegative() {
+		headroom = sdk.ZeroInt()
+	}
+
+	return types.SupplyHeadroom{
+		Denom:     maxSupply.Denom,
+		MaxSupply: maxSupply.Amount,
+		Supply:    supply,
+		Headroom:  headroom,
+	}
+}
+
+// ClampToMaxSupply reduces the amounts of coins to be minted, so that the supply
+// of capped denominations does not exceed the maximum supply defined in params.
+// An event is emitted for each denomination which reaches its cap.
+func (k Keeper) ClampToMaxSupply(ctx sdk.Context, params types.Params, newCoins sdk.Coins) sdk.Coins {
+	clamped := make([]sdk.Coin, 0, len(newCoins))
+	for _, coin := range newCoins {
+		maxSupply, capped := params.MaxSupplyOf(coin.Denom)
+		if !capped {
+			clamped = append(clamped, coin)
+			continue
+		}
+
+		headroom := k.GetSupplyHeadroom(ctx, sdk.NewCoin(coin.Denom, maxSupply)).Headroom
+		if coin.Amount.LT(headroom) {
+			clamped = append(clamped, coin)
+			continue
+		}
+
+		if headroom.IsPositive() {
+			clamped = append(clamped, sdk.NewCoin(coin.Denom, headroom))
+
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeMaxSupplyReached,
+					sdk.NewAttribute(types.AttributeKeyDenom, coin.Denom),
+					sdk.NewAttribute(types.AttributeKeyMaxSupply, maxSupply.String()),
+				),
+			)
+		}
+	}
+
+	return sdk.NewCoins(clamped...)
+}
+
+// FeeCollectorName returns the name of the module account receiving the block
+// provisions.
+func (k Keeper) FeeCollectorName() string {
+	return k.feeCollectorName
+}
+
 // AddCollectedFees implements an alias call to the underlying supply keeper's
 // AddCollectedFees to be used in BeginBlocker.
 func (k Keeper) AddCollectedFees(ctx sdk.Context, fees sdk.Coins) error {
 	return k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, k.feeCollectorName, fees)
 }
+
+// SendMunicipalInflationShare sends the share of newly minted coins from the
+// mint module account to the given municipal inflation target. Shares sent to
+// the distribution module are added to the community pool.
+func (k Keeper) SendMunicipalInflationShare(ctx sdk.Context, target *types.MunicipalInflationTarget, share sdk.Coins) error {
+	if share.Empty() {
+		return nil
+	}
+
+	if len(target.Module) == 0 {
+		addr, err := sdk.AccAddressFromBech32(target.Address)
+		if err != nil {
+			return err
+		}
+
+		return k.BankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, share)
+	}
+
+	if target.Module == distrtypes.ModuleName && k.distrKeeper != nil {
+		return k.distrKeeper.FundCommunityPool(ctx, share, k.authKeeper.GetModuleAddress(types.ModuleName))
+	}
+
+	return k.BankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, target.Module, share)
+}
+
+// ValidateMunicipalInflationTargets performs stateful validation of municipal
+// inflation recipients: module accounts must exist and accounts must be allowed
+// to receive funds. Only the treasury module accounts the keeper has been
+// configured with can be the source of burned tokens, as balances of other
+// module accounts back e.g. the staked tokens, deposits, rewards or fees, and
+// balances of other accounts belong to their holders.
+func (k Keeper) ValidateMunicipalInflationTargets(inflations []*types.MunicipalInflationPair) error {
+	for _, pair := range inflations {
+		for _, target := range pair.Inflation.EffectiveTargets() {
+			if len(target.Module) > 0 {
+				if k.authKeeper.GetModuleAddress(target.Module) == nil {
+					return sdkerrors.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", target.Module)
+				}
+				if pair.Inflation.IsBurn() && !k.burnSourceModules[target.Module] {
+					return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "module account %s cannot be the source of burned tokens", target.Module)
+				}
+				continue
+			}
+
+			if pair.Inflation.IsBurn() {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s cannot be the source of burned tokens, use a treasury module account instead", target.Address)
+			}
+
+			addr, err := sdk.AccAddressFromBech32(target.Address)
+			if err != nil {
+				return err
+			}
+			if k.BankKeeper.BlockedAddr(addr) {
+				return sdkerrors.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds, use module target instead", target.Address)
+			}
+		}
+	}
+
+	return nil
+}
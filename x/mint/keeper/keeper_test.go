@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestMunicipalInflationCacheIsOwnedByKeeper(t *testing.T) {
+	app1, ctx1 := createTestApp(false)
+	app2, ctx2 := createTestApp(false)
+
+	target := sdk.AccAddress("target______________").String()
+	minter := app1.MintKeeper.GetMinter(ctx1)
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(target, sdk.NewDecWithPrec(1, 2))},
+	}
+	app1.MintKeeper.SetMinter(ctx1, minter)
+	params := app1.MintKeeper.GetParams(ctx1)
+	require.NotNil(t, app1.MintKeeper.RefreshMunicipalInflationCache(ctx1, &minter.MunicipalInflation, params.BlocksPerYear).GetInflation("denom0"))
+
+	// the cache of one app must not leak into another one
+	require.Nil(t, app2.MintKeeper.GetMunicipalInflationSnapshot(ctx2).GetInflation("denom0"))
+}
+
+func TestMunicipalInflationCacheFollowsState(t *testing.T) {
+	app, ctx := createTestApp(false)
+	k := app.MintKeeper
+
+	target := sdk.AccAddress("target______________").String()
+	minter := k.GetMinter(ctx)
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(target, sdk.NewDecWithPrec(1, 2))},
+	}
+	k.SetMinter(ctx, minter)
+	params := k.GetParams(ctx)
+	cached := k.RefreshMunicipalInflationCache(ctx, &minter.MunicipalInflation, params.BlocksPerYear)
+	require.Same(t, cached, k.GetMunicipalInflationSnapshot(ctx))
+
+	// minter changed directly in the store, e.g. by a discarded block or a restart
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(target, sdk.NewDecWithPrec(5, 2))},
+	}
+	k.SetMinter(ctx, minter)
+	snapshot := k.GetMunicipalInflationSnapshot(ctx)
+	require.NotSame(t, cached, snapshot)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), snapshot.GetInflation("denom0").AnnualInflation.Value)
+
+	// params change invalidates the cache as well
+	cached = k.RefreshMunicipalInflationCache(ctx, &minter.MunicipalInflation, params.BlocksPerYear)
+	params.BlocksPerYear /= 2
+	k.SetParams(ctx, params)
+	snapshot = k.GetMunicipalInflationSnapshot(ctx)
+	require.NotSame(t, cached, snapshot)
+	require.True(t, snapshot.GetInflation("denom0").PerBlockInflation.GT(cached.GetInflation("denom0").PerBlockInflation))
+
+}
@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates from version 1 to 2. It introduces the MaxSupply param,
+// which is set to empty value, so no denomination is capped.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxSupply, sdk.Coins{})
+	return nil
+}
+
+// Migrate2to3 migrates from version 2 to 3. It introduces the InflationMode
+// param, set to the fixed inflation mode, so the inflation rate is unchanged,
+// together with default params of the bonded ratio inflation mode.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationMode, types.InflationModeFixed)
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationRateChange, defaultParams.InflationRateChange)
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationMax, defaultParams.InflationMax)
+	m.keeper.paramSpace.Set(ctx, types.KeyInflationMin, defaultParams.InflationMin)
+	m.keeper.paramSpace.Set(ctx, types.KeyGoalBonded, defaultParams.GoalBonded)
+	return nil
+}
+
+// Migrate3to4 migrates from version 3 to 4. It introduces the CheckpointInterval
+// param, set to the default value. Total minted amounts are accounted from the
+// upgrade height on.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	m.keeper.paramSpace.Set(ctx, types.KeyCheckpointInterval, types.DefaultParams().CheckpointInterval)
+	return nil
+}
+
+// Migrate4to5 migrates from version 4 to 5. It introduces params of the blocks
+// per year calibration, which is disabled, so the BlocksPerYear param is used
+// unchanged, with default bounds and calibration window.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	defaultParams := types.DefaultParams()
+
+	m.keeper.paramSpace.Set(ctx, types.KeyCalibrateBlocksPerYear, false)
+	m.keeper.paramSpace.Set(ctx, types.KeyMinBlocksPerYear, defaultParams.MinBlocksPerYear)
+	m.keeper.paramSpace.Set(ctx, types.KeyMaxBlocksPerYear, defaultParams.MaxBlocksPerYear)
+	m.keeper.paramSpace.Set(ctx, types.KeyCalibrationWindow, defaultParams.CalibrationWindow)
+	return nil
+}
+
+// Migrate5to6 migrates from version 5 to 6. It grants the burner permission to
+// the mint module account, which burns tokens of municipal inflation in burn
+// mode. Accounts created at genesis keep the permissions they were created with.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	acc, ok := m.keeper.authKeeper.GetModuleAccount(ctx, types.ModuleName).(*authtypes.ModuleAccount)
+	if !ok {
+		return fmt.Errorf("unexpected type of the %s module account", types.ModuleName)
+	}
+
+	if !acc.HasPermission(authtypes.Burner) {
+		acc.Permissions = append(acc.Permissions, authtypes.Burner)
+		m.keeper.authKeeper.SetModuleAccount(ctx, acc)
+	}
+	return nil
+}
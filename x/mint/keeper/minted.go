@@ -0,0 +1,230 @@
+package keeper
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// RecordMinted adds the newly minted coins to the total minted amounts of their
+// denominations and to the total minted amounts of the given recipient.
+func (k Keeper) RecordMinted(ctx sdk.Context, recipient string, minted sdk.Coins) {
+	store := ctx.KVStore(k.storeKey)
+	totalStore := prefix.NewStore(store, types.MintedTotalKeyPrefix)
+	recipientStore := prefix.NewStore(store, types.RecipientMintedKey(recipient))
+
+	for _, coin := range minted {
+		addAmount(totalStore, coin)
+		addAmount(recipientStore, coin)
+	}
+}
+
+// GetMintedTotal returns the total amount of the denomination minted so far.
+func (k Keeper) GetMintedTotal(ctx sdk.Context, denom string) sdk.Coin {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintedTotalKeyPrefix)
+	return sdk.NewCoin(denom, getAmount(store, denom))
+}
+
+// GetAllMintedTotal returns the total amounts of all denominations minted so far.
+func (k Keeper) GetAllMintedTotal(ctx sdk.Context) sdk.Coins {
+	minted, _, err := k.GetPaginatedMintedTotal(ctx, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	return minted
+}
+
+// GetPaginatedMintedTotal queries for the total minted amounts of denominations
+// with a given pagination.
+func (k Keeper) GetPaginatedMintedTotal(ctx sdk.Context, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintedTotalKeyPrefix)
+	return paginateAmounts(store, pagination)
+}
+
+// SetMintedTotal sets the total minted amount of the coin denomination.
+func (k Keeper) SetMintedTotal(ctx sdk.Context, minted sdk.Coin) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintedTotalKeyPrefix)
+	setAmount(store, minted)
+}
+
+// GetRecipientMinted returns the total amounts of all denominations minted to
+// the recipient so far.
+func (k Keeper) GetRecipientMinted(ctx sdk.Context, recipient string) sdk.Coins {
+	minted, _, err := k.GetPaginatedRecipientMinted(ctx, recipient, &query.PageRequest{Limit: query.MaxLimit})
+	if err != nil {
+		panic(err)
+	}
+
+	return minted
+}
+
+// GetRecipientMintedOf returns the total amount of the denomination minted to
+// the recipient so far.
+func (k Keeper) GetRecipientMintedOf(ctx sdk.Context, recipient string, denom string) sdk.Int {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecipientMintedKey(recipient))
+	return getAmount(store, denom)
+}
+
+// GetPaginatedRecipientMinted queries for the total minted amounts of the
+// recipient with a given pagination.
+func (k Keeper) GetPaginatedRecipientMinted(ctx sdk.Context, recipient string, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecipientMintedKey(recipient))
+	return paginateAmounts(store, pagination)
+}
+
+// SetRecipientMinted sets the total minted amounts of the recipient.
+func (k Keeper) SetRecipientMinted(ctx sdk.Context, recipientMinted types.RecipientMinted) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecipientMintedKey(recipientMinted.Recipient))
+	for _, coin := range recipientMinted.Minted {
+		setAmount(store, coin)
+	}
+}
+
+// GetAllRecipientsMinted returns the total minted amounts of all recipients.
+func (k Keeper) GetAllRecipientsMinted(ctx sdk.Context) []types.RecipientMinted {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.RecipientMintedKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var recipientsMinted []types.RecipientMinted
+	for ; iterator.Valid(); iterator.Next() {
+		key := iterator.Key()
+		recipientLen := int(key[0])
+		recipient := string(key[1 : 1+recipientLen])
+		denom := string(key[1+recipientLen:])
+
+		var amount sdk.Int
+		if err := amount.Unmarshal(iterator.Value()); err != nil {
+			panic(fmt.Errorf("unable to unmarshal minted amount value %v", err))
+		}
+
+		last := len(recipientsMinted) - 1
+		if last < 0 || recipientsMinted[last].Recipient != recipient {
+			recipientsMinted = append(recipientsMinted, types.RecipientMinted{Recipient: recipient})
+			last++
+		}
+		recipientsMinted[last].Minted = recipientsMinted[last].Minted.Add(sdk.NewCoin(denom, amount))
+	}
+
+	return recipientsMinted
+}
+
+// CheckpointIfNecessary records the checkpoint of the total minted amounts, if
+// the current block height is a multiple of the checkpoint interval.
+func (k Keeper) CheckpointIfNecessary(ctx sdk.Context, params types.Params) {
+	if params.CheckpointInterval == 0 || uint64(ctx.BlockHeight())%params.CheckpointInterval != 0 {
+		return
+	}
+
+	k.SetMintCheckpoint(ctx, types.MintCheckpoint{
+		Height: ctx.BlockHeight(),
+		Time:   ctx.BlockTime(),
+		Minted: k.GetAllMintedTotal(ctx),
+	})
+}
+
+// GetMintCheckpoint returns the checkpoint recorded at the given height.
+func (k Keeper) GetMintCheckpoint(ctx sdk.Context, height int64) (checkpoint types.MintCheckpoint, found bool) {
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.MintCheckpointKey(height))
+	if bz == nil {
+		return checkpoint, false
+	}
+
+	k.cdc.MustUnmarshal(bz, &checkpoint)
+	return checkpoint, true
+}
+
+// SetMintCheckpoint stores the checkpoint of the total minted amounts.
+func (k Keeper) SetMintCheckpoint(ctx sdk.Context, checkpoint types.MintCheckpoint) {
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.MintCheckpointKey(checkpoint.Height), k.cdc.MustMarshal(&checkpoint))
+}
+
+// GetPaginatedMintCheckpoints queries for the checkpoints ordered by height
+// with a given pagination.
+func (k Keeper) GetPaginatedMintCheckpoints(ctx sdk.Context, pagination *query.PageRequest) ([]types.MintCheckpoint, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintCheckpointKeyPrefix)
+
+	var checkpoints []types.MintCheckpoint
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var checkpoint types.MintCheckpoint
+		if err := k.cdc.Unmarshal(value, &checkpoint); err != nil {
+			return err
+		}
+
+		checkpoints = append(checkpoints, checkpoint)
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return checkpoints, pageRes, nil
+}
+
+// GetAllMintCheckpoints returns all checkpoints ordered by height.
+func (k Keeper) GetAllMintCheckpoints(ctx sdk.Context) []types.MintCheckpoint {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.MintCheckpointKeyPrefix)
+	iterator := store.Iterator(nil, nil)
+	defer iterator.Close()
+
+	var checkpoints []types.MintCheckpoint
+	for ; iterator.Valid(); iterator.Next() {
+		var checkpoint types.MintCheckpoint
+		k.cdc.MustUnmarshal(iterator.Value(), &checkpoint)
+		checkpoints = append(checkpoints, checkpoint)
+	}
+
+	return checkpoints
+}
+
+func getAmount(store sdk.KVStore, denom string) sdk.Int {
+	bz := store.Get([]byte(denom))
+	if bz == nil {
+		return sdk.ZeroInt()
+	}
+
+	var amount sdk.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		panic(fmt.Errorf("unable to unmarshal minted amount value %v", err))
+	}
+
+	return amount
+}
+
+func setAmount(store sdk.KVStore, coin sdk.Coin) {
+	bz, err := coin.Amount.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	store.Set([]byte(coin.Denom), bz)
+}
+
+func addAmount(store sdk.KVStore, coin sdk.Coin) {
+	setAmount(store, sdk.NewCoin(coin.Denom, getAmount(store, coin.Denom).Add(coin.Amount)))
+}
+
+func paginateAmounts(store sdk.KVStore, pagination *query.PageRequest) (sdk.Coins, *query.PageResponse, error) {
+	minted := sdk.NewCoins()
+
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var amount sdk.Int
+		if err := amount.Unmarshal(value); err != nil {
+			return fmt.Errorf("unable to convert amount string to Int %v", err)
+		}
+
+		minted = minted.Add(sdk.NewCoin(string(key), amount))
+		return nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return minted, pageRes, nil
+}
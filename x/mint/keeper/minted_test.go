@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestRecordMinted(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.MintKeeper
+
+	recipient1 := sdk.AccAddress("recipient1__________").String()
+	recipient2 := types.ModuleRecipient("distribution")
+
+	k.RecordMinted(ctx, recipient1, sdk.NewCoins(sdk.NewInt64Coin("denom0", 10), sdk.NewInt64Coin("denom1", 5)))
+	k.RecordMinted(ctx, recipient2, sdk.NewCoins(sdk.NewInt64Coin("denom0", 20)))
+	k.RecordMinted(ctx, recipient1, sdk.NewCoins(sdk.NewInt64Coin("denom0", 1)))
+
+	require.Equal(t, sdk.NewInt64Coin("denom0", 31), k.GetMintedTotal(ctx, "denom0"))
+	require.Equal(t, sdk.NewInt64Coin("denom2", 0), k.GetMintedTotal(ctx, "denom2"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom0", 31), sdk.NewInt64Coin("denom1", 5)), k.GetAllMintedTotal(ctx))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom0", 11), sdk.NewInt64Coin("denom1", 5)), k.GetRecipientMinted(ctx, recipient1))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom0", 20)), k.GetRecipientMinted(ctx, recipient2))
+	require.Len(t, k.GetAllRecipientsMinted(ctx), 2)
+}
+
+func TestMintCheckpoints(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	k := app.MintKeeper
+
+	params := k.GetParams(ctx)
+	params.CheckpointInterval = 10
+	k.SetParams(ctx, params)
+
+	k.RecordMinted(ctx, types.ModuleRecipient("fee_collector"), sdk.NewCoins(sdk.NewInt64Coin("denom0", 10)))
+
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	for height := int64(1); height <= 25; height++ {
+		k.CheckpointIfNecessary(ctx.WithBlockHeight(height).WithBlockTime(blockTime), params)
+	}
+
+	checkpoints := k.GetAllMintCheckpoints(ctx)
+	require.Len(t, checkpoints, 2)
+	require.Equal(t, int64(10), checkpoints[0].Height)
+	require.Equal(t, int64(20), checkpoints[1].Height)
+	require.Equal(t, blockTime, checkpoints[1].Time)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom0", 10)), checkpoints[1].Minted)
+
+	_, found := k.GetMintCheckpoint(ctx, 15)
+	require.False(t, found)
+}
+
+func TestMintedAccountingGenesis(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{}).WithBlockHeight(10)
+	k := app.MintKeeper
+
+	params := k.GetParams(ctx)
+	params.CheckpointInterval = 10
+	k.SetParams(ctx, params)
+
+	recipient := sdk.AccAddress("recipient1__________").String()
+	k.RecordMinted(ctx, recipient, sdk.NewCoins(sdk.NewInt64Coin("denom0", 10)))
+	k.CheckpointIfNecessary(ctx, params)
+
+	genesis := mint.ExportGenesis(ctx, k)
+	require.NoError(t, types.ValidateGenesis(*genesis))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom0", 10)), genesis.MintedTotal)
+	require.Equal(t, []types.RecipientMinted{{Recipient: recipient, Minted: sdk.NewCoins(sdk.NewInt64Coin("denom0", 10))}}, genesis.RecipientsMinted)
+	require.Len(t, genesis.Checkpoints, 1)
+
+	app2 := simapp.Setup(false)
+	ctx2 := app2.BaseApp.NewContext(false, tmproto.Header{})
+	mint.InitGenesis(ctx2, app2.MintKeeper, app2.AccountKeeper, genesis)
+	require.Equal(t, genesis, mint.ExportGenesis(ctx2, app2.MintKeeper))
+}
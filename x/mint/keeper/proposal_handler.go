@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+// HandleUpdateMunicipalInflationProposal is a handler for executing a passed municipal inflation update proposal
+func HandleUpdateMunicipalInflationProposal(ctx sdk.Context, k Keeper, p *types.UpdateMunicipalInflationProposal) error {
+	minter := k.GetMinter(ctx)
+	inflations := types.UpdateMunicipalInflations(minter.MunicipalInflation, p.MunicipalInflation)
+
+	if err := k.setMunicipalInflations(ctx, minter, inflations); err != nil {
+		return err
+	}
+
+	for _, pair := range p.MunicipalInflation {
+		attrs := []sdk.Attribute{
+			sdk.NewAttribute(types.AttributeKeyDenom, pair.Denom),
+			sdk.NewAttribute(types.AttributeKeyInflation, pair.Inflation.Value.String()),
+		}
+		for _, target := range pair.Inflation.EffectiveTargets() {
+			attrs = append(attrs, target.Attributes()...)
+		}
+
+		ctx.EventManager().EmitEvent(sdk.NewEvent(types.EventTypeUpdateMunicipalInflation, attrs...))
+	}
+
+	k.Logger(ctx).Info("updated municipal inflation", "denoms", len(p.MunicipalInflation))
+
+	return nil
+}
+
+// HandleRemoveMunicipalInflationProposal is a handler for executing a passed municipal inflation removal proposal
+func HandleRemoveMunicipalInflationProposal(ctx sdk.Context, k Keeper, p *types.RemoveMunicipalInflationProposal) error {
+	minter := k.GetMinter(ctx)
+	inflations, err := types.RemoveMunicipalInflations(minter.MunicipalInflation, p.Denoms)
+	if err != nil {
+		return err
+	}
+
+	if err := k.setMunicipalInflations(ctx, minter, inflations); err != nil {
+		return err
+	}
+
+	for _, denom := range p.Denoms {
+		ctx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeRemoveMunicipalInflation,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+			),
+		)
+	}
+
+	k.Logger(ctx).Info("removed municipal inflation", "denoms", p.Denoms)
+
+	return nil
+}
+
+// setMunicipalInflations validates the given inflations, stores them in the
+// minter and refreshes the municipal inflation cache.
+func (k Keeper) setMunicipalInflations(ctx sdk.Context, minter types.Minter, inflations []*types.MunicipalInflationPair) error {
+	if err := types.ValidateMunicipalInflations(&inflations); err != nil {
+		return err
+	}
+	if err := k.ValidateMunicipalInflationTargets(inflations); err != nil {
+		return err
+	}
+
+	minter.MunicipalInflation = inflations
+	k.SetMinter(ctx, minter)
+
+	params := k.GetParams(ctx)
+	k.RefreshMunicipalInflationCache(ctx, &minter.MunicipalInflation, k.GetBlocksPerYear(ctx, params))
+
+	return nil
+}
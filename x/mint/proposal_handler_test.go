@@ -0,0 +1,89 @@
+package mint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	"github.com/cosmos/cosmos-sdk/simapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+var (
+	targetAddr1 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+	targetAddr2 = sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address()).String()
+)
+
+func TestUpdateMunicipalInflationProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	hdlr := mint.NewMunicipalInflationProposalHandler(app.MintKeeper)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(targetAddr1, sdk.NewDecWithPrec(1, 2))},
+	}
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	// change existing entry and add a new one
+	tp := types.NewUpdateMunicipalInflationProposal("Test", "description", []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(targetAddr2, sdk.NewDecWithPrec(5, 2))},
+		{Denom: "denom1", Inflation: types.NewMunicipalInflation(targetAddr1, sdk.NewDecWithPrec(3, 2))},
+	})
+	require.NoError(t, tp.ValidateBasic())
+	require.NoError(t, hdlr(ctx, tp))
+
+	inflations := app.MintKeeper.GetMinter(ctx).MunicipalInflation
+	require.Len(t, inflations, 2)
+	require.Equal(t, "denom0", inflations[0].Denom)
+	require.Equal(t, targetAddr2, inflations[0].Inflation.TargetAddress)
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), inflations[0].Inflation.Value)
+	require.Equal(t, "denom1", inflations[1].Denom)
+
+	cached := app.MintKeeper.GetMunicipalInflationSnapshot(ctx).GetInflation("denom1")
+	require.NotNil(t, cached)
+	require.Equal(t, sdk.NewDecWithPrec(3, 2), cached.AnnualInflation.Value)
+
+	// invalid entry must be rejected without modifying the minter
+	tp = types.NewUpdateMunicipalInflationProposal("Test", "description", []*types.MunicipalInflationPair{
+		{Denom: "denom2", Inflation: types.NewMunicipalInflation("invalid", sdk.NewDecWithPrec(3, 2))},
+	})
+	require.Error(t, tp.ValidateBasic())
+	require.Error(t, hdlr(ctx, tp))
+	require.Len(t, app.MintKeeper.GetMinter(ctx).MunicipalInflation, 2)
+}
+
+func TestRemoveMunicipalInflationProposalHandler(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	hdlr := mint.NewMunicipalInflationProposalHandler(app.MintKeeper)
+
+	minter := app.MintKeeper.GetMinter(ctx)
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{Denom: "denom0", Inflation: types.NewMunicipalInflation(targetAddr1, sdk.NewDecWithPrec(1, 2))},
+		{Denom: "denom1", Inflation: types.NewMunicipalInflation(targetAddr2, sdk.NewDecWithPrec(2, 2))},
+	}
+	app.MintKeeper.SetMinter(ctx, minter)
+
+	// unknown denomination
+	tp := types.NewRemoveMunicipalInflationProposal("Test", "description", []string{"denom0", "unknown"})
+	require.NoError(t, tp.ValidateBasic())
+	require.ErrorIs(t, hdlr(ctx, tp), types.ErrUnknownMunicipalInflationDenom)
+	require.Len(t, app.MintKeeper.GetMinter(ctx).MunicipalInflation, 2)
+
+	tp = types.NewRemoveMunicipalInflationProposal("Test", "description", []string{"denom0"})
+	require.NoError(t, hdlr(ctx, tp))
+
+	inflations := app.MintKeeper.GetMinter(ctx).MunicipalInflation
+	require.Len(t, inflations, 1)
+	require.Equal(t, "denom1", inflations[0].Denom)
+	require.Nil(t, app.MintKeeper.GetMunicipalInflationSnapshot(ctx).GetInflation("denom0"))
+
+	// empty and duplicated denominations are rejected
+	require.Error(t, types.NewRemoveMunicipalInflationProposal("Test", "description", nil).ValidateBasic())
+	require.Error(t, types.NewRemoveMunicipalInflationProposal("Test", "description", []string{"denom1", "denom1"}).ValidateBasic())
+}
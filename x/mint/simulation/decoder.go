@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/kv"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
@@ -19,6 +20,26 @@ func NewDecodeStore(cdc codec.Codec) func(kvA, kvB kv.Pair) string {
 			cdc.MustUnmarshal(kvA.Value, &minterA)
 			cdc.MustUnmarshal(kvB.Value, &minterB)
 			return fmt.Sprintf("%v\n%v", minterA, minterB)
+		case bytes.HasPrefix(kvA.Key, types.MintedTotalKeyPrefix), bytes.HasPrefix(kvA.Key, types.RecipientMintedKeyPrefix),
+			bytes.HasPrefix(kvA.Key, types.BurnedTotalKeyPrefix):
+			var amountA, amountB sdk.Int
+			if err := amountA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := amountB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", amountA, amountB)
+		case bytes.HasPrefix(kvA.Key, types.MintCheckpointKeyPrefix):
+			var checkpointA, checkpointB types.MintCheckpoint
+			cdc.MustUnmarshal(kvA.Value, &checkpointA)
+			cdc.MustUnmarshal(kvB.Value, &checkpointB)
+			return fmt.Sprintf("%v\n%v", checkpointA, checkpointB)
+		case bytes.Equal(kvA.Key, types.BlockTimeObservationKey):
+			var observationA, observationB types.BlockTimeObservation
+			cdc.MustUnmarshal(kvA.Value, &observationA)
+			cdc.MustUnmarshal(kvB.Value, &observationB)
+			return fmt.Sprintf("%v\n%v", observationA, observationB)
 		default:
 			panic(fmt.Sprintf("invalid mint key %X", kvA.Key))
 		}
@@ -18,10 +18,19 @@ func TestDecodeStore(t *testing.T) {
 	dec := simulation.NewDecodeStore(cdc)
 
 	minter := types.NewMinter(sdk.OneDec(), sdk.NewDec(15), nil)
+	minted := sdk.NewInt(1000)
+	mintedBz, err := minted.Marshal()
+	require.NoError(t, err)
+	checkpoint := types.MintCheckpoint{Height: 10, Minted: sdk.NewCoins(sdk.NewCoin("stake", minted))}
+	observation := types.BlockTimeObservation{AverageBlockInterval: sdk.NewDec(5), Samples: 3, BlocksPerYear: 6311520}
 
 	kvPairs := kv.Pairs{
 		Pairs: []kv.Pair{
 			{Key: types.MinterKey, Value: cdc.MustMarshal(&minter)},
+			{Key: append(types.MintedTotalKeyPrefix, []byte("stake")...), Value: mintedBz},
+			{Key: types.MintCheckpointKey(10), Value: cdc.MustMarshal(&checkpoint)},
+			{Key: types.BlockTimeObservationKey, Value: cdc.MustMarshal(&observation)},
+			{Key: append(types.BurnedTotalKeyPrefix, []byte("stake")...), Value: mintedBz},
 			{Key: []byte{0x99}, Value: []byte{0x99}},
 		},
 	}
@@ -30,6 +39,10 @@ func TestDecodeStore(t *testing.T) {
 		expectedLog string
 	}{
 		{"Minter", fmt.Sprintf("%v\n%v", minter, minter)},
+		{"MintedTotal", fmt.Sprintf("%v\n%v", minted, minted)},
+		{"MintCheckpoint", fmt.Sprintf("%v\n%v", checkpoint, checkpoint)},
+		{"BlockTimeObservation", fmt.Sprintf("%v\n%v", observation, observation)},
+		{"BurnedTotal", fmt.Sprintf("%v\n%v", minted, minted)},
 		{"other", ""},
 	}
 
@@ -11,6 +11,8 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 )
 
@@ -18,6 +20,12 @@ import (
 const (
 	Inflation     = "inflation"
 	InflationRate = "inflation_rate"
+	InflationMode = "inflation_mode"
+
+	CheckpointInterval = "checkpoint_interval"
+
+	CalibrateBlocksPerYear = "calibrate_blocks_per_year"
+	CalibrationWindow      = "calibration_window"
 )
 
 // GenInflation randomized Inflation
@@ -30,6 +38,26 @@ func GenInflationRate(r *rand.Rand) sdk.Dec {
 	return sdk.NewDecWithPrec(int64(r.Intn(99)), 2)
 }
 
+// GenInflationMode randomized InflationMode
+func GenInflationMode(r *rand.Rand) types.InflationMode {
+	return types.InflationMode(r.Intn(len(types.InflationMode_name)))
+}
+
+// GenCheckpointInterval randomized CheckpointInterval
+func GenCheckpointInterval(r *rand.Rand) uint64 {
+	return uint64(r.Intn(100))
+}
+
+// GenCalibrateBlocksPerYear randomized CalibrateBlocksPerYear
+func GenCalibrateBlocksPerYear(r *rand.Rand) bool {
+	return r.Intn(2) == 0
+}
+
+// GenCalibrationWindow randomized CalibrationWindow
+func GenCalibrationWindow(r *rand.Rand) uint64 {
+	return uint64(r.Intn(20) + 1)
+}
+
 // GenMunicipalInflation randomized Municipal Inflation configuration
 func GenMunicipalInflation(simState *module.SimulationState) []*types.MunicipalInflationPair {
 	r := simState.Rand
@@ -51,14 +79,100 @@ func GenMunicipalInflation(simState *module.SimulationState) []*types.MunicipalI
 		coins[rndIdx] = coins[lastIdx]
 		coins = coins[:lastIdx]
 
-		acc := &simState.Accounts[r.Intn(len(simState.Accounts))]
-		infl := sdk.NewDecWithPrec(r.Int63n(201), 2)
-		municipalInflation[i] = &types.MunicipalInflationPair{Denom: c.Denom, Inflation: types.NewMunicipalInflation(acc.Address.String(), infl)}
+		municipalInflation[i] = &types.MunicipalInflationPair{Denom: c.Denom, Inflation: GenMunicipalInflationValue(r, simState.Accounts)}
 	}
 
 	return municipalInflation
 }
 
+// GenMunicipalInflationValue randomized municipal inflation of a single denomination,
+// sent either to a single account or split between weighted accounts and the
+// community pool, with an optional schedule. Occasionally the inflation is in
+// the burn mode instead.
+func GenMunicipalInflationValue(r *rand.Rand, accs []simtypes.Account) *types.MunicipalInflation {
+	if r.Intn(5) == 0 {
+		return GenMunicipalBurnInflation(r)
+	}
+
+	infl := sdk.NewDecWithPrec(r.Int63n(201), 2)
+
+	var inflation *types.MunicipalInflation
+	if r.Intn(2) == 0 {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		inflation = types.NewMunicipalInflation(acc.Address.String(), infl)
+	} else {
+		inflation = types.NewWeightedMunicipalInflation(infl, GenMunicipalInflationTargets(r, accs)...)
+	}
+
+	if r.Intn(3) == 0 {
+		inflation.Schedule = GenMunicipalInflationSchedule(r)
+	}
+
+	return inflation
+}
+
+// GenMunicipalBurnInflation randomized municipal inflation in the burn mode,
+// burning tokens held by the mint module account, with an optional schedule
+func GenMunicipalBurnInflation(r *rand.Rand) *types.MunicipalInflation {
+	inflation := types.NewMunicipalBurnInflation(types.ModuleName, sdk.NewDecWithPrec(r.Int63n(100), 2).Neg())
+
+	if r.Intn(3) == 0 {
+		inflation.Schedule = GenMunicipalInflationSchedule(r)
+		for i := range inflation.Schedule.Steps {
+			step := &inflation.Schedule.Steps[i]
+			step.Value = sdk.MinDec(step.Value, sdk.NewDecWithPrec(99, 2)).Neg()
+		}
+	}
+
+	return inflation
+}
+
+// GenMunicipalInflationTargets randomized weighted recipients of municipal inflation,
+// with weights adding up to one
+func GenMunicipalInflationTargets(r *rand.Rand, accs []simtypes.Account) []*types.MunicipalInflationTarget {
+	recipients := r.Intn(3) + 1
+	used := map[string]bool{}
+	targets := make([]*types.MunicipalInflationTarget, 0, recipients+1)
+
+	// split one hundred percent into positive weights
+	remaining := int64(100)
+	for i := 0; i < recipients && remaining > 1; i++ {
+		acc, _ := simtypes.RandomAcc(r, accs)
+		if used[acc.Address.String()] {
+			continue
+		}
+		used[acc.Address.String()] = true
+
+		weight := r.Int63n(remaining-1) + 1
+		remaining -= weight
+		targets = append(targets, types.NewMunicipalInflationAddressTarget(acc.Address.String(), sdk.NewDecWithPrec(weight, 2)))
+	}
+
+	return append(targets, types.NewMunicipalInflationModuleTarget(distrtypes.ModuleName, sdk.NewDecWithPrec(remaining, 2)))
+}
+
+// GenMunicipalInflationSchedule randomized height bounded schedule of municipal
+// inflation, with an optional step and decay
+func GenMunicipalInflationSchedule(r *rand.Rand) *types.MunicipalInflationSchedule {
+	schedule := &types.MunicipalInflationSchedule{StartHeight: r.Int63n(50)}
+
+	if r.Intn(2) == 0 {
+		schedule.EndHeight = schedule.StartHeight + r.Int63n(500) + 1
+	}
+	if r.Intn(2) == 0 {
+		schedule.Steps = []types.MunicipalInflationStep{{
+			Height: schedule.StartHeight + r.Int63n(100) + 1,
+			Value:  sdk.NewDecWithPrec(r.Int63n(201), 2),
+		}}
+	}
+	if r.Intn(2) == 0 {
+		schedule.DecayPeriod = uint64(r.Intn(100) + 1)
+		schedule.DecayFactor = sdk.NewDecWithPrec(r.Int63n(100)+1, 2)
+	}
+
+	return schedule
+}
+
 // RandomizedGenState generates a random GenesisState for mint
 func RandomizedGenState(simState *module.SimulationState) {
 	// minter
@@ -77,10 +191,41 @@ func RandomizedGenState(simState *module.SimulationState) {
 
 	mintDenom := sdk.DefaultBondDenom
 	blocksPerYear := uint64(60 * 60 * 8766 / 5)
-	params := types.NewParams(mintDenom, inflationRateChange, blocksPerYear)
+	params := types.NewParams(mintDenom, inflationRateChange, blocksPerYear, sdk.Coins{})
 
 	minter := types.InitialMinter(inflation)
 	minter.MunicipalInflation = GenMunicipalInflation(simState)
+
+	var inflationMode types.InflationMode
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, InflationMode, &inflationMode, simState.Rand,
+		func(r *rand.Rand) { inflationMode = GenInflationMode(r) },
+	)
+
+	if inflationMode == types.InflationModeBondedRatio {
+		defaultParams := types.DefaultParams()
+		params = types.NewBondedRatioParams(
+			mintDenom, defaultParams.InflationRateChange, defaultParams.InflationMax, defaultParams.InflationMin,
+			defaultParams.GoalBonded, blocksPerYear, sdk.Coins{},
+		)
+	}
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CheckpointInterval, &params.CheckpointInterval, simState.Rand,
+		func(r *rand.Rand) { params.CheckpointInterval = GenCheckpointInterval(r) },
+	)
+
+	defaultParams := types.DefaultParams()
+	params.MinBlocksPerYear = defaultParams.MinBlocksPerYear
+	params.MaxBlocksPerYear = defaultParams.MaxBlocksPerYear
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CalibrateBlocksPerYear, &params.CalibrateBlocksPerYear, simState.Rand,
+		func(r *rand.Rand) { params.CalibrateBlocksPerYear = GenCalibrateBlocksPerYear(r) },
+	)
+	simState.AppParams.GetOrGenerate(
+		simState.Cdc, CalibrationWindow, &params.CalibrationWindow, simState.Rand,
+		func(r *rand.Rand) { params.CalibrationWindow = GenCalibrationWindow(r) },
+	)
+
 	mintGenesis := types.NewGenesisState(minter, params)
 
 	bz, err := json.MarshalIndent(&mintGenesis, "", " ")
@@ -75,3 +75,14 @@ func TestRandomizedGenState1(t *testing.T) {
 		require.Panicsf(t, func() { simulation.RandomizedGenState(&tt.simState) }, tt.panicMsg)
 	}
 }
+
+// TestGenMunicipalInflationValue tests that randomized municipal inflations are valid.
+func TestGenMunicipalInflationValue(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	accs := simtypes.RandomAccounts(r, 3)
+
+	for i := 0; i < 100; i++ {
+		inflation := simulation.GenMunicipalInflationValue(r, accs)
+		require.NoError(t, inflation.Validate())
+	}
+}
@@ -0,0 +1,70 @@
+package simulation
+
+import (
+	"math/rand"
+
+	simappparams "github.com/cosmos/cosmos-sdk/simapp/params"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	banksim "github.com/cosmos/cosmos-sdk/x/bank/simulation"
+	"github.com/cosmos/cosmos-sdk/x/mint/keeper"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightSubmitUpdateMunicipalInflationProposal = "op_weight_submit_update_municipal_inflation_proposal"
+	OpWeightSubmitRemoveMunicipalInflationProposal = "op_weight_submit_remove_municipal_inflation_proposal"
+)
+
+// ProposalContents defines the module weighted proposals' contents
+func ProposalContents(k keeper.Keeper) []simtypes.WeightedProposalContent {
+	return []simtypes.WeightedProposalContent{
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitUpdateMunicipalInflationProposal,
+			simappparams.DefaultWeightUpdateMunicipalInflationProposal,
+			SimulateUpdateMunicipalInflationProposalContent(k),
+		),
+		simulation.NewWeightedProposalContent(
+			OpWeightSubmitRemoveMunicipalInflationProposal,
+			simappparams.DefaultWeightRemoveMunicipalInflationProposal,
+			SimulateRemoveMunicipalInflationProposalContent(k),
+		),
+	}
+}
+
+// SimulateUpdateMunicipalInflationProposalContent generates random update-municipal-inflation
+// proposal content, changing or adding inflation of one of the additional test denominations
+func SimulateUpdateMunicipalInflationProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) simtypes.Content {
+		denoms := banksim.AdditionalTestBalancePerAccount
+		if len(denoms) == 0 {
+			return nil
+		}
+
+		denom := denoms[r.Intn(len(denoms))].Denom
+		return types.NewUpdateMunicipalInflationProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			[]*types.MunicipalInflationPair{{Denom: denom, Inflation: GenMunicipalInflationValue(r, accs)}},
+		)
+	}
+}
+
+// SimulateRemoveMunicipalInflationProposalContent generates random remove-municipal-inflation
+// proposal content, removing inflation of one of the inflated denominations
+func SimulateRemoveMunicipalInflationProposalContent(k keeper.Keeper) simtypes.ContentSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, _ []simtypes.Account) simtypes.Content {
+		inflations := k.GetMinter(ctx).MunicipalInflation
+		if len(inflations) == 0 {
+			return nil
+		}
+
+		return types.NewRemoveMunicipalInflationProposal(
+			simtypes.RandStringOfLength(r, 10),
+			simtypes.RandStringOfLength(r, 100),
+			[]string{inflations[r.Intn(len(inflations))].Denom},
+		)
+	}
+}
@@ -0,0 +1,68 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SecondsPerYear is the number of seconds in the year of 365.25 days.
+const SecondsPerYear = 60 * 60 * 8766
+
+// Observe adds the interval between the previously observed block and the block
+// with the given time to the moving average of block intervals. The average is
+// cumulative until `window` intervals are observed, exponential afterwards.
+// It returns true when the number of observed intervals reaches a multiple of
+// `window`, i.e. when the blocks per year should be recalculated.
+func (o *BlockTimeObservation) Observe(blockTime time.Time, window uint64) bool {
+	previous := o.LastBlockTime
+	o.LastBlockTime = blockTime
+
+	// intervals can not be measured from the first observed block, nor from
+	// blocks without increasing time
+	if previous.IsZero() || !blockTime.After(previous) {
+		return false
+	}
+
+	interval := sdk.NewDec(blockTime.Sub(previous).Nanoseconds()).QuoInt64(int64(time.Second))
+	o.Samples++
+
+	if o.Samples == 1 || o.AverageBlockInterval.IsNil() {
+		o.AverageBlockInterval = interval
+	} else {
+		weight := o.Samples
+		if window > 0 && weight > window {
+			weight = window
+		}
+		o.AverageBlockInterval = o.AverageBlockInterval.Add(interval.Sub(o.AverageBlockInterval).QuoInt64(int64(weight)))
+	}
+
+	return window > 0 && o.Samples%window == 0
+}
+
+// ObservedBlocksPerYear returns the number of blocks per year given by the
+// average block interval, zero if no intervals have been observed yet.
+func (o BlockTimeObservation) ObservedBlocksPerYear() uint64 {
+	if o.Samples == 0 || o.AverageBlockInterval.IsNil() || !o.AverageBlockInterval.IsPositive() {
+		return 0
+	}
+
+	return sdk.NewDec(SecondsPerYear).Quo(o.AverageBlockInterval).TruncateInt().Uint64()
+}
+
+// Validate ensures validity of BlockTimeObservation object fields
+func (o BlockTimeObservation) Validate() error {
+	if o.AverageBlockInterval.IsNil() {
+		if o.Samples > 0 {
+			return fmt.Errorf("block time observation with %d samples requires average_block_interval", o.Samples)
+		}
+		return nil
+	}
+
+	if o.AverageBlockInterval.IsNegative() {
+		return fmt.Errorf("average block interval cannot be negative: %s", o.AverageBlockInterval)
+	}
+
+	return nil
+}
@@ -0,0 +1,52 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestObserveBlockTime(t *testing.T) {
+	var observation types.BlockTimeObservation
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	// the first block only sets the reference time
+	require.False(t, observation.Observe(blockTime, 3))
+	require.Equal(t, uint64(0), observation.Samples)
+	require.Equal(t, uint64(0), observation.ObservedBlocksPerYear())
+
+	// cumulative average until the window is filled
+	blockTime = blockTime.Add(4 * time.Second)
+	require.False(t, observation.Observe(blockTime, 3))
+	blockTime = blockTime.Add(6 * time.Second)
+	require.False(t, observation.Observe(blockTime, 3))
+	require.Equal(t, sdk.NewDec(5), observation.AverageBlockInterval)
+
+	blockTime = blockTime.Add(2 * time.Second)
+	require.True(t, observation.Observe(blockTime, 3))
+	require.Equal(t, sdk.NewDec(4), observation.AverageBlockInterval)
+	require.Equal(t, uint64(types.SecondsPerYear/4), observation.ObservedBlocksPerYear())
+
+	// exponential average afterwards
+	blockTime = blockTime.Add(7 * time.Second)
+	require.False(t, observation.Observe(blockTime, 3))
+	require.Equal(t, sdk.NewDec(5), observation.AverageBlockInterval)
+	require.Equal(t, uint64(4), observation.Samples)
+
+	// blocks without increasing time are not sampled
+	require.False(t, observation.Observe(blockTime, 3))
+	require.Equal(t, uint64(4), observation.Samples)
+
+	// sub-second intervals
+	blockTime = blockTime.Add(500 * time.Millisecond)
+	require.False(t, observation.Observe(blockTime, 3))
+	require.Equal(t, sdk.NewDecWithPrec(35, 1), observation.AverageBlockInterval)
+	require.NoError(t, observation.Validate())
+
+	observation.AverageBlockInterval = sdk.NewDec(-1)
+	require.Error(t, observation.Validate())
+}
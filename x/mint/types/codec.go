@@ -2,12 +2,31 @@ package types
 
 import (
 	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 )
 
+// RegisterLegacyAminoCodec registers the necessary x/mint interfaces and concrete types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&UpdateMunicipalInflationProposal{}, "cosmos-sdk/UpdateMunicipalInflationProposal", nil)
+	cdc.RegisterConcrete(&RemoveMunicipalInflationProposal{}, "cosmos-sdk/RemoveMunicipalInflationProposal", nil)
+}
+
+// RegisterInterfaces registers the x/mint interfaces types with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*govtypes.Content)(nil),
+		&UpdateMunicipalInflationProposal{},
+		&RemoveMunicipalInflationProposal{},
+	)
+}
+
 var amino = codec.NewLegacyAmino()
 
 func init() {
+	RegisterLegacyAminoCodec(amino)
 	cryptocodec.RegisterCrypto(amino)
 	amino.Seal()
 }
@@ -0,0 +1,11 @@
+package types
+
+import (
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// x/mint module sentinel errors
+var (
+	ErrEmptyMunicipalInflationProposal = sdkerrors.Register(ModuleName, 2, "municipal inflation proposal does not contain any entries")
+	ErrUnknownMunicipalInflationDenom  = sdkerrors.Register(ModuleName, 3, "no municipal inflation defined for denomination")
+)
@@ -4,9 +4,22 @@ package types
 const (
 	EventTypeMint          = ModuleName
 	EventTypeMunicipalMint = "municipal_mint"
+	EventTypeMunicipalBurn = "municipal_burn"
+
+	EventTypeMaxSupplyReached = "max_supply_reached"
+
+	EventTypeBlocksPerYearCalibrated = "blocks_per_year_calibrated"
+
+	EventTypeUpdateMunicipalInflation = "update_municipal_inflation"
+	EventTypeRemoveMunicipalInflation = "remove_municipal_inflation"
 
 	AttributeKeyInflation        = "inflation"
 	AttributeKeyAnnualProvisions = "annual_provisions"
 	AttributeKeyDenom            = "denom"
 	AttributeKeyTargetAddr       = "target_address"
+	AttributeKeyTargetModule     = "target_module"
+	AttributeKeyWeight           = "weight"
+	AttributeKeyMaxSupply        = "max_supply"
+	AttributeKeyBlocksPerYear    = "blocks_per_year"
+	AttributeKeyBlockInterval    = "average_block_interval"
 )
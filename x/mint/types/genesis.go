@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // NewGenesisState creates a new GenesisState object
 func NewGenesisState(minter Minter, params Params) *GenesisState {
 	return &GenesisState{
@@ -23,5 +25,40 @@ func ValidateGenesis(data GenesisState) error {
 		return err
 	}
 
+	if err := data.MintedTotal.Validate(); err != nil {
+		return fmt.Errorf("invalid minted total: %w", err)
+	}
+
+	if err := data.BurnedTotal.Validate(); err != nil {
+		return fmt.Errorf("invalid burned total: %w", err)
+	}
+
+	recipients := map[string]struct{}{}
+	for _, recipientMinted := range data.RecipientsMinted {
+		if _, exists := recipients[recipientMinted.Recipient]; exists {
+			return fmt.Errorf("duplicate minted amounts of recipient \"%s\"", recipientMinted.Recipient)
+		}
+		recipients[recipientMinted.Recipient] = struct{}{}
+
+		if err := recipientMinted.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for i, checkpoint := range data.Checkpoints {
+		if err := checkpoint.Validate(); err != nil {
+			return err
+		}
+		if i > 0 && checkpoint.Height <= data.Checkpoints[i-1].Height {
+			return fmt.Errorf("mint checkpoints must be ordered by ascending height, height: %d", checkpoint.Height)
+		}
+	}
+
+	if data.BlockTimeObservation != nil {
+		if err := data.BlockTimeObservation.Validate(); err != nil {
+			return err
+		}
+	}
+
 	return ValidateMinter(data.Minter)
 }
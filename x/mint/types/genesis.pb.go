@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
 	io "io"
@@ -29,6 +31,18 @@ type GenesisState struct {
 	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
 	// params defines all the paramaters of the module.
 	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// minted_total holds total amounts of tokens minted per denomination.
+	MintedTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=minted_total,json=mintedTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted_total" yaml:"minted_total"`
+	// recipients_minted holds total amounts of tokens minted per recipient.
+	RecipientsMinted []RecipientMinted `protobuf:"bytes,4,rep,name=recipients_minted,json=recipientsMinted,proto3" json:"recipients_minted" yaml:"recipients_minted"`
+	// checkpoints holds periodic checkpoints of the total minted amounts.
+	Checkpoints []MintCheckpoint `protobuf:"bytes,5,rep,name=checkpoints,proto3" json:"checkpoints"`
+	// block_time_observation holds the observed block times used to calibrate
+	// the effective blocks per year.
+	BlockTimeObservation *BlockTimeObservation `protobuf:"bytes,6,opt,name=block_time_observation,json=blockTimeObservation,proto3" json:"block_time_observation,omitempty" yaml:"block_time_observation"`
+	// burned_total holds total amounts of tokens burned per denomination by
+	// municipal inflation in burn mode.
+	BurnedTotal github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=burned_total,json=burnedTotal,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned_total" yaml:"burned_total"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +92,41 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMintedTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MintedTotal
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRecipientsMinted() []RecipientMinted {
+	if m != nil {
+		return m.RecipientsMinted
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCheckpoints() []MintCheckpoint {
+	if m != nil {
+		return m.Checkpoints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBlockTimeObservation() *BlockTimeObservation {
+	if m != nil {
+		return m.BlockTimeObservation
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBurnedTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BurnedTotal
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "cosmos.mint.v1beta1.GenesisState")
 }
@@ -85,21 +134,35 @@ func init() {
 func init() { proto.RegisterFile("cosmos/mint/v1beta1/genesis.proto", fileDescriptor_0e215eb1d09cd648) }
 
 var fileDescriptor_0e215eb1d09cd648 = []byte{
-	// 218 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4c, 0xce, 0x2f, 0xce,
-	0xcd, 0x2f, 0xd6, 0xcf, 0xcd, 0xcc, 0x2b, 0xd1, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4,
-	0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x12, 0x86,
-	0x28, 0xd1, 0x03, 0x29, 0xd1, 0x83, 0x2a, 0x91, 0x12, 0x49, 0xcf, 0x4f, 0xcf, 0x07, 0xcb, 0xeb,
-	0x83, 0x58, 0x10, 0xa5, 0x52, 0x72, 0xd8, 0x4c, 0x03, 0xeb, 0x03, 0xcb, 0x2b, 0xb5, 0x30, 0x72,
-	0xf1, 0xb8, 0x43, 0x0c, 0x0f, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe4, 0x62, 0x03, 0x49, 0xa7,
-	0x16, 0x49, 0x30, 0x2a, 0x30, 0x6a, 0x70, 0x1b, 0x49, 0xeb, 0x61, 0xb1, 0x4c, 0xcf, 0x17, 0xac,
-	0xc4, 0x89, 0xe5, 0xc4, 0x3d, 0x79, 0x86, 0x20, 0xa8, 0x06, 0x90, 0xd6, 0x82, 0xc4, 0xa2, 0xc4,
-	0xdc, 0x62, 0x09, 0x26, 0x3c, 0x5a, 0x03, 0xc0, 0x4a, 0x60, 0x5a, 0x21, 0x1a, 0x9c, 0x9c, 0x4f,
-	0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18,
-	0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x33, 0x3d, 0xb3, 0x24, 0xa3, 0x34,
-	0x49, 0x2f, 0x39, 0x3f, 0x57, 0x1f, 0xea, 0x17, 0x08, 0xa5, 0x5b, 0x9c, 0x92, 0xad, 0x5f, 0x01,
-	0xf1, 0x58, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0xd8, 0x4b, 0xc6, 0x80, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0xbb, 0xc1, 0x11, 0x51, 0x42, 0x01, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xc1, 0x8e, 0xd2, 0x40,
+	0x18, 0xc7, 0x5b, 0x77, 0x17, 0x93, 0x76, 0x0f, 0xda, 0xdd, 0x98, 0xba, 0xc6, 0x16, 0xaa, 0x07,
+	0x38, 0xd8, 0x06, 0x3c, 0xe9, 0xb1, 0x1c, 0x38, 0x18, 0xa3, 0xa9, 0x9c, 0xbc, 0x34, 0x33, 0x65,
+	0x52, 0x26, 0xd0, 0x99, 0xa6, 0x33, 0x10, 0xb9, 0x9a, 0x78, 0xf7, 0x39, 0x7c, 0x12, 0x8e, 0x1c,
+	0x4d, 0x4c, 0xd0, 0xc0, 0x1b, 0xf0, 0x04, 0x66, 0x66, 0x4a, 0x41, 0xad, 0x24, 0x9c, 0x20, 0xfd,
+	0xfe, 0xbf, 0xdf, 0x7c, 0xf3, 0x7d, 0x19, 0xa3, 0x95, 0x50, 0x96, 0x51, 0x16, 0x64, 0x98, 0xf0,
+	0x60, 0xde, 0x85, 0x88, 0x83, 0x6e, 0x90, 0x22, 0x82, 0x18, 0x66, 0x7e, 0x5e, 0x50, 0x4e, 0xad,
+	0x1b, 0x15, 0xf1, 0x45, 0xc4, 0x2f, 0x23, 0x77, 0xb7, 0x29, 0x4d, 0xa9, 0xac, 0x07, 0xe2, 0x9f,
+	0x8a, 0xde, 0x39, 0xa5, 0x0d, 0x02, 0x86, 0x2a, 0x5b, 0x42, 0x31, 0xf9, 0xab, 0xfe, 0xc7, 0x69,
+	0xd2, 0x2b, 0xeb, 0xde, 0x8f, 0x2b, 0xe3, 0x7a, 0xa0, 0x0e, 0xff, 0xc0, 0x01, 0x47, 0xd6, 0x2b,
+	0xa3, 0x21, 0xca, 0xa8, 0xb0, 0xf5, 0xa6, 0xde, 0x36, 0x7b, 0x4f, 0xfc, 0x9a, 0x66, 0xfc, 0xb7,
+	0x32, 0x12, 0x5e, 0x2e, 0xd7, 0xae, 0x16, 0x95, 0x80, 0x40, 0x73, 0x50, 0x80, 0x8c, 0xd9, 0xf7,
+	0x4e, 0xa0, 0xef, 0x65, 0x64, 0x8f, 0x2a, 0xc0, 0xfa, 0xa2, 0x1b, 0xd7, 0xd2, 0x32, 0x8a, 0x39,
+	0xe5, 0x60, 0x6a, 0x5f, 0x34, 0x2f, 0xda, 0x66, 0xef, 0xf1, 0xde, 0x20, 0xae, 0x57, 0x19, 0xfa,
+	0x14, 0x93, 0x70, 0x20, 0xf8, 0xdd, 0xda, 0xbd, 0x59, 0x80, 0x6c, 0xfa, 0xda, 0x3b, 0x86, 0xbd,
+	0x6f, 0x3f, 0xdd, 0x76, 0x8a, 0xf9, 0x78, 0x06, 0xfd, 0x84, 0x66, 0x41, 0x39, 0x02, 0xf5, 0xf3,
+	0x82, 0x8d, 0x26, 0x01, 0x5f, 0xe4, 0x88, 0x49, 0x0f, 0x8b, 0x4c, 0x85, 0x0e, 0x05, 0x69, 0x31,
+	0xe3, 0x61, 0x81, 0x12, 0x9c, 0x63, 0x44, 0x38, 0x8b, 0x55, 0xc5, 0xbe, 0x94, 0xbd, 0x3c, 0xaf,
+	0xbd, 0x4d, 0xb4, 0x4f, 0xcb, 0x89, 0x8c, 0xc2, 0x66, 0xd9, 0x96, 0xad, 0xda, 0xfa, 0x47, 0xe6,
+	0x45, 0x0f, 0x0e, 0xdf, 0x14, 0x63, 0xbd, 0x31, 0xcc, 0x64, 0x8c, 0x92, 0x49, 0x4e, 0x31, 0xe1,
+	0xcc, 0xbe, 0x92, 0xc7, 0x3d, 0xfb, 0xef, 0xdc, 0xfb, 0x55, 0xb6, 0x1c, 0xe2, 0x31, 0x6d, 0x7d,
+	0xd6, 0x8d, 0x47, 0x70, 0x4a, 0x93, 0x49, 0xcc, 0x71, 0x86, 0x62, 0x0a, 0x19, 0x2a, 0xe6, 0x80,
+	0x63, 0x4a, 0xec, 0x86, 0xdc, 0x4a, 0xa7, 0x56, 0x1c, 0x0a, 0x64, 0x88, 0x33, 0xf4, 0xee, 0x00,
+	0x84, 0xad, 0xdd, 0xda, 0x7d, 0xaa, 0x2e, 0x52, 0xaf, 0xf4, 0xa2, 0x5b, 0x58, 0x03, 0xca, 0x75,
+	0xc2, 0x59, 0x41, 0xaa, 0x75, 0xde, 0x3f, 0x73, 0x9d, 0xc7, 0xf0, 0x99, 0xeb, 0x54, 0xa8, 0x5c,
+	0x67, 0xd8, 0x5f, 0x6e, 0x1c, 0x7d, 0xb5, 0x71, 0xf4, 0x5f, 0x1b, 0x47, 0xff, 0xba, 0x75, 0xb4,
+	0xd5, 0xd6, 0xd1, 0xbe, 0x6f, 0x1d, 0xed, 0x63, 0xe7, 0xa4, 0xf0, 0x93, 0x7a, 0x2f, 0xd2, 0x0b,
+	0x1b, 0xf2, 0xa5, 0xbc, 0xfc, 0x3d, 0x00, 0xca, 0x5b, 0xf8, 0x68, 0xb9, 0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -122,6 +185,74 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BurnedTotal) > 0 {
+		for iNdEx := len(m.BurnedTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BurnedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.BlockTimeObservation != nil {
+		{
+			size, err := m.BlockTimeObservation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RecipientsMinted) > 0 {
+		for iNdEx := len(m.RecipientsMinted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientsMinted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.MintedTotal) > 0 {
+		for iNdEx := len(m.MintedTotal) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintedTotal[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -166,6 +297,34 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintedTotal) > 0 {
+		for _, e := range m.MintedTotal {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RecipientsMinted) > 0 {
+		for _, e := range m.RecipientsMinted {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.BlockTimeObservation != nil {
+		l = m.BlockTimeObservation.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.BurnedTotal) > 0 {
+		for _, e := range m.BurnedTotal {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -270,6 +429,178 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintedTotal = append(m.MintedTotal, types.Coin{})
+			if err := m.MintedTotal[len(m.MintedTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientsMinted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientsMinted = append(m.RecipientsMinted, RecipientMinted{})
+			if err := m.RecipientsMinted[len(m.RecipientsMinted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, MintCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTimeObservation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.BlockTimeObservation == nil {
+				m.BlockTimeObservation = &BlockTimeObservation{}
+			}
+			if err := m.BlockTimeObservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedTotal", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BurnedTotal = append(m.BurnedTotal, types.Coin{})
+			if err := m.BurnedTotal[len(m.BurnedTotal)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
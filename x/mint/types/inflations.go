@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 )
 
 // NewMunicipalInflation returns a new AnnualInflation object with the given denom, target_address
@@ -15,6 +16,17 @@ func NewMunicipalInflation(targetAddress string, inflation sdk.Dec) *MunicipalIn
 	}
 }
 
+// NewMunicipalBurnInflation returns a new AnnualInflation object in the burn
+// mode with the given source module account, from which tokens are burned, and
+// non-positive inflation_rate
+func NewMunicipalBurnInflation(sourceModule string, inflation sdk.Dec) *MunicipalInflation {
+	return &MunicipalInflation{
+		Targets: []*MunicipalInflationTarget{NewMunicipalInflationModuleTarget(sourceModule, sdk.OneDec())},
+		Value:   inflation,
+		Mode:    MunicipalInflationModeBurn,
+	}
+}
+
 func CalculateInflationPerBlock(inflation sdk.Dec, blocksPerYear uint64) (result sdk.Dec, err error) {
 	inflationPerBlockPlusOne, err := inflation.Add(sdk.OneDec()).ApproxRoot(blocksPerYear)
 	if err != nil {
@@ -29,32 +41,215 @@ func CalculateInflationIssuance(inflation sdk.Dec, supply sdk.Coin) (result sdk.
 	return sdk.NewCoins(sdk.NewCoin(supply.Denom, issuedAmount))
 }
 
-// Validate ensures validity of AnnualInflation object fields
+// CalculateBurnAmount returns the amount burned from the `balance` of the burn
+// source for the given non-positive per block inflation, capped at the balance.
+func CalculateBurnAmount(inflation sdk.Dec, balance sdk.Coin) (result sdk.Coins) {
+	burnedAmount := sdk.MinInt(inflation.Abs().MulInt(balance.Amount).TruncateInt(), balance.Amount)
+	return sdk.NewCoins(sdk.NewCoin(balance.Denom, burnedAmount))
+}
+
+// NewWeightedMunicipalInflation returns a new AnnualInflation object with the given inflation_rate
+// and weighted recipients of newly minted tokens
+func NewWeightedMunicipalInflation(inflation sdk.Dec, targets ...*MunicipalInflationTarget) *MunicipalInflation {
+	return &MunicipalInflation{
+		Value:   inflation,
+		Targets: targets,
+	}
+}
+
+// NewMunicipalInflationAddressTarget returns a new recipient account of municipal inflation
+func NewMunicipalInflationAddressTarget(address string, weight sdk.Dec) *MunicipalInflationTarget {
+	return &MunicipalInflationTarget{
+		Address: address,
+		Weight:  weight,
+	}
+}
 
+// NewMunicipalInflationModuleTarget returns a new recipient module account of municipal inflation
+func NewMunicipalInflationModuleTarget(module string, weight sdk.Dec) *MunicipalInflationTarget {
+	return &MunicipalInflationTarget{
+		Module: module,
+		Weight: weight,
+	}
+}
+
+// Validate ensures validity of AnnualInflation object fields
 func (inflation *MunicipalInflation) Validate() error {
 	// NOTE(pb): Algebraically speaking, negative inflation >= -1 is logically
 	//			 valid, however it would cause issues once balance on
 	//			 target_address runs out (we would need to burn tokens from all
 	//			 addresses with non-zero token balance of given denomination,
 	//			 what is politically & performance wise unfeasible.
-	//		     To avoid issues for now, negative inflation is not allowed.
-	if inflation.Value.IsNegative() {
-		return fmt.Errorf("inflation object param, inflation_rate, cannot be negative, value: %s",
-			inflation.Value.String())
+	//		     To avoid issues, negative inflation is allowed only in the
+	//			 explicit burn mode, which burns tokens held by the single
+	//			 target module account, capped at its balance.
+	if err := ValidateMunicipalInflationRate(inflation.Mode, inflation.Value); err != nil {
+		return fmt.Errorf("inflation object param, inflation_rate, %s", err)
 	}
 
-	_, err := sdk.AccAddressFromBech32(inflation.TargetAddress)
-	if err != nil {
-		return fmt.Errorf("inflation object param, target_address, is invalid: %s",
-			inflation.TargetAddress)
+	if inflation.Schedule != nil {
+		if err := inflation.Schedule.ValidateForMode(inflation.Mode); err != nil {
+			return err
+		}
+	}
+
+	if inflation.Mode == MunicipalInflationModeBurn && (len(inflation.Targets) != 1 || len(inflation.Targets[0].Module) == 0) {
+		return fmt.Errorf("inflation object param, targets, must contain a single module account burn source in burn mode")
+	}
+
+	if len(inflation.Targets) == 0 {
+		_, err := sdk.AccAddressFromBech32(inflation.TargetAddress)
+		if err != nil {
+			return fmt.Errorf("inflation object param, target_address, is invalid: %s",
+				inflation.TargetAddress)
+		}
+
+		return nil
+	}
+
+	if len(inflation.TargetAddress) > 0 {
+		return fmt.Errorf("inflation object params, target_address and targets, are mutually exclusive")
+	}
+
+	recipients := map[string]struct{}{}
+	weights := sdk.ZeroDec()
+	for _, target := range inflation.Targets {
+		if target == nil {
+			return fmt.Errorf("inflation object param, targets, contains an undefined target")
+		}
+
+		if err := target.Validate(); err != nil {
+			return err
+		}
+
+		recipient := target.Recipient()
+		if _, exists := recipients[recipient]; exists {
+			return fmt.Errorf("inflation object param, targets, contains recipient \"%s\" more than once", recipient)
+		}
+		recipients[recipient] = struct{}{}
+
+		weights = weights.Add(target.Weight)
+	}
+
+	if !weights.Equal(sdk.OneDec()) {
+		return fmt.Errorf("inflation object param, targets, weights must add up to one, sum: %s", weights)
 	}
 
 	return nil
 }
 
+// ValidateMunicipalInflationRate ensures that the annual inflation rate is
+// allowed in the given mode, i.e. non-negative when minting and in the (-1, 0]
+// range when burning. The rate of -1 is rejected, as it would burn the whole
+// balance of the source in every block.
+func ValidateMunicipalInflationRate(mode MunicipalInflationMode, rate sdk.Dec) error {
+	if rate.IsNil() {
+		return fmt.Errorf("cannot be nil")
+	}
+
+	switch mode {
+	case MunicipalInflationModeMint:
+		if rate.IsNegative() {
+			return fmt.Errorf("cannot be negative in mint mode, value: %s", rate)
+		}
+	case MunicipalInflationModeBurn:
+		if rate.IsPositive() || rate.LTE(sdk.OneDec().Neg()) {
+			return fmt.Errorf("must be in the (-1, 0] range in burn mode, value: %s", rate)
+		}
+	default:
+		return fmt.Errorf("has unknown mode %s", mode)
+	}
+
+	return nil
+}
+
+// IsBurn returns true if tokens held by the target are burned instead of new
+// tokens being minted.
+func (inflation *MunicipalInflation) IsBurn() bool {
+	return inflation.Mode == MunicipalInflationModeBurn
+}
+
+// EffectiveTargets returns weighted recipients of newly minted tokens. The
+// single `target_address` recipient is returned with the weight of one.
+func (inflation *MunicipalInflation) EffectiveTargets() []*MunicipalInflationTarget {
+	if len(inflation.Targets) > 0 {
+		return inflation.Targets
+	}
+
+	return []*MunicipalInflationTarget{NewMunicipalInflationAddressTarget(inflation.TargetAddress, sdk.OneDec())}
+}
+
+// SplitIssuance splits the newly minted coins between the effective targets
+// according to their weights. Truncation remainder is added to the share of
+// the last target, so that the sum of the shares always equals `issuance`.
+func (inflation *MunicipalInflation) SplitIssuance(issuance sdk.Coins) []sdk.Coins {
+	targets := inflation.EffectiveTargets()
+	shares := make([]sdk.Coins, len(targets))
+
+	remainder := issuance
+	for i, target := range targets[:len(targets)-1] {
+		share := sdk.Coins{}
+		for _, coin := range issuance {
+			share = share.Add(sdk.NewCoin(coin.Denom, target.Weight.MulInt(coin.Amount).TruncateInt()))
+		}
+		shares[i] = share
+		remainder = remainder.Sub(share)
+	}
+	shares[len(targets)-1] = remainder
+
+	return shares
+}
+
+// Validate ensures validity of MunicipalInflationTarget object fields
+func (target *MunicipalInflationTarget) Validate() error {
+	if (len(target.Address) == 0) == (len(target.Module) == 0) {
+		return fmt.Errorf("inflation target object params, address and module, are mutually exclusive and one of them must be set")
+	}
+
+	if len(target.Address) > 0 {
+		if _, err := sdk.AccAddressFromBech32(target.Address); err != nil {
+			return fmt.Errorf("inflation target object param, address, is invalid: %s", target.Address)
+		}
+	}
+
+	if target.Weight.IsNil() {
+		return fmt.Errorf("inflation target object param, weight, must be set")
+	}
+
+	if !target.Weight.IsPositive() {
+		return fmt.Errorf("inflation target object param, weight, must be positive, value: %s", target.Weight)
+	}
+
+	return nil
+}
+
+// Attributes returns event attributes identifying the recipient and its weight.
+func (target *MunicipalInflationTarget) Attributes() []sdk.Attribute {
+	recipient := sdk.NewAttribute(AttributeKeyTargetAddr, target.Address)
+	if len(target.Module) > 0 {
+		recipient = sdk.NewAttribute(AttributeKeyTargetModule, target.Module)
+	}
+
+	return []sdk.Attribute{recipient, sdk.NewAttribute(AttributeKeyWeight, target.Weight.String())}
+}
+
+// Recipient returns the human readable identification of the recipient, what
+// is either bech32 address or module name prefixed with "module:".
+func (target *MunicipalInflationTarget) Recipient() string {
+	if len(target.Module) > 0 {
+		return ModuleRecipient(target.Module)
+	}
+
+	return target.Address
+}
+
 func ValidateMunicipalInflations(inflations *[]*MunicipalInflationPair) (err error) {
 	denoms := map[string]struct{}{}
 	for _, pair := range *inflations {
+		if pair == nil {
+			return fmt.Errorf("municipal inflation: undefined denomination and inflation pair")
+		}
+
 		_, exists := denoms[pair.Denom]
 		if exists {
 			return fmt.Errorf("municipal inflation: denomination \"%s\" defined more than once", pair.Denom)
@@ -62,6 +257,10 @@ func ValidateMunicipalInflations(inflations *[]*MunicipalInflationPair) (err err
 
 		denoms[pair.Denom] = struct{}{}
 
+		if pair.Inflation == nil {
+			return fmt.Errorf("municipal inflation: inflation for denomination \"%s\" is not defined", pair.Denom)
+		}
+
 		err = sdk.ValidateDenom(pair.Denom)
 		if err != nil {
 			return fmt.Errorf("inflation object param, denom: %s", err)
@@ -75,3 +274,54 @@ func ValidateMunicipalInflations(inflations *[]*MunicipalInflationPair) (err err
 
 	return
 }
+
+// UpdateMunicipalInflations returns a copy of the `current` inflations where each
+// entry of `updates` replaces the entry with the same denomination. Entries with
+// denominations which are not present in `current` are appended.
+func UpdateMunicipalInflations(current []*MunicipalInflationPair, updates []*MunicipalInflationPair) []*MunicipalInflationPair {
+	result := make([]*MunicipalInflationPair, len(current), len(current)+len(updates))
+	copy(result, current)
+
+	index := map[string]int{}
+	for i, pair := range result {
+		index[pair.Denom] = i
+	}
+
+	for _, pair := range updates {
+		if i, exists := index[pair.Denom]; exists {
+			result[i] = pair
+			continue
+		}
+		index[pair.Denom] = len(result)
+		result = append(result, pair)
+	}
+
+	return result
+}
+
+// RemoveMunicipalInflations returns a copy of the `current` inflations without
+// entries for the given denominations. It fails if any of the denominations has
+// no municipal inflation defined.
+func RemoveMunicipalInflations(current []*MunicipalInflationPair, denoms []string) ([]*MunicipalInflationPair, error) {
+	toRemove := map[string]struct{}{}
+	for _, denom := range denoms {
+		toRemove[denom] = struct{}{}
+	}
+
+	result := make([]*MunicipalInflationPair, 0, len(current))
+	for _, pair := range current {
+		if _, exists := toRemove[pair.Denom]; exists {
+			delete(toRemove, pair.Denom)
+			continue
+		}
+		result = append(result, pair)
+	}
+
+	for _, denom := range denoms {
+		if _, missing := toRemove[denom]; missing {
+			return nil, sdkerrors.Wrapf(ErrUnknownMunicipalInflationDenom, "\"%s\"", denom)
+		}
+	}
+
+	return result, nil
+}
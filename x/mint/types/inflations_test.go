@@ -3,12 +3,15 @@ package types_test
 import (
 	"math/rand"
 	"testing"
+	"time"
 
 	"github.com/cosmos/cosmos-sdk/simapp"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	auth "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/cosmos/cosmos-sdk/x/mint"
+	mintkeeper "github.com/cosmos/cosmos-sdk/x/mint/keeper"
 	"github.com/cosmos/cosmos-sdk/x/mint/types"
 	"github.com/stretchr/testify/require"
 	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
@@ -137,6 +140,72 @@ func TestValidationOfMunicipalInflation(t *testing.T) {
 	}
 }
 
+func TestValidationOfBurnMunicipalInflation(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	sourceAccounts := simtypes.RandomAccounts(r, 2)
+	source := types.ModuleName
+	account := sourceAccounts[0].Address.String()
+
+	withSchedule := func(inflation *types.MunicipalInflation, steps ...types.MunicipalInflationStep) *types.MunicipalInflation {
+		inflation.Schedule = &types.MunicipalInflationSchedule{Steps: steps}
+		return inflation
+	}
+	unknownMode := types.NewMunicipalBurnInflation(source, onePercent.Neg())
+	unknownMode.Mode = types.MunicipalInflationMode(2)
+	accountSource := types.NewWeightedMunicipalInflation(onePercent.Neg(), types.NewMunicipalInflationAddressTarget(account, sdk.OneDec()))
+	accountSource.Mode = types.MunicipalInflationModeBurn
+	legacyAccountSource := types.NewMunicipalInflation(account, onePercent.Neg())
+	legacyAccountSource.Mode = types.MunicipalInflationModeBurn
+	multipleSources := types.NewWeightedMunicipalInflation(onePercent.Neg(),
+		types.NewMunicipalInflationModuleTarget(source, sdk.NewDecWithPrec(5, 1)),
+		types.NewMunicipalInflationModuleTarget(auth.FeeCollectorName, sdk.NewDecWithPrec(5, 1)),
+	)
+	multipleSources.Mode = types.MunicipalInflationModeBurn
+
+	tests := []struct {
+		name           string
+		inflation      *types.MunicipalInflation
+		expectedToPass bool
+	}{
+		{"-1% burned", types.NewMunicipalBurnInflation(source, onePercent.Neg()), true},
+		{"almost whole balance burned", types.NewMunicipalBurnInflation(source, sdk.OneDec().Neg().Add(sdk.SmallestDec())), true},
+		{"whole balance burned", types.NewMunicipalBurnInflation(source, sdk.OneDec().Neg()), false},
+		{"nothing burned", types.NewMunicipalBurnInflation(source, sdk.ZeroDec()), true},
+		{"account source", accountSource, false},
+		{"legacy account source", legacyAccountSource, false},
+		{"negative step", withSchedule(types.NewMunicipalBurnInflation(source, onePercent.Neg()), types.MunicipalInflationStep{Height: 10, Value: sdk.NewDecWithPrec(5, 1).Neg()}), true},
+		{"positive rate", types.NewMunicipalBurnInflation(source, onePercent), false},
+		{"rate below -1", types.NewMunicipalBurnInflation(source, sdk.NewDecWithPrec(11, 1).Neg()), false},
+		{"step burning whole balance", withSchedule(types.NewMunicipalBurnInflation(source, onePercent.Neg()), types.MunicipalInflationStep{Height: 10, Value: sdk.OneDec().Neg()}), false},
+		{"positive step", withSchedule(types.NewMunicipalBurnInflation(source, onePercent.Neg()), types.MunicipalInflationStep{Height: 10, Value: onePercent}), false},
+		{"negative rate in mint mode", types.NewMunicipalInflation(account, onePercent.Neg()), false},
+		{"negative step in mint mode", withSchedule(types.NewMunicipalInflation(account, onePercent), types.MunicipalInflationStep{Height: 10, Value: onePercent.Neg()}), false},
+		{"multiple sources", multipleSources, false},
+		{"missing source", types.NewMunicipalBurnInflation("", onePercent.Neg()), false},
+		{"unknown mode", unknownMode, false},
+	}
+	for _, tc := range tests {
+		err := tc.inflation.Validate()
+		if tc.expectedToPass {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.Error(t, err, tc.name)
+		}
+	}
+}
+
+func TestCalculateBurnAmount(t *testing.T) {
+	balance := sdk.NewCoin("denom", sdk.NewInt(1000))
+
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin("denom", sdk.NewInt(10))), types.CalculateBurnAmount(onePercent.Neg(), balance))
+	require.Equal(t, sdk.NewCoins(balance), types.CalculateBurnAmount(sdk.OneDec().Neg(), balance))
+	require.Equal(t, sdk.NewCoins(balance), types.CalculateBurnAmount(sdk.NewDec(2).Neg(), balance))
+	require.True(t, types.CalculateBurnAmount(sdk.NewDecWithPrec(1, 4).Neg(), balance).Empty())
+	require.True(t, types.CalculateBurnAmount(onePercent.Neg(), sdk.NewCoin("denom", sdk.ZeroInt())).Empty())
+}
+
 func TestBulkValidationOfMunicipalInflations(t *testing.T) {
 	s := rand.NewSource(1)
 	r := rand.New(s)
@@ -221,7 +290,7 @@ func TestHandleMunicipalInflation(t *testing.T) {
 
 	testMunicipalInflations := make([]*types.MunicipalInflationPair, len(definedInfations))
 	for i, infl := range definedInfations {
-		testMunicipalInflations[i] = &types.MunicipalInflationPair{infl.denom, &types.MunicipalInflation{targetAccounts[i].Address.String(), infl.annualInflation}}
+		testMunicipalInflations[i] = &types.MunicipalInflationPair{infl.denom, types.NewMunicipalInflation(targetAccounts[i].Address.String(), infl.annualInflation)}
 	}
 
 	// Configure/initialise Minter with MunicipalInflation:
@@ -258,3 +327,388 @@ func TestHandleMunicipalInflation(t *testing.T) {
 		require.True(t, issuanceRelativeMulError.LT(allowedRelativeMulError))
 	}
 }
+
+func TestHandleMunicipalInflationRecordsScheduleActivation(t *testing.T) {
+	app := simapp.Setup(false)
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{Height: 500, Time: startTime.Add(-time.Second)})
+	keeper := app.MintKeeper
+
+	r := rand.New(rand.NewSource(1))
+	targetAccounts := getTestingAccounts(r, 1, ctx, app)
+
+	inflation := types.NewMunicipalInflation(targetAccounts[0].Address.String(), onePercent)
+	inflation.Schedule = &types.MunicipalInflationSchedule{StartTime: &startTime, DecayPeriod: 100, DecayFactor: sdk.NewDecWithPrec(5, 1)}
+	original := []*types.MunicipalInflationPair{{Denom: "denom0", Inflation: inflation}}
+
+	minter := types.DefaultInitialMinter()
+	minter.MunicipalInflation = original
+	params := types.DefaultParams()
+
+	// not active yet
+	mint.HandleMunicipalInflation(&minter, &params, &ctx, &keeper)
+	require.Zero(t, minter.MunicipalInflation[0].Inflation.Schedule.ActivationHeight)
+
+	ctx = ctx.WithBlockHeight(501).WithBlockTime(startTime)
+	mint.HandleMunicipalInflation(&minter, &params, &ctx, &keeper)
+	require.Equal(t, int64(501), minter.MunicipalInflation[0].Inflation.Schedule.ActivationHeight)
+	require.Zero(t, original[0].Inflation.Schedule.ActivationHeight)
+
+	ctx = ctx.WithBlockHeight(600)
+	mint.HandleMunicipalInflation(&minter, &params, &ctx, &keeper)
+	require.Equal(t, int64(501), minter.MunicipalInflation[0].Inflation.Schedule.ActivationHeight)
+
+	// decay is anchored at the activation height rather than at the start height
+	keeper.SetMinter(ctx, minter)
+	require.Equal(t, onePercent, keeper.GetMunicipalInflationSnapshot(ctx).GetStatus("denom0").CurrentRate)
+}
+
+func TestValidationOfWeightedMunicipalInflation(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	targetAccounts := simtypes.RandomAccounts(r, 2)
+	addr0 := targetAccounts[0].Address.String()
+	addr1 := targetAccounts[1].Address.String()
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		inflation      *types.MunicipalInflation
+		expectedToPass bool
+	}{
+		// Pass: single target
+		{types.NewWeightedMunicipalInflation(onePercent, types.NewMunicipalInflationAddressTarget(addr0, sdk.OneDec())), true},
+		// Pass: account & module targets
+		{types.NewWeightedMunicipalInflation(onePercent,
+			types.NewMunicipalInflationAddressTarget(addr0, half),
+			types.NewMunicipalInflationModuleTarget("distribution", half)), true},
+		// Fail: weights do not add up to one
+		{types.NewWeightedMunicipalInflation(onePercent,
+			types.NewMunicipalInflationAddressTarget(addr0, half),
+			types.NewMunicipalInflationAddressTarget(addr1, onePercent)), false},
+		// Fail: zero weight
+		{types.NewWeightedMunicipalInflation(onePercent,
+			types.NewMunicipalInflationAddressTarget(addr0, sdk.OneDec()),
+			types.NewMunicipalInflationAddressTarget(addr1, sdk.ZeroDec())), false},
+		// Fail: duplicated recipient
+		{types.NewWeightedMunicipalInflation(onePercent,
+			types.NewMunicipalInflationAddressTarget(addr0, half),
+			types.NewMunicipalInflationAddressTarget(addr0, half)), false},
+		// Fail: both address & module set
+		{types.NewWeightedMunicipalInflation(onePercent, &types.MunicipalInflationTarget{Address: addr0, Module: "distribution", Weight: sdk.OneDec()}), false},
+		// Fail: invalid address
+		{types.NewWeightedMunicipalInflation(onePercent, types.NewMunicipalInflationAddressTarget("fetch123abc", sdk.OneDec())), false},
+		// Fail: unset weight
+		{types.NewWeightedMunicipalInflation(onePercent, &types.MunicipalInflationTarget{Address: addr0}), false},
+		// Fail: undefined target
+		{types.NewWeightedMunicipalInflation(onePercent, nil), false},
+		// Fail: both target_address & targets set
+		{&types.MunicipalInflation{TargetAddress: addr0, Value: onePercent, Targets: []*types.MunicipalInflationTarget{types.NewMunicipalInflationAddressTarget(addr1, sdk.OneDec())}}, false},
+	}
+	for _, tc := range tests {
+		err := tc.inflation.Validate()
+		if tc.expectedToPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
+
+func TestSplitIssuance(t *testing.T) {
+	s := rand.NewSource(1)
+	r := rand.New(s)
+
+	targetAccounts := simtypes.RandomAccounts(r, 2)
+
+	inflation := types.NewWeightedMunicipalInflation(onePercent,
+		types.NewMunicipalInflationAddressTarget(targetAccounts[0].Address.String(), sdk.NewDecWithPrec(1, 1)),
+		types.NewMunicipalInflationAddressTarget(targetAccounts[1].Address.String(), sdk.NewDecWithPrec(6, 1)),
+		types.NewMunicipalInflationModuleTarget("distribution", sdk.NewDecWithPrec(3, 1)),
+	)
+	require.NoError(t, inflation.Validate())
+
+	shares := inflation.SplitIssuance(sdk.NewCoins(sdk.NewInt64Coin("denom", 1005)))
+	require.Len(t, shares, 3)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 100)), shares[0])
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 603)), shares[1])
+	// truncation remainder goes to the last target
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("denom", 302)), shares[2])
+
+	legacy := types.NewMunicipalInflation(targetAccounts[0].Address.String(), onePercent)
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin("denom", 7))}, legacy.SplitIssuance(sdk.NewCoins(sdk.NewInt64Coin("denom", 7))))
+}
+
+func TestHandleWeightedMunicipalInflation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := app.MintKeeper
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	targetAccounts := getTestingAccounts(r, 1, ctx, app)
+
+	minter := types.DefaultInitialMinter()
+	params := types.DefaultParams()
+	params.BlocksPerYear = 1
+	keeper.SetParams(ctx, params)
+
+	denom := "weighted"
+	initSupplyAmount := sdk.NewInt(1000000)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewCoin(denom, initSupplyAmount))))
+
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{denom, types.NewWeightedMunicipalInflation(sdk.NewDecWithPrec(10, 2),
+			types.NewMunicipalInflationAddressTarget(targetAccounts[0].Address.String(), sdk.NewDecWithPrec(75, 2)),
+			types.NewMunicipalInflationModuleTarget(distrtypes.ModuleName, sdk.NewDecWithPrec(25, 2)),
+		)},
+	}
+	require.NoError(t, keeper.ValidateMunicipalInflationTargets(minter.MunicipalInflation))
+	keeper.SetMinter(ctx, minter)
+
+	communityPoolBefore := app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(denom)
+
+	mint.HandleMunicipalInflation(&minter, &params, &ctx, &keeper)
+
+	// with a single block per year the whole annual inflation is minted at once
+	require.Equal(t, sdk.NewInt(75000), app.BankKeeper.GetBalance(ctx, targetAccounts[0].Address, denom).Amount)
+	require.Equal(t, sdk.NewDec(25000), app.DistrKeeper.GetFeePoolCommunityCoins(ctx).AmountOf(denom).Sub(communityPoolBefore))
+	require.Equal(t, initSupplyAmount.AddRaw(100000), keeper.BankKeeper.GetSupply(ctx, denom).Amount)
+
+	// minted amounts are accounted per denomination & recipient
+	require.Equal(t, sdk.NewInt64Coin(denom, 100000), keeper.GetMintedTotal(ctx, denom))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 75000)), keeper.GetRecipientMinted(ctx, targetAccounts[0].Address.String()))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(denom, 25000)), keeper.GetRecipientMinted(ctx, types.ModuleRecipient(distrtypes.ModuleName)))
+
+	// the issuance is verified by invariants
+	_, broken := mintkeeper.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	var issuance types.MunicipalIssuance
+	keeper.IterateMunicipalIssuances(ctx, func(i types.MunicipalIssuance) bool {
+		issuance = i
+		return true
+	})
+	require.Equal(t, denom, issuance.Denom)
+	require.Len(t, issuance.Recipients, 2)
+
+	// minted amount of a target deviates from its share of the issuance
+	cacheCtx, _ := ctx.CacheContext()
+	deviated := issuance
+	deviated.Recipients = []types.MunicipalIssuanceRecipient{
+		{Recipient: issuance.Recipients[0].Recipient, Minted: issuance.Recipients[0].Minted.AddRaw(1)},
+		{Recipient: issuance.Recipients[1].Recipient, Minted: issuance.Recipients[1].Minted.SubRaw(1)},
+	}
+	keeper.SetMunicipalIssuance(cacheCtx, deviated)
+	_, broken = mintkeeper.MunicipalIssuanceInvariant(keeper)(cacheCtx)
+	require.False(t, broken)
+	_, broken = mintkeeper.MunicipalTargetsInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+
+	// minted amount deviates from the issuance
+	cacheCtx, _ = ctx.CacheContext()
+	deviated.Recipients = []types.MunicipalIssuanceRecipient{
+		issuance.Recipients[0],
+		{Recipient: issuance.Recipients[1].Recipient, Minted: issuance.Recipients[1].Minted.AddRaw(1)},
+	}
+	keeper.SetMunicipalIssuance(cacheCtx, deviated)
+	_, broken = mintkeeper.MunicipalIssuanceInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+
+	// other supply changes in the same block do not break the invariants
+	cacheCtx, _ = ctx.CacheContext()
+	require.NoError(t, app.BankKeeper.MintCoins(cacheCtx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(denom, 1))))
+	keeper.RecordMinted(cacheCtx, targetAccounts[0].Address.String(), sdk.NewCoins(sdk.NewInt64Coin(denom, 1)))
+	_, broken = mintkeeper.AllInvariants(keeper)(cacheCtx)
+	require.False(t, broken)
+
+	// issuance deviates from the stored inflation rate
+	cacheCtx, _ = ctx.CacheContext()
+	changed := minter
+	changed.MunicipalInflation = []*types.MunicipalInflationPair{
+		{denom, types.NewWeightedMunicipalInflation(sdk.NewDecWithPrec(20, 2), minter.MunicipalInflation[0].Inflation.Targets...)},
+	}
+	keeper.SetMinter(cacheCtx, changed)
+	_, broken = mintkeeper.MunicipalIssuanceInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+	_, broken = mintkeeper.MunicipalTargetsInvariant(keeper)(cacheCtx)
+	require.True(t, broken)
+
+	// module accounts must not be targeted by their address
+	feeCollector := app.AccountKeeper.GetModuleAddress(auth.FeeCollectorName)
+	require.Error(t, keeper.ValidateMunicipalInflationTargets([]*types.MunicipalInflationPair{
+		{denom, types.NewMunicipalInflation(feeCollector.String(), onePercent)},
+	}))
+	require.Error(t, keeper.ValidateMunicipalInflationTargets([]*types.MunicipalInflationPair{
+		{denom, types.NewWeightedMunicipalInflation(onePercent, types.NewMunicipalInflationModuleTarget("unknown", sdk.OneDec()))},
+	}))
+}
+
+func TestMintingIsClampedToMaxSupply(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := app.MintKeeper
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	targetAccounts := getTestingAccounts(r, 1, ctx, app)
+
+	denom := "capped"
+	initSupplyAmount := sdk.NewInt(1000000)
+	params := types.DefaultParams()
+	params.BlocksPerYear = 1
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewCoin(denom, initSupplyAmount),
+		sdk.NewCoin(params.MintDenom, initSupplyAmount),
+	)))
+	stakingSupply := keeper.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	params.MaxSupply = sdk.NewCoins(
+		sdk.NewCoin(denom, initSupplyAmount.AddRaw(50000)),
+		sdk.NewCoin(params.MintDenom, stakingSupply.AddRaw(10)),
+	)
+	require.NoError(t, params.Validate())
+	keeper.SetParams(ctx, params)
+
+	minter := types.DefaultInitialMinter()
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{denom, types.NewMunicipalInflation(targetAccounts[0].Address.String(), sdk.NewDecWithPrec(10, 2))},
+	}
+	keeper.SetMinter(ctx, minter)
+
+	mint.BeginBlocker(ctx, keeper)
+
+	// 10% inflation would mint 100000 tokens, but only 50000 fit below the cap
+	require.Equal(t, sdk.NewInt(50000), app.BankKeeper.GetBalance(ctx, targetAccounts[0].Address, denom).Amount)
+	require.Equal(t, initSupplyAmount.AddRaw(50000), keeper.BankKeeper.GetSupply(ctx, denom).Amount)
+	require.Equal(t, stakingSupply.AddRaw(10), keeper.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+
+	reached := 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMaxSupplyReached {
+			reached++
+		}
+	}
+	require.Equal(t, 2, reached)
+
+	// capped issuance is accepted by invariants
+	_, broken := mintkeeper.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// nothing is minted, nor the event is emitted, once the cap is reached
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, keeper)
+
+	require.Equal(t, initSupplyAmount.AddRaw(50000), keeper.BankKeeper.GetSupply(ctx, denom).Amount)
+	require.Equal(t, stakingSupply.AddRaw(10), keeper.BankKeeper.GetSupply(ctx, params.MintDenom).Amount)
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeMaxSupplyReached, event.Type)
+		require.NotEqual(t, types.EventTypeMunicipalMint, event.Type)
+	}
+}
+
+func TestMunicipalInflationOfMintDenomIsAcceptedByInvariants(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := app.MintKeeper
+
+	s := rand.NewSource(1)
+	r := rand.New(s)
+	targetAccounts := getTestingAccounts(r, 1, ctx, app)
+
+	params := types.DefaultParams()
+	params.BlocksPerYear = 100
+	keeper.SetParams(ctx, params)
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 1000000))))
+
+	// the fee collector receives both the municipal issuance & the block provision
+	minter := types.DefaultInitialMinter()
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{params.MintDenom, types.NewWeightedMunicipalInflation(sdk.NewDecWithPrec(10, 2),
+			types.NewMunicipalInflationAddressTarget(targetAccounts[0].Address.String(), sdk.NewDecWithPrec(5, 1)),
+			types.NewMunicipalInflationModuleTarget(auth.FeeCollectorName, sdk.NewDecWithPrec(5, 1)),
+		)},
+	}
+	require.NoError(t, keeper.ValidateMunicipalInflationTargets(minter.MunicipalInflation))
+	keeper.SetMinter(ctx, minter)
+
+	supply := keeper.BankKeeper.GetSupply(ctx, params.MintDenom).Amount
+	mint.BeginBlocker(ctx, keeper)
+
+	municipal := app.BankKeeper.GetBalance(ctx, targetAccounts[0].Address, params.MintDenom).Amount.MulRaw(2)
+	require.True(t, municipal.IsPositive())
+	require.True(t, keeper.BankKeeper.GetSupply(ctx, params.MintDenom).Amount.Sub(supply).GT(municipal))
+
+	_, broken := mintkeeper.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// burning tokens, e.g. by slashing, in the same block
+	require.NoError(t, app.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(params.MintDenom, 100000))))
+
+	_, broken = mintkeeper.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+}
+
+func TestHandleBurnMunicipalInflation(t *testing.T) {
+	app := simapp.Setup(false)
+	ctx := app.BaseApp.NewContext(false, tmproto.Header{})
+	keeper := app.MintKeeper
+
+	slowDenom, fastDenom := "burned", "fastburned"
+	initSupplyAmount := sdk.NewInt(1000000)
+	params := types.DefaultParams()
+	params.BlocksPerYear = 1
+	keeper.SetParams(ctx, params)
+
+	require.NoError(t, app.BankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(
+		sdk.NewCoin(slowDenom, initSupplyAmount),
+		sdk.NewCoin(fastDenom, initSupplyAmount),
+	)))
+
+	// the mint module is the treasury allowed to be drained in simapp
+	minter := types.DefaultInitialMinter()
+	minter.MunicipalInflation = []*types.MunicipalInflationPair{
+		{slowDenom, types.NewMunicipalBurnInflation(types.ModuleName, sdk.NewDecWithPrec(10, 2).Neg())},
+		{fastDenom, types.NewMunicipalBurnInflation(types.ModuleName, sdk.NewDecWithPrec(5, 1).Neg())},
+	}
+	require.NoError(t, keeper.ValidateMunicipalInflationTargets(minter.MunicipalInflation))
+	keeper.SetMinter(ctx, minter)
+
+	mint.BeginBlocker(ctx, keeper)
+
+	// -10% inflation burns 10% of the module balance, -50% half of it
+	mintModule := app.AccountKeeper.GetModuleAddress(types.ModuleName)
+	require.Equal(t, sdk.NewInt(900000), app.BankKeeper.GetBalance(ctx, mintModule, slowDenom).Amount)
+	require.Equal(t, sdk.NewInt(900000), keeper.BankKeeper.GetSupply(ctx, slowDenom).Amount)
+	require.Equal(t, sdk.NewInt(500000), app.BankKeeper.GetBalance(ctx, mintModule, fastDenom).Amount)
+	require.Equal(t, sdk.NewInt(500000), keeper.BankKeeper.GetSupply(ctx, fastDenom).Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(slowDenom, sdk.NewInt(100000)), sdk.NewCoin(fastDenom, sdk.NewInt(500000))),
+		keeper.GetAllBurnedTotal(ctx))
+	require.True(t, keeper.GetMintedTotal(ctx, slowDenom).IsZero())
+
+	burns := 0
+	for _, event := range ctx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypeMunicipalMint, event.Type)
+		if event.Type == types.EventTypeMunicipalBurn {
+			burns++
+		}
+	}
+	require.Equal(t, 2, burns)
+
+	_, broken := mintkeeper.AllInvariants(keeper)(ctx)
+	require.False(t, broken)
+
+	// nothing is burned once the balance runs out
+	require.NoError(t, app.BankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(fastDenom, 500000))))
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	mint.BeginBlocker(ctx, keeper)
+
+	require.Equal(t, sdk.NewInt(810000), app.BankKeeper.GetBalance(ctx, mintModule, slowDenom).Amount)
+	require.Equal(t, sdk.NewInt(500000), keeper.GetBurnedTotal(ctx, fastDenom).Amount)
+	burns = 0
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMunicipalBurn {
+			burns++
+		}
+	}
+	require.Equal(t, 1, burns)
+}
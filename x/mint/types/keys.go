@@ -1,7 +1,29 @@
 package types
 
-// MinterKey is the key to use for the keeper store.
-var MinterKey = []byte{0x00}
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	// MinterKey is the key to use for the keeper store.
+	MinterKey = []byte{0x00}
+
+	// MintedTotalKeyPrefix is the prefix of total minted amounts per denomination
+	MintedTotalKeyPrefix = []byte{0x01}
+	// RecipientMintedKeyPrefix is the prefix of total minted amounts per recipient
+	RecipientMintedKeyPrefix = []byte{0x02}
+	// MintCheckpointKeyPrefix is the prefix of checkpoints of total minted amounts
+	MintCheckpointKeyPrefix = []byte{0x03}
+	// BlockTimeObservationKey is the key of observed block times
+	BlockTimeObservationKey = []byte{0x04}
+	// BurnedTotalKeyPrefix is the prefix of total burned amounts per denomination
+	BurnedTotalKeyPrefix = []byte{0x05}
+
+	// MunicipalIssuanceKeyPrefix is the prefix of municipal inflation issuances
+	// of the current block in the transient store
+	MunicipalIssuanceKeyPrefix = []byte{0x00}
+)
 
 const (
 	// module name
@@ -10,6 +32,12 @@ const (
 	// StoreKey is the default store key for mint
 	StoreKey = ModuleName
 
+	// TStoreKey is the transient store key for mint
+	TStoreKey = "transient_" + ModuleName
+
+	// RouterKey is the message route for the minting module
+	RouterKey = ModuleName
+
 	// QuerierRoute is the querier route for the minting store.
 	QuerierRoute = StoreKey
 
@@ -19,3 +47,20 @@ const (
 	QueryInflations       = "inflations"
 	QueryAnnualProvisions = "annual_provisions"
 )
+
+// RecipientMintedKey returns the store prefix of total minted amounts of the
+// given recipient, under which amounts are stored by denomination.
+func RecipientMintedKey(recipient string) []byte {
+	return append(RecipientMintedKeyPrefix, address.MustLengthPrefix([]byte(recipient))...)
+}
+
+// MintCheckpointKey returns the store key of the checkpoint at the given height.
+func MintCheckpointKey(height int64) []byte {
+	return append(MintCheckpointKeyPrefix, sdk.Uint64ToBigEndian(uint64(height))...)
+}
+
+// MunicipalIssuanceKey returns the transient store key of the municipal
+// inflation issuance of the given denomination.
+func MunicipalIssuanceKey(denom string) []byte {
+	return append(MunicipalIssuanceKeyPrefix, []byte(denom)...)
+}
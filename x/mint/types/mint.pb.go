@@ -6,17 +6,22 @@ package types
 import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/gogo/protobuf/gogoproto"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,6 +29,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MunicipalInflationMode enumerates the ways in which municipal inflation is
+// applied to the denomination.
+type MunicipalInflationMode int32
+
+const (
+	// MUNICIPAL_INFLATION_MODE_MINT defines non-negative inflation, where new
+	// tokens are minted every block and split between the targets.
+	MunicipalInflationModeMint MunicipalInflationMode = 0
+	// MUNICIPAL_INFLATION_MODE_BURN defines non-positive inflation, where tokens
+	// held by the single target module account are burned every block. The per
+	// block burned amount is derived from the balance of the target instead of
+	// the supply, and is capped at that balance.
+	MunicipalInflationModeBurn MunicipalInflationMode = 1
+)
+
+var MunicipalInflationMode_name = map[int32]string{
+	0: "MUNICIPAL_INFLATION_MODE_MINT",
+	1: "MUNICIPAL_INFLATION_MODE_BURN",
+}
+
+var MunicipalInflationMode_value = map[string]int32{
+	"MUNICIPAL_INFLATION_MODE_MINT": 0,
+	"MUNICIPAL_INFLATION_MODE_BURN": 1,
+}
+
+func (x MunicipalInflationMode) String() string {
+	return proto.EnumName(MunicipalInflationMode_name, int32(x))
+}
+
+func (MunicipalInflationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{0}
+}
+
+// InflationMode enumerates the mechanisms determining the annual inflation rate
+// of the mint denomination.
+type InflationMode int32
+
+const (
+	// INFLATION_MODE_FIXED defines the constant annual inflation rate given by
+	// the `inflation_rate` param.
+	InflationModeFixed InflationMode = 0
+	// INFLATION_MODE_BONDED_RATIO defines the annual inflation rate which moves
+	// towards `inflation_max` while the bonded ratio is below `goal_bonded`, and
+	// towards `inflation_min` while it is above, by at most
+	// `inflation_rate_change` per year.
+	InflationModeBondedRatio InflationMode = 1
+)
+
+var InflationMode_name = map[int32]string{
+	0: "INFLATION_MODE_FIXED",
+	1: "INFLATION_MODE_BONDED_RATIO",
+}
+
+var InflationMode_value = map[string]int32{
+	"INFLATION_MODE_FIXED":        0,
+	"INFLATION_MODE_BONDED_RATIO": 1,
+}
+
+func (x InflationMode) String() string {
+	return proto.EnumName(InflationMode_name, int32(x))
+}
+
+func (InflationMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{1}
+}
+
 // Minter represents the minting state.
 type Minter struct {
 	// current annual inflation rate
@@ -75,10 +146,19 @@ func (m *Minter) GetMunicipalInflation() []*MunicipalInflationPair {
 
 // Inflation holds parameters for individual native token inflation
 type MunicipalInflation struct {
-	// address where inflation induced new tokens will be minted
+	// address where inflation induced new tokens will be minted, mutually
+	// exclusive with `targets`
 	TargetAddress string `protobuf:"bytes,2,opt,name=target_address,json=targetAddress,proto3" json:"target_address,omitempty" yaml:"target_address"`
 	// current ANNUAL inflation rate
 	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+	// weighted recipients between which inflation induced new tokens are split,
+	// mutually exclusive with `target_address`
+	Targets []*MunicipalInflationTarget `protobuf:"bytes,4,rep,name=targets,proto3" json:"targets,omitempty"`
+	// optional schedule bounding and changing the inflation rate over time,
+	// `value` is constant for the whole lifetime of the chain if not set
+	Schedule *MunicipalInflationSchedule `protobuf:"bytes,5,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// mode in which the inflation is applied, minting to the targets by default
+	Mode MunicipalInflationMode `protobuf:"varint,6,opt,name=mode,proto3,enum=cosmos.mint.v1beta1.MunicipalInflationMode" json:"mode,omitempty"`
 }
 
 func (m *MunicipalInflation) Reset()         { *m = MunicipalInflation{} }
@@ -121,6 +201,244 @@ func (m *MunicipalInflation) GetTargetAddress() string {
 	return ""
 }
 
+func (m *MunicipalInflation) GetTargets() []*MunicipalInflationTarget {
+	if m != nil {
+		return m.Targets
+	}
+	return nil
+}
+
+func (m *MunicipalInflation) GetSchedule() *MunicipalInflationSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *MunicipalInflation) GetMode() MunicipalInflationMode {
+	if m != nil {
+		return m.Mode
+	}
+	return MunicipalInflationModeMint
+}
+
+// MunicipalInflationSchedule defines when municipal inflation is active and how
+// its annual rate evolves. The `value` of the parent MunicipalInflation is the
+// initial rate, which is overridden by `steps` and decayed every `decay_period`
+// blocks counted from the `start_height` or the most recent step.
+type MunicipalInflationSchedule struct {
+	// height of the first block with active inflation, unbounded if zero
+	StartHeight int64 `protobuf:"varint,1,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty" yaml:"start_height"`
+	// height of the first block with inactive inflation, unbounded if zero
+	EndHeight int64 `protobuf:"varint,2,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty" yaml:"end_height"`
+	// time from which the inflation is active, unbounded if not set
+	StartTime *time.Time `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,stdtime" json:"start_time,omitempty" yaml:"start_time"`
+	// time from which the inflation is inactive, unbounded if not set
+	EndTime *time.Time `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,stdtime" json:"end_time,omitempty" yaml:"end_time"`
+	// step changes of the annual inflation rate, ordered by height
+	Steps []MunicipalInflationStep `protobuf:"bytes,5,rep,name=steps,proto3" json:"steps"`
+	// number of blocks after which the annual inflation rate is multiplied by
+	// `decay_factor`, no decay if zero
+	DecayPeriod uint64 `protobuf:"varint,6,opt,name=decay_period,json=decayPeriod,proto3" json:"decay_period,omitempty" yaml:"decay_period"`
+	// multiplier applied to the annual inflation rate every `decay_period`
+	// blocks, e.g. 0.5 for halvings
+	DecayFactor github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,7,opt,name=decay_factor,json=decayFactor,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"decay_factor" yaml:"decay_factor"`
+	// height of the first block with active inflation of a schedule started by
+	// `start_time`, recorded by the chain; the decay is anchored at it
+	ActivationHeight int64 `protobuf:"varint,8,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty" yaml:"activation_height"`
+}
+
+func (m *MunicipalInflationSchedule) Reset()         { *m = MunicipalInflationSchedule{} }
+func (m *MunicipalInflationSchedule) String() string { return proto.CompactTextString(m) }
+func (*MunicipalInflationSchedule) ProtoMessage()    {}
+func (*MunicipalInflationSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{2}
+}
+func (m *MunicipalInflationSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MunicipalInflationSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MunicipalInflationSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MunicipalInflationSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MunicipalInflationSchedule.Merge(m, src)
+}
+func (m *MunicipalInflationSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MunicipalInflationSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MunicipalInflationSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MunicipalInflationSchedule proto.InternalMessageInfo
+
+func (m *MunicipalInflationSchedule) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *MunicipalInflationSchedule) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *MunicipalInflationSchedule) GetStartTime() *time.Time {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *MunicipalInflationSchedule) GetEndTime() *time.Time {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *MunicipalInflationSchedule) GetSteps() []MunicipalInflationStep {
+	if m != nil {
+		return m.Steps
+	}
+	return nil
+}
+
+func (m *MunicipalInflationSchedule) GetDecayPeriod() uint64 {
+	if m != nil {
+		return m.DecayPeriod
+	}
+	return 0
+}
+
+func (m *MunicipalInflationSchedule) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+// MunicipalInflationStep changes the annual municipal inflation rate from the
+// given height on.
+type MunicipalInflationStep struct {
+	// height of the first block with the new rate
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// new ANNUAL inflation rate
+	Value github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=value,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"value"`
+}
+
+func (m *MunicipalInflationStep) Reset()         { *m = MunicipalInflationStep{} }
+func (m *MunicipalInflationStep) String() string { return proto.CompactTextString(m) }
+func (*MunicipalInflationStep) ProtoMessage()    {}
+func (*MunicipalInflationStep) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{3}
+}
+func (m *MunicipalInflationStep) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MunicipalInflationStep) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MunicipalInflationStep.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MunicipalInflationStep) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MunicipalInflationStep.Merge(m, src)
+}
+func (m *MunicipalInflationStep) XXX_Size() int {
+	return m.Size()
+}
+func (m *MunicipalInflationStep) XXX_DiscardUnknown() {
+	xxx_messageInfo_MunicipalInflationStep.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MunicipalInflationStep proto.InternalMessageInfo
+
+func (m *MunicipalInflationStep) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// MunicipalInflationTarget represents a weighted recipient of municipal
+// inflation. Exactly one of `address` or `module` must be set.
+type MunicipalInflationTarget struct {
+	// bech32 address of the recipient account
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// name of the recipient module account, tokens sent to the `distribution`
+	// module are added to the community pool
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// share of newly minted tokens sent to the recipient, weights of all
+	// recipients must add up to one
+	Weight github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"weight"`
+}
+
+func (m *MunicipalInflationTarget) Reset()         { *m = MunicipalInflationTarget{} }
+func (m *MunicipalInflationTarget) String() string { return proto.CompactTextString(m) }
+func (*MunicipalInflationTarget) ProtoMessage()    {}
+func (*MunicipalInflationTarget) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{4}
+}
+func (m *MunicipalInflationTarget) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MunicipalInflationTarget) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MunicipalInflationTarget.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MunicipalInflationTarget) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MunicipalInflationTarget.Merge(m, src)
+}
+func (m *MunicipalInflationTarget) XXX_Size() int {
+	return m.Size()
+}
+func (m *MunicipalInflationTarget) XXX_DiscardUnknown() {
+	xxx_messageInfo_MunicipalInflationTarget.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MunicipalInflationTarget proto.InternalMessageInfo
+
+func (m *MunicipalInflationTarget) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MunicipalInflationTarget) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
 // Pair representing denom -> inflation mapping.
 // This pair-like structure will be used in `repeating MunicipalInflationPair`
 // type, what will have the same Protobuf binary representation on wire as the
@@ -139,7 +457,7 @@ func (m *MunicipalInflationPair) Reset()         { *m = MunicipalInflationPair{}
 func (m *MunicipalInflationPair) String() string { return proto.CompactTextString(m) }
 func (*MunicipalInflationPair) ProtoMessage()    {}
 func (*MunicipalInflationPair) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2df116d183c1e223, []int{2}
+	return fileDescriptor_2df116d183c1e223, []int{5}
 }
 func (m *MunicipalInflationPair) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -182,20 +500,175 @@ func (m *MunicipalInflationPair) GetInflation() *MunicipalInflation {
 	return nil
 }
 
+// UpdateMunicipalInflationProposal is a gov Content type for adding or changing
+// municipal inflation entries. Each pair replaces the entry of its denomination,
+// or is appended when no entry for that denomination exists yet.
+type UpdateMunicipalInflationProposal struct {
+	Title              string                    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description        string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	MunicipalInflation []*MunicipalInflationPair `protobuf:"bytes,3,rep,name=municipal_inflation,json=municipalInflation,proto3" json:"municipal_inflation,omitempty" yaml:"municipal_inflation"`
+}
+
+func (m *UpdateMunicipalInflationProposal) Reset()      { *m = UpdateMunicipalInflationProposal{} }
+func (*UpdateMunicipalInflationProposal) ProtoMessage() {}
+func (*UpdateMunicipalInflationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{6}
+}
+func (m *UpdateMunicipalInflationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateMunicipalInflationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateMunicipalInflationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateMunicipalInflationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMunicipalInflationProposal.Merge(m, src)
+}
+func (m *UpdateMunicipalInflationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateMunicipalInflationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMunicipalInflationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateMunicipalInflationProposal proto.InternalMessageInfo
+
+// UpdateMunicipalInflationProposalWithDeposit defines an
+// UpdateMunicipalInflationProposal with a deposit.
+type UpdateMunicipalInflationProposalWithDeposit struct {
+	Title              string                    `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty" yaml:"title"`
+	Description        string                    `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty" yaml:"description"`
+	MunicipalInflation []*MunicipalInflationPair `protobuf:"bytes,3,rep,name=municipal_inflation,json=municipalInflation,proto3" json:"municipal_inflation,omitempty" yaml:"municipal_inflation"`
+	Deposit            string                    `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit,omitempty" yaml:"deposit"`
+}
+
+func (m *UpdateMunicipalInflationProposalWithDeposit) Reset() {
+	*m = UpdateMunicipalInflationProposalWithDeposit{}
+}
+func (m *UpdateMunicipalInflationProposalWithDeposit) String() string {
+	return proto.CompactTextString(m)
+}
+func (*UpdateMunicipalInflationProposalWithDeposit) ProtoMessage() {}
+func (*UpdateMunicipalInflationProposalWithDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{7}
+}
+func (m *UpdateMunicipalInflationProposalWithDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UpdateMunicipalInflationProposalWithDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UpdateMunicipalInflationProposalWithDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UpdateMunicipalInflationProposalWithDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateMunicipalInflationProposalWithDeposit.Merge(m, src)
+}
+func (m *UpdateMunicipalInflationProposalWithDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *UpdateMunicipalInflationProposalWithDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateMunicipalInflationProposalWithDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateMunicipalInflationProposalWithDeposit proto.InternalMessageInfo
+
+// RemoveMunicipalInflationProposal is a gov Content type for removing municipal
+// inflation entries of the given denominations.
+type RemoveMunicipalInflationProposal struct {
+	Title       string   `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	Description string   `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Denoms      []string `protobuf:"bytes,3,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (m *RemoveMunicipalInflationProposal) Reset()      { *m = RemoveMunicipalInflationProposal{} }
+func (*RemoveMunicipalInflationProposal) ProtoMessage() {}
+func (*RemoveMunicipalInflationProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{8}
+}
+func (m *RemoveMunicipalInflationProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoveMunicipalInflationProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoveMunicipalInflationProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoveMunicipalInflationProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveMunicipalInflationProposal.Merge(m, src)
+}
+func (m *RemoveMunicipalInflationProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoveMunicipalInflationProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveMunicipalInflationProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveMunicipalInflationProposal proto.InternalMessageInfo
+
 // Params holds parameters for the mint module.
 type Params struct {
 	// type of coin to mint
 	MintDenom string `protobuf:"bytes,1,opt,name=mint_denom,json=mintDenom,proto3" json:"mint_denom,omitempty"`
-	// maximum annual change in inflation rate
+	// annual inflation rate, used in the fixed inflation mode
 	InflationRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=inflation_rate,json=inflationRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate" yaml:"inflation_rate"`
+	// maximum inflation rate, used in the bonded ratio inflation mode
+	InflationMax github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=inflation_max,json=inflationMax,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_max" yaml:"inflation_max"`
+	// minimum inflation rate, used in the bonded ratio inflation mode
+	InflationMin github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,4,opt,name=inflation_min,json=inflationMin,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_min" yaml:"inflation_min"`
+	// goal of percent bonded atoms, used in the bonded ratio inflation mode
+	GoalBonded github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=goal_bonded,json=goalBonded,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"goal_bonded" yaml:"goal_bonded"`
 	// expected blocks per year
 	BlocksPerYear uint64 `protobuf:"varint,6,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+	// maximum total supply per denomination, minting stops once the supply
+	// reaches the cap, denominations which are not listed are not capped
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,7,rep,name=max_supply,json=maxSupply,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"max_supply" yaml:"max_supply"`
+	// mechanism determining the annual inflation rate of the mint denomination
+	InflationMode InflationMode `protobuf:"varint,8,opt,name=inflation_mode,json=inflationMode,proto3,enum=cosmos.mint.v1beta1.InflationMode" json:"inflation_mode,omitempty" yaml:"inflation_mode"`
+	// maximum annual change in inflation rate, used in the bonded ratio
+	// inflation mode
+	InflationRateChange github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,9,opt,name=inflation_rate_change,json=inflationRateChange,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"inflation_rate_change" yaml:"inflation_rate_change"`
+	// number of blocks between checkpoints of the total minted amounts, no
+	// checkpoints are recorded if zero
+	CheckpointInterval uint64 `protobuf:"varint,10,opt,name=checkpoint_interval,json=checkpointInterval,proto3" json:"checkpoint_interval,omitempty" yaml:"checkpoint_interval"`
+	// derive the effective blocks per year from observed block times, bounded by
+	// `min_blocks_per_year` and `max_blocks_per_year`, instead of using
+	// `blocks_per_year`, which is used only until the first calibration
+	CalibrateBlocksPerYear bool `protobuf:"varint,11,opt,name=calibrate_blocks_per_year,json=calibrateBlocksPerYear,proto3" json:"calibrate_blocks_per_year,omitempty" yaml:"calibrate_blocks_per_year"`
+	// lower bound of the calibrated blocks per year
+	MinBlocksPerYear uint64 `protobuf:"varint,12,opt,name=min_blocks_per_year,json=minBlocksPerYear,proto3" json:"min_blocks_per_year,omitempty" yaml:"min_blocks_per_year"`
+	// upper bound of the calibrated blocks per year
+	MaxBlocksPerYear uint64 `protobuf:"varint,13,opt,name=max_blocks_per_year,json=maxBlocksPerYear,proto3" json:"max_blocks_per_year,omitempty" yaml:"max_blocks_per_year"`
+	// number of blocks over which block intervals are averaged, the calibrated
+	// blocks per year is recalculated once per window
+	CalibrationWindow uint64 `protobuf:"varint,14,opt,name=calibration_window,json=calibrationWindow,proto3" json:"calibration_window,omitempty" yaml:"calibration_window"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
 func (*Params) ProtoMessage() {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_2df116d183c1e223, []int{3}
+	return fileDescriptor_2df116d183c1e223, []int{9}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -238,70 +711,514 @@ func (m *Params) GetBlocksPerYear() uint64 {
 	return 0
 }
 
-func init() {
-	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
-	proto.RegisterType((*MunicipalInflation)(nil), "cosmos.mint.v1beta1.MunicipalInflation")
-	proto.RegisterType((*MunicipalInflationPair)(nil), "cosmos.mint.v1beta1.MunicipalInflationPair")
-	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+func (m *Params) GetMaxSupply() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.MaxSupply
+	}
+	return nil
 }
 
-func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
+func (m *Params) GetInflationMode() InflationMode {
+	if m != nil {
+		return m.InflationMode
+	}
+	return InflationModeFixed
+}
 
-var fileDescriptor_2df116d183c1e223 = []byte{
-	// 476 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x53, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0xb5, 0x9b, 0x36, 0x52, 0xb6, 0x4a, 0x81, 0x6d, 0xa9, 0x4c, 0x25, 0xec, 0x68, 0x0f, 0x10,
-	0x84, 0xb0, 0xd5, 0x72, 0xeb, 0x09, 0x42, 0x10, 0x02, 0x51, 0x29, 0xf2, 0x0d, 0x84, 0x64, 0x4d,
-	0x9c, 0x25, 0xac, 0x6a, 0xef, 0x5a, 0xbb, 0xeb, 0x40, 0xfe, 0x82, 0x23, 0x07, 0x0e, 0xfc, 0x00,
-	0xff, 0xd1, 0x63, 0x8f, 0x88, 0x83, 0x05, 0xc9, 0x1f, 0xe4, 0x0b, 0x50, 0xbc, 0x49, 0x9a, 0x92,
-	0x0a, 0x91, 0x9e, 0x92, 0x79, 0xf3, 0xe6, 0xcd, 0xcc, 0x1b, 0x2f, 0x72, 0x63, 0xa1, 0x52, 0xa1,
-	0x82, 0x94, 0x71, 0x1d, 0x0c, 0x0e, 0xbb, 0x54, 0xc3, 0x61, 0x19, 0xf8, 0x99, 0x14, 0x5a, 0xe0,
-	0x5d, 0x93, 0xf7, 0x4b, 0x68, 0x96, 0x3f, 0xd8, 0xeb, 0x8b, 0xbe, 0x28, 0xf3, 0xc1, 0xf4, 0x9f,
-	0xa1, 0x92, 0xef, 0x1b, 0xa8, 0x7a, 0xc2, 0xb8, 0xa6, 0x12, 0xbf, 0x46, 0x35, 0xc6, 0xdf, 0x27,
-	0xa0, 0x99, 0xe0, 0x8e, 0xdd, 0xb0, 0x9b, 0xb5, 0x96, 0x7f, 0x56, 0x78, 0xd6, 0xcf, 0xc2, 0xbb,
-	0xd7, 0x67, 0xfa, 0x43, 0xde, 0xf5, 0x63, 0x91, 0x06, 0xb3, 0xde, 0xe6, 0xe7, 0x91, 0xea, 0x9d,
-	0x06, 0x7a, 0x98, 0x51, 0xe5, 0xb7, 0x69, 0x1c, 0x5e, 0x08, 0xe0, 0x8f, 0xe8, 0x16, 0x70, 0x9e,
-	0x43, 0x12, 0x65, 0x52, 0x0c, 0x98, 0x62, 0x82, 0x2b, 0x67, 0xa3, 0x54, 0x7d, 0xb5, 0x9e, 0xea,
-	0xa4, 0xf0, 0x9c, 0x21, 0xa4, 0xc9, 0x31, 0x59, 0x11, 0x24, 0xe1, 0x4d, 0x83, 0x75, 0x16, 0x10,
-	0x7e, 0x87, 0x76, 0xd3, 0x9c, 0xb3, 0x98, 0x65, 0x90, 0x44, 0x17, 0x0b, 0x55, 0x1a, 0x95, 0xe6,
-	0xf6, 0xd1, 0x43, 0xff, 0x0a, 0x6b, 0xfc, 0x93, 0x39, 0xff, 0xe5, 0x9c, 0xde, 0x01, 0x26, 0x43,
-	0x9c, 0xae, 0xe0, 0xe4, 0xab, 0x8d, 0xf0, 0x2a, 0x1d, 0x3f, 0x41, 0x3b, 0x1a, 0x64, 0x9f, 0xea,
-	0x08, 0x7a, 0x3d, 0x49, 0xd5, 0x7c, 0xd5, 0x3b, 0x93, 0xc2, 0xbb, 0x6d, 0x86, 0xbf, 0x9c, 0x27,
-	0x61, 0xdd, 0x00, 0x4f, 0x4d, 0x8c, 0xdb, 0x68, 0x6b, 0x00, 0x49, 0x4e, 0x9d, 0xca, 0xb5, 0x9c,
-	0x37, 0xc5, 0x24, 0x47, 0xfb, 0x57, 0x2f, 0x83, 0xf7, 0xd0, 0x56, 0x8f, 0x72, 0x91, 0x9a, 0xcb,
-	0x86, 0x26, 0xc0, 0xcf, 0x97, 0x6f, 0x3e, 0x1d, 0x79, 0xfb, 0xe8, 0xfe, 0x7f, 0x5a, 0xb4, 0x74,
-	0x6c, 0xf2, 0xdb, 0x46, 0xd5, 0x0e, 0x48, 0x48, 0x15, 0xbe, 0x8b, 0xd0, 0xb4, 0x30, 0x5a, 0x6e,
-	0x56, 0x9b, 0x22, 0xed, 0xb2, 0x21, 0x47, 0x3b, 0x8b, 0xb2, 0x48, 0x82, 0xa6, 0x33, 0xa3, 0x5e,
-	0xac, 0xfd, 0x4d, 0xcc, 0x6c, 0xbd, 0xac, 0x46, 0xc2, 0xfa, 0x02, 0x08, 0x41, 0x53, 0xdc, 0x42,
-	0x37, 0xba, 0x89, 0x88, 0x4f, 0x55, 0x94, 0x51, 0x19, 0x0d, 0x29, 0x48, 0xa7, 0xda, 0xb0, 0x9b,
-	0x9b, 0xad, 0x83, 0x49, 0xe1, 0xed, 0x1b, 0x89, 0xbf, 0x08, 0x24, 0xac, 0x1b, 0xa4, 0x43, 0xe5,
-	0x1b, 0x0a, 0xf2, 0x78, 0xf3, 0xcb, 0x37, 0xcf, 0x6a, 0x3d, 0x3b, 0x1b, 0xb9, 0xf6, 0xf9, 0xc8,
-	0xb5, 0x7f, 0x8d, 0x5c, 0xfb, 0xf3, 0xd8, 0xb5, 0xce, 0xc7, 0xae, 0xf5, 0x63, 0xec, 0x5a, 0x6f,
-	0x1f, 0xfc, 0x73, 0xe6, 0x4f, 0xe6, 0x99, 0x96, 0xa3, 0x77, 0xab, 0xe5, 0xab, 0x7b, 0xfc, 0x27,
-	0x00, 0x00, 0xff, 0xff, 0x65, 0xf2, 0xc8, 0x9b, 0xc2, 0x03, 0x00, 0x00,
+func (m *Params) GetCheckpointInterval() uint64 {
+	if m != nil {
+		return m.CheckpointInterval
+	}
+	return 0
 }
 
-func (m *Minter) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Params) GetCalibrateBlocksPerYear() bool {
+	if m != nil {
+		return m.CalibrateBlocksPerYear
 	}
-	return dAtA[:n], nil
+	return false
 }
 
-func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Params) GetMinBlocksPerYear() uint64 {
+	if m != nil {
+		return m.MinBlocksPerYear
+	}
+	return 0
 }
 
-func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MunicipalInflation) > 0 {
+func (m *Params) GetMaxBlocksPerYear() uint64 {
+	if m != nil {
+		return m.MaxBlocksPerYear
+	}
+	return 0
+}
+
+func (m *Params) GetCalibrationWindow() uint64 {
+	if m != nil {
+		return m.CalibrationWindow
+	}
+	return 0
+}
+
+// RecipientMinted represents the total amount of tokens minted to a recipient.
+type RecipientMinted struct {
+	// bech32 address of the recipient account, or name of the recipient module
+	// account prefixed with "module:"
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// total minted amounts per denomination
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+}
+
+func (m *RecipientMinted) Reset()         { *m = RecipientMinted{} }
+func (m *RecipientMinted) String() string { return proto.CompactTextString(m) }
+func (*RecipientMinted) ProtoMessage()    {}
+func (*RecipientMinted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{10}
+}
+func (m *RecipientMinted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RecipientMinted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RecipientMinted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RecipientMinted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RecipientMinted.Merge(m, src)
+}
+func (m *RecipientMinted) XXX_Size() int {
+	return m.Size()
+}
+func (m *RecipientMinted) XXX_DiscardUnknown() {
+	xxx_messageInfo_RecipientMinted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RecipientMinted proto.InternalMessageInfo
+
+func (m *RecipientMinted) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *RecipientMinted) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+// MintCheckpoint represents the total amounts of tokens minted up to and
+// including the block with the given height.
+type MintCheckpoint struct {
+	// height of the block
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time of the block
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// total minted amounts per denomination
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+}
+
+func (m *MintCheckpoint) Reset()         { *m = MintCheckpoint{} }
+func (m *MintCheckpoint) String() string { return proto.CompactTextString(m) }
+func (*MintCheckpoint) ProtoMessage()    {}
+func (*MintCheckpoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{11}
+}
+func (m *MintCheckpoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintCheckpoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintCheckpoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintCheckpoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintCheckpoint.Merge(m, src)
+}
+func (m *MintCheckpoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintCheckpoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintCheckpoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintCheckpoint proto.InternalMessageInfo
+
+func (m *MintCheckpoint) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *MintCheckpoint) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *MintCheckpoint) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
+}
+
+// MunicipalIssuance records the inputs of the municipal inflation issuance of a
+// denomination in the current block. It is kept in the transient store only, for
+// invariants to verify the issuance.
+type MunicipalIssuance struct {
+	// token denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// supply of the denomination before the issuance
+	Supply types.Coin `protobuf:"bytes,2,opt,name=supply,proto3" json:"supply"`
+	// recipients of the issuance with the amounts minted to them
+	Recipients []MunicipalIssuanceRecipient `protobuf:"bytes,3,rep,name=recipients,proto3" json:"recipients"`
+}
+
+func (m *MunicipalIssuance) Reset()         { *m = MunicipalIssuance{} }
+func (m *MunicipalIssuance) String() string { return proto.CompactTextString(m) }
+func (*MunicipalIssuance) ProtoMessage()    {}
+func (*MunicipalIssuance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{12}
+}
+func (m *MunicipalIssuance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MunicipalIssuance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MunicipalIssuance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MunicipalIssuance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MunicipalIssuance.Merge(m, src)
+}
+func (m *MunicipalIssuance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MunicipalIssuance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MunicipalIssuance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MunicipalIssuance proto.InternalMessageInfo
+
+func (m *MunicipalIssuance) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MunicipalIssuance) GetSupply() types.Coin {
+	if m != nil {
+		return m.Supply
+	}
+	return types.Coin{}
+}
+
+func (m *MunicipalIssuance) GetRecipients() []MunicipalIssuanceRecipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+// MunicipalIssuanceRecipient records the amount of the denomination minted to a
+// target by the municipal inflation issuance.
+type MunicipalIssuanceRecipient struct {
+	// bech32 address of the recipient account, or name of the recipient module
+	// account prefixed with "module:"
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// amount of the denomination minted to the recipient by the issuance
+	Minted github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=minted,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"minted"`
+}
+
+func (m *MunicipalIssuanceRecipient) Reset()         { *m = MunicipalIssuanceRecipient{} }
+func (m *MunicipalIssuanceRecipient) String() string { return proto.CompactTextString(m) }
+func (*MunicipalIssuanceRecipient) ProtoMessage()    {}
+func (*MunicipalIssuanceRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{13}
+}
+func (m *MunicipalIssuanceRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MunicipalIssuanceRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MunicipalIssuanceRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MunicipalIssuanceRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MunicipalIssuanceRecipient.Merge(m, src)
+}
+func (m *MunicipalIssuanceRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MunicipalIssuanceRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MunicipalIssuanceRecipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MunicipalIssuanceRecipient proto.InternalMessageInfo
+
+func (m *MunicipalIssuanceRecipient) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// BlockTimeObservation holds the moving average of intervals between blocks,
+// used to calibrate the effective blocks per year.
+type BlockTimeObservation struct {
+	// time of the most recent observed block
+	LastBlockTime time.Time `protobuf:"bytes,1,opt,name=last_block_time,json=lastBlockTime,proto3,stdtime" json:"last_block_time" yaml:"last_block_time"`
+	// moving average of intervals between blocks, in seconds
+	AverageBlockInterval github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=average_block_interval,json=averageBlockInterval,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_block_interval" yaml:"average_block_interval"`
+	// number of observed intervals between blocks
+	Samples uint64 `protobuf:"varint,3,opt,name=samples,proto3" json:"samples,omitempty"`
+	// calibrated blocks per year, zero until the first calibration
+	BlocksPerYear uint64 `protobuf:"varint,4,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty" yaml:"blocks_per_year"`
+}
+
+func (m *BlockTimeObservation) Reset()         { *m = BlockTimeObservation{} }
+func (m *BlockTimeObservation) String() string { return proto.CompactTextString(m) }
+func (*BlockTimeObservation) ProtoMessage()    {}
+func (*BlockTimeObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2df116d183c1e223, []int{14}
+}
+func (m *BlockTimeObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockTimeObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockTimeObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockTimeObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockTimeObservation.Merge(m, src)
+}
+func (m *BlockTimeObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockTimeObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockTimeObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockTimeObservation proto.InternalMessageInfo
+
+func (m *BlockTimeObservation) GetLastBlockTime() time.Time {
+	if m != nil {
+		return m.LastBlockTime
+	}
+	return time.Time{}
+}
+
+func (m *BlockTimeObservation) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
+}
+
+func (m *BlockTimeObservation) GetBlocksPerYear() uint64 {
+	if m != nil {
+		return m.BlocksPerYear
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("cosmos.mint.v1beta1.MunicipalInflationMode", MunicipalInflationMode_name, MunicipalInflationMode_value)
+	proto.RegisterEnum("cosmos.mint.v1beta1.InflationMode", InflationMode_name, InflationMode_value)
+	proto.RegisterType((*Minter)(nil), "cosmos.mint.v1beta1.Minter")
+	proto.RegisterType((*MunicipalInflation)(nil), "cosmos.mint.v1beta1.MunicipalInflation")
+	proto.RegisterType((*MunicipalInflationSchedule)(nil), "cosmos.mint.v1beta1.MunicipalInflationSchedule")
+	proto.RegisterType((*MunicipalInflationStep)(nil), "cosmos.mint.v1beta1.MunicipalInflationStep")
+	proto.RegisterType((*MunicipalInflationTarget)(nil), "cosmos.mint.v1beta1.MunicipalInflationTarget")
+	proto.RegisterType((*MunicipalInflationPair)(nil), "cosmos.mint.v1beta1.MunicipalInflationPair")
+	proto.RegisterType((*UpdateMunicipalInflationProposal)(nil), "cosmos.mint.v1beta1.UpdateMunicipalInflationProposal")
+	proto.RegisterType((*UpdateMunicipalInflationProposalWithDeposit)(nil), "cosmos.mint.v1beta1.UpdateMunicipalInflationProposalWithDeposit")
+	proto.RegisterType((*RemoveMunicipalInflationProposal)(nil), "cosmos.mint.v1beta1.RemoveMunicipalInflationProposal")
+	proto.RegisterType((*Params)(nil), "cosmos.mint.v1beta1.Params")
+	proto.RegisterType((*RecipientMinted)(nil), "cosmos.mint.v1beta1.RecipientMinted")
+	proto.RegisterType((*MintCheckpoint)(nil), "cosmos.mint.v1beta1.MintCheckpoint")
+	proto.RegisterType((*MunicipalIssuance)(nil), "cosmos.mint.v1beta1.MunicipalIssuance")
+	proto.RegisterType((*MunicipalIssuanceRecipient)(nil), "cosmos.mint.v1beta1.MunicipalIssuanceRecipient")
+	proto.RegisterType((*BlockTimeObservation)(nil), "cosmos.mint.v1beta1.BlockTimeObservation")
+}
+
+func init() { proto.RegisterFile("cosmos/mint/v1beta1/mint.proto", fileDescriptor_2df116d183c1e223) }
+
+var fileDescriptor_2df116d183c1e223 = []byte{
+	// 1714 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcd, 0x6f, 0x23, 0x49,
+	0x15, 0x77, 0x3b, 0x8e, 0x13, 0xbf, 0x7c, 0x4c, 0x52, 0xc9, 0x98, 0x8e, 0x99, 0x71, 0x5b, 0x25,
+	0xb4, 0x84, 0x5d, 0xd6, 0xd9, 0x1d, 0x90, 0x58, 0x45, 0x42, 0x30, 0x1d, 0x27, 0x83, 0x61, 0xf2,
+	0xa1, 0x9a, 0x8c, 0x16, 0x10, 0x52, 0xab, 0xdc, 0x5d, 0xe3, 0xb4, 0xa6, 0xbf, 0xd4, 0xdd, 0xf6,
+	0x38, 0x12, 0x12, 0x68, 0x25, 0xd0, 0x6a, 0x4e, 0x7b, 0xe0, 0xc0, 0x65, 0xa4, 0x91, 0xe0, 0x84,
+	0xe0, 0x0f, 0xe0, 0x0f, 0x40, 0xec, 0x71, 0x8f, 0x88, 0x83, 0x17, 0xcd, 0x20, 0xc4, 0x89, 0x83,
+	0xf9, 0x07, 0x50, 0x57, 0x75, 0xb7, 0xdb, 0x4e, 0xe7, 0xc3, 0xcb, 0xae, 0xb4, 0xa7, 0xe4, 0xbd,
+	0x7a, 0xef, 0xf7, 0xde, 0xab, 0xf7, 0x51, 0xaf, 0x0d, 0x75, 0xdd, 0x0d, 0x6c, 0x37, 0xd8, 0xb1,
+	0x4d, 0x27, 0xdc, 0xe9, 0xbf, 0xdb, 0x61, 0x21, 0x7d, 0x97, 0x13, 0x4d, 0xcf, 0x77, 0x43, 0x17,
+	0x6d, 0x88, 0xf3, 0x26, 0x67, 0xc5, 0xe7, 0xb5, 0xcd, 0xae, 0xdb, 0x75, 0xf9, 0xf9, 0x4e, 0xf4,
+	0x9f, 0x10, 0xad, 0x29, 0x5d, 0xd7, 0xed, 0x5a, 0x6c, 0x87, 0x53, 0x9d, 0xde, 0x93, 0x9d, 0xd0,
+	0xb4, 0x59, 0x10, 0x52, 0xdb, 0x8b, 0x05, 0x12, 0x5b, 0x1d, 0x1a, 0xb0, 0xd4, 0x96, 0xee, 0x9a,
+	0x8e, 0x38, 0xc7, 0x7f, 0x2a, 0x42, 0xf9, 0xd0, 0x74, 0x42, 0xe6, 0xa3, 0x87, 0x50, 0x31, 0x9d,
+	0x27, 0x16, 0x0d, 0x4d, 0xd7, 0x91, 0xa5, 0x86, 0xb4, 0x5d, 0x51, 0x9b, 0x1f, 0x0f, 0x95, 0xc2,
+	0xdf, 0x87, 0xca, 0x1b, 0x5d, 0x33, 0x3c, 0xeb, 0x75, 0x9a, 0xba, 0x6b, 0xef, 0xc4, 0x80, 0xe2,
+	0xcf, 0xdb, 0x81, 0xf1, 0x74, 0x27, 0x3c, 0xf7, 0x58, 0xd0, 0x6c, 0x31, 0x9d, 0x8c, 0x01, 0xd0,
+	0x33, 0x58, 0xa7, 0x8e, 0xd3, 0xa3, 0x96, 0xe6, 0xf9, 0x6e, 0xdf, 0x0c, 0x4c, 0xd7, 0x09, 0xe4,
+	0x22, 0x47, 0xfd, 0xe1, 0x6c, 0xa8, 0xa3, 0xa1, 0x22, 0x9f, 0x53, 0xdb, 0xda, 0xc5, 0x17, 0x00,
+	0x31, 0x59, 0x13, 0xbc, 0x93, 0x94, 0x85, 0x7e, 0x06, 0x1b, 0x76, 0xcf, 0x31, 0x75, 0xd3, 0xa3,
+	0x96, 0x36, 0x0e, 0x68, 0xae, 0x31, 0xb7, 0xbd, 0x74, 0xef, 0xad, 0x66, 0xce, 0xdd, 0x36, 0x0f,
+	0x13, 0xf9, 0x76, 0x22, 0x7e, 0x42, 0x4d, 0x9f, 0x20, 0xfb, 0x02, 0x1f, 0xff, 0xb7, 0x08, 0xe8,
+	0xa2, 0x38, 0xfa, 0x3e, 0xac, 0x86, 0xd4, 0xef, 0xb2, 0x50, 0xa3, 0x86, 0xe1, 0xb3, 0x20, 0x09,
+	0x75, 0x6b, 0x34, 0x54, 0x6e, 0x0b, 0xe7, 0x27, 0xcf, 0x31, 0x59, 0x11, 0x8c, 0xfb, 0x82, 0x46,
+	0x2d, 0x98, 0xef, 0x53, 0xab, 0xc7, 0xe4, 0xb9, 0xcf, 0x74, 0xf3, 0x42, 0x19, 0x3d, 0x80, 0x05,
+	0x01, 0x1b, 0xc8, 0x25, 0x1e, 0xf0, 0xdb, 0x37, 0x0c, 0xf8, 0x94, 0x6b, 0x91, 0x44, 0x1b, 0xfd,
+	0x08, 0x16, 0x03, 0xfd, 0x8c, 0x19, 0x3d, 0x8b, 0xc9, 0xf3, 0x0d, 0x69, 0x7b, 0xe9, 0xde, 0xce,
+	0x0d, 0x91, 0x1e, 0xc5, 0x6a, 0x24, 0x05, 0x40, 0xdf, 0x83, 0x92, 0xed, 0x1a, 0x4c, 0x2e, 0x37,
+	0xa4, 0xed, 0xd5, 0x1b, 0xe7, 0xe0, 0xd0, 0x35, 0x18, 0xe1, 0x8a, 0xf8, 0x3f, 0x25, 0xa8, 0x5d,
+	0x6e, 0x09, 0xed, 0xc2, 0x72, 0x10, 0x52, 0x3f, 0xd4, 0xce, 0x98, 0xd9, 0x3d, 0x0b, 0x79, 0xf1,
+	0xce, 0xa9, 0x5f, 0x19, 0x0d, 0x95, 0x0d, 0x71, 0xf7, 0xd9, 0x53, 0x4c, 0x96, 0x38, 0xf9, 0x03,
+	0x4e, 0xa1, 0x6f, 0x03, 0x30, 0xc7, 0x48, 0x34, 0x8b, 0x5c, 0xf3, 0xf6, 0x68, 0xa8, 0xac, 0x0b,
+	0xcd, 0xf1, 0x19, 0x26, 0x15, 0xe6, 0x18, 0xb1, 0xd6, 0x29, 0x80, 0xc0, 0x8c, 0xfa, 0x8d, 0xa7,
+	0x6c, 0xe9, 0x5e, 0xad, 0x29, 0x9a, 0xb1, 0x99, 0x34, 0x63, 0xf3, 0x34, 0x69, 0x46, 0x75, 0x6b,
+	0x8c, 0x38, 0xd6, 0xc3, 0x1f, 0x7d, 0xaa, 0x48, 0xa4, 0xc2, 0x19, 0x91, 0x28, 0x3a, 0x82, 0xc5,
+	0xc8, 0x1e, 0xc7, 0x2c, 0x5d, 0x8b, 0x19, 0xc5, 0x77, 0x6b, 0xec, 0xe5, 0x18, 0x71, 0x81, 0x39,
+	0x06, 0xc7, 0x7b, 0x00, 0xf3, 0x41, 0xc8, 0xbc, 0x40, 0x9e, 0x9f, 0xa9, 0xf8, 0x1f, 0x85, 0xcc,
+	0x53, 0x4b, 0x51, 0x01, 0x12, 0xa1, 0x1f, 0x5d, 0xb0, 0xc1, 0x74, 0x7a, 0xae, 0x79, 0xcc, 0x37,
+	0x5d, 0x83, 0x27, 0xb2, 0x94, 0xbd, 0xe0, 0xec, 0x29, 0x26, 0x4b, 0x9c, 0x3c, 0xe1, 0x14, 0x3a,
+	0x4b, 0x74, 0x9f, 0x50, 0x3d, 0x74, 0x7d, 0x79, 0x81, 0xd7, 0xf7, 0xfe, 0xcc, 0x33, 0x60, 0xc2,
+	0x92, 0xc0, 0x4a, 0x2c, 0x1d, 0x70, 0x0a, 0xb5, 0x61, 0x9d, 0xea, 0xa1, 0xd9, 0xe7, 0x41, 0x24,
+	0x19, 0x5d, 0xe4, 0x19, 0xbd, 0x93, 0x19, 0x22, 0xd3, 0x22, 0xd1, 0x10, 0x49, 0x79, 0x22, 0xbf,
+	0xb8, 0x0f, 0xd5, 0xfc, 0x7b, 0x41, 0x55, 0x28, 0x67, 0xab, 0x8c, 0xc4, 0xd4, 0xb8, 0x7f, 0x8b,
+	0xff, 0x47, 0xff, 0xe2, 0xdf, 0x48, 0x20, 0x5f, 0xd6, 0x9c, 0x48, 0x86, 0x85, 0x64, 0xba, 0xf0,
+	0xf1, 0x4c, 0x12, 0x32, 0x72, 0xca, 0x76, 0x79, 0xaf, 0x72, 0xeb, 0x24, 0xa6, 0xd0, 0x01, 0x94,
+	0x9f, 0x09, 0x67, 0x3f, 0xdb, 0x54, 0x89, 0xb5, 0x71, 0x0f, 0xaa, 0xf9, 0x33, 0x12, 0x6d, 0xc2,
+	0xbc, 0xc1, 0x1c, 0xd7, 0x8e, 0x3d, 0x12, 0x04, 0xda, 0xcf, 0x3e, 0x25, 0x45, 0x5e, 0xc9, 0x5f,
+	0xbf, 0x61, 0xf1, 0x65, 0xde, 0x10, 0xfc, 0x2f, 0x09, 0x1a, 0x8f, 0x3d, 0x83, 0x86, 0x2c, 0xc7,
+	0xba, 0xef, 0x7a, 0x6e, 0x40, 0xad, 0xc8, 0x83, 0xd0, 0x0c, 0x2d, 0x96, 0x78, 0xc0, 0x09, 0xd4,
+	0x80, 0x25, 0x83, 0x05, 0xba, 0x6f, 0x7a, 0xa9, 0x0f, 0x15, 0x92, 0x65, 0xa1, 0x9f, 0x7f, 0x5e,
+	0xef, 0x84, 0x5a, 0x1f, 0x0d, 0x95, 0x9a, 0x28, 0xae, 0x1c, 0x44, 0x9c, 0xf7, 0x8e, 0xec, 0x2e,
+	0x7f, 0xf8, 0x52, 0x29, 0xfc, 0xf6, 0xa5, 0x52, 0xf8, 0xf7, 0x4b, 0xa5, 0x80, 0xff, 0x52, 0x84,
+	0xb7, 0xae, 0x0b, 0xf4, 0x7d, 0x33, 0x3c, 0x6b, 0x31, 0xcf, 0x0d, 0xcc, 0x10, 0xbd, 0x31, 0x11,
+	0xb3, 0xba, 0x36, 0x1a, 0x2a, 0xcb, 0xf1, 0x2b, 0x13, 0xb1, 0x71, 0x72, 0x0b, 0xef, 0xe5, 0xdc,
+	0x82, 0x5a, 0x1d, 0x0d, 0x15, 0x94, 0x34, 0x53, 0x7a, 0x88, 0xbf, 0x44, 0xb7, 0x83, 0xbe, 0x09,
+	0x0b, 0x86, 0x08, 0x95, 0xcf, 0xc1, 0x8a, 0x8a, 0x46, 0x43, 0x65, 0x35, 0xf1, 0x99, 0x1f, 0x60,
+	0x92, 0x88, 0xec, 0x2e, 0xc6, 0x77, 0x29, 0xe1, 0x5f, 0x4a, 0xd0, 0x20, 0xcc, 0x76, 0xfb, 0x5f,
+	0x44, 0xc1, 0x54, 0xa1, 0xcc, 0xab, 0x3b, 0xe0, 0xb7, 0x50, 0x21, 0x31, 0x35, 0x95, 0xca, 0x3f,
+	0x02, 0x94, 0x4f, 0xa8, 0x4f, 0xed, 0x00, 0xdd, 0x05, 0x88, 0x2e, 0x48, 0xcb, 0x36, 0x48, 0x25,
+	0xe2, 0xb4, 0x78, 0x93, 0x38, 0xb0, 0x9a, 0x5e, 0x83, 0xe6, 0xd3, 0x30, 0x19, 0x1d, 0x0f, 0x66,
+	0x1e, 0x8d, 0xf1, 0x86, 0x31, 0x89, 0x86, 0xc9, 0x4a, 0xca, 0x20, 0x34, 0x64, 0xe8, 0x29, 0x8c,
+	0x19, 0x9a, 0x4d, 0x07, 0xf1, 0x4c, 0x38, 0x98, 0xd9, 0xdc, 0xe6, 0xb4, 0x39, 0x9b, 0x0e, 0x30,
+	0x59, 0x4e, 0xe9, 0x43, 0x3a, 0x98, 0x32, 0x66, 0x3a, 0x72, 0xe9, 0x73, 0x33, 0x66, 0x3a, 0x13,
+	0xc6, 0x4c, 0x07, 0x31, 0x58, 0xea, 0xba, 0xd4, 0xd2, 0x3a, 0xae, 0x63, 0x30, 0x83, 0xef, 0x2b,
+	0x15, 0xb5, 0x35, 0xb3, 0xa9, 0xb8, 0x29, 0x32, 0x50, 0x98, 0x40, 0x44, 0xa9, 0x9c, 0x40, 0x2a,
+	0xdc, 0xea, 0x58, 0xae, 0xfe, 0x34, 0x88, 0x1e, 0x3a, 0xed, 0x9c, 0x51, 0x3f, 0x7e, 0x08, 0x6b,
+	0xa3, 0xa1, 0x52, 0x15, 0xca, 0x53, 0x02, 0x98, 0xac, 0x08, 0xce, 0x09, 0xf3, 0x7f, 0xc2, 0xa8,
+	0x8f, 0x7e, 0x01, 0x60, 0xd3, 0x81, 0x16, 0xf4, 0x3c, 0xcf, 0x3a, 0x97, 0x17, 0x78, 0x3b, 0x6d,
+	0x25, 0xed, 0x14, 0x2d, 0xe9, 0x69, 0x3b, 0xed, 0xb9, 0xa6, 0x23, 0x9e, 0xc9, 0xf1, 0xee, 0x30,
+	0x56, 0xc5, 0x7f, 0xf8, 0x54, 0xd9, 0xbe, 0x41, 0x64, 0x11, 0x4a, 0x40, 0x2a, 0x36, 0x1d, 0x3c,
+	0xe2, 0x7a, 0xc8, 0xc8, 0x56, 0x1d, 0xdf, 0xca, 0x16, 0xf9, 0x56, 0x86, 0x73, 0x7b, 0x7a, 0x62,
+	0x19, 0xcb, 0x6e, 0xb3, 0x93, 0x18, 0xd9, 0x5a, 0x8b, 0x24, 0xd1, 0x07, 0x12, 0xdc, 0x9e, 0x2c,
+	0x47, 0x4d, 0x3f, 0xa3, 0x4e, 0x97, 0xc9, 0x15, 0x9e, 0x9c, 0xa3, 0x99, 0x93, 0x73, 0x27, 0xaf,
+	0xc6, 0x63, 0x50, 0x4c, 0x36, 0x26, 0x4a, 0x7d, 0x8f, 0x73, 0xd1, 0x31, 0x6c, 0xe8, 0x67, 0x4c,
+	0x7f, 0xea, 0xb9, 0x51, 0x17, 0xf2, 0x8f, 0x9c, 0x3e, 0xb5, 0x64, 0xe0, 0x39, 0xcb, 0x8c, 0xa5,
+	0x1c, 0x21, 0x4c, 0xd0, 0x98, 0xdb, 0x8e, 0x99, 0x48, 0x83, 0x2d, 0x9d, 0x5a, 0x66, 0x87, 0x9b,
+	0x9e, 0x2e, 0x85, 0xa5, 0x86, 0xb4, 0xbd, 0xa8, 0x7e, 0x6d, 0x34, 0x54, 0x1a, 0x31, 0xec, 0x65,
+	0xa2, 0x98, 0x54, 0xd3, 0x33, 0x75, 0xa2, 0x3a, 0x0e, 0x61, 0xc3, 0x36, 0x9d, 0x0b, 0xd0, 0xcb,
+	0xd3, 0x1e, 0xe7, 0x08, 0x61, 0xb2, 0x66, 0x9b, 0xce, 0x45, 0x38, 0x3a, 0xb8, 0x00, 0xb7, 0x72,
+	0x01, 0x8e, 0x0e, 0xf2, 0xe0, 0xe8, 0x60, 0x12, 0xee, 0x21, 0xa0, 0xc4, 0xef, 0x28, 0x01, 0xcf,
+	0x4c, 0xc7, 0x70, 0x9f, 0xc9, 0xab, 0x1c, 0xed, 0xee, 0x68, 0xa8, 0x6c, 0x4d, 0xc6, 0x3d, 0x96,
+	0xc1, 0x64, 0x3d, 0xc3, 0x7c, 0x9f, 0xf3, 0x76, 0x4b, 0xd1, 0xc8, 0x8c, 0x16, 0x9e, 0x5b, 0x84,
+	0xe9, 0xa6, 0x67, 0x32, 0x27, 0xe4, 0x1f, 0xa2, 0x06, 0xba, 0x03, 0x15, 0x3f, 0x61, 0x25, 0x63,
+	0x33, 0x65, 0x20, 0x1d, 0xca, 0x36, 0x97, 0x93, 0x8b, 0xd7, 0x75, 0xcf, 0x3b, 0x51, 0x95, 0xcd,
+	0xd4, 0x28, 0x31, 0x34, 0xfe, 0xab, 0x04, 0xab, 0x91, 0x37, 0x7b, 0x69, 0x11, 0x5c, 0xba, 0xf8,
+	0xbd, 0x07, 0x25, 0xbe, 0xb0, 0x17, 0xaf, 0x5d, 0xd8, 0x17, 0x23, 0x77, 0xf8, 0x86, 0xce, 0x35,
+	0x32, 0x91, 0xcc, 0x7d, 0x71, 0x91, 0xfc, 0x59, 0x82, 0xf5, 0xf1, 0x63, 0x18, 0x04, 0x3d, 0xea,
+	0xe8, 0xec, 0x92, 0xb5, 0xed, 0x3b, 0x50, 0x8e, 0x07, 0x93, 0x08, 0xe6, 0x0a, 0x87, 0xc4, 0xe7,
+	0x41, 0x2c, 0x8e, 0x1e, 0x03, 0xa4, 0x09, 0x0a, 0xe2, 0x68, 0xae, 0xfb, 0x5e, 0x8c, 0x5d, 0x49,
+	0x93, 0x1f, 0x43, 0x66, 0x80, 0xf0, 0x07, 0x12, 0xd4, 0x2e, 0x57, 0xb8, 0xa6, 0x4e, 0x0e, 0x32,
+	0x75, 0x32, 0xeb, 0xee, 0xdb, 0x76, 0xc2, 0xf4, 0x02, 0xff, 0x59, 0x84, 0x4d, 0xde, 0x07, 0x51,
+	0x22, 0x8f, 0x3b, 0x41, 0x34, 0x0a, 0xf8, 0x3e, 0xf0, 0x04, 0x6e, 0x59, 0x34, 0x08, 0x45, 0xe7,
+	0x88, 0x8f, 0x36, 0xe9, 0xda, 0x1a, 0xc0, 0xf1, 0x40, 0x8f, 0x9f, 0x8b, 0x29, 0x00, 0xf1, 0xfd,
+	0xb6, 0x12, 0x71, 0x53, 0x93, 0xe8, 0x57, 0x12, 0x54, 0x69, 0x9f, 0xf9, 0xb4, 0x1b, 0x4f, 0x92,
+	0xf1, 0x28, 0x13, 0x91, 0x1d, 0xcf, 0x3c, 0x4c, 0xef, 0x0a, 0xeb, 0xf9, 0xa8, 0x98, 0x6c, 0xc6,
+	0x07, 0xdc, 0x87, 0x74, 0xfa, 0xc9, 0xb0, 0x10, 0x50, 0xdb, 0xb3, 0x58, 0xc0, 0x37, 0x87, 0x12,
+	0x49, 0xc8, 0xbc, 0x87, 0xb1, 0x34, 0xe3, 0xc3, 0xf8, 0xe6, 0xef, 0xa5, 0xbc, 0x6f, 0x0c, 0xfe,
+	0x98, 0xdc, 0x87, 0xbb, 0x87, 0x8f, 0x8f, 0xda, 0x7b, 0xed, 0x93, 0xfb, 0x0f, 0xb5, 0xf6, 0xd1,
+	0xc1, 0xc3, 0xfb, 0xa7, 0xed, 0xe3, 0x23, 0xed, 0xf0, 0xb8, 0xb5, 0xaf, 0x1d, 0xb6, 0x8f, 0x4e,
+	0xd7, 0x0a, 0xb5, 0xfa, 0xf3, 0x17, 0x8d, 0x5a, 0xbe, 0x7a, 0xd4, 0xc6, 0x57, 0x42, 0xa8, 0x8f,
+	0xc9, 0xd1, 0x9a, 0x74, 0x15, 0x84, 0xda, 0xf3, 0x9d, 0x5a, 0xe9, 0xc3, 0xdf, 0xd5, 0x0b, 0x6f,
+	0xfe, 0x5a, 0x82, 0x95, 0x49, 0xef, 0xde, 0x81, 0xcd, 0x29, 0xc0, 0x83, 0xf6, 0x8f, 0xf7, 0x5b,
+	0x6b, 0x85, 0x5a, 0xf5, 0xf9, 0x8b, 0x06, 0x9a, 0x10, 0x3e, 0x30, 0x07, 0xcc, 0x40, 0xdf, 0x85,
+	0xaf, 0x4e, 0xbb, 0x70, 0x7c, 0xd4, 0xda, 0x6f, 0x69, 0x24, 0x62, 0xad, 0x49, 0xb5, 0x3b, 0xcf,
+	0x5f, 0x34, 0xe4, 0x49, 0x0f, 0xf8, 0x06, 0x42, 0x22, 0x5a, 0x38, 0xa2, 0xee, 0x7d, 0xfc, 0xaa,
+	0x2e, 0x7d, 0xf2, 0xaa, 0x2e, 0xfd, 0xe3, 0x55, 0x5d, 0xfa, 0xe8, 0x75, 0xbd, 0xf0, 0xc9, 0xeb,
+	0x7a, 0xe1, 0x6f, 0xaf, 0xeb, 0x85, 0x9f, 0x7e, 0xe3, 0xca, 0x32, 0x18, 0x88, 0x9f, 0x1d, 0x79,
+	0x35, 0x74, 0xca, 0xbc, 0x42, 0xbf, 0xf5, 0xbf, 0x01, 0x00, 0x78, 0x31, 0x7c, 0x90, 0x92, 0x14,
+	0x00, 0x00,
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MunicipalInflation) > 0 {
 		for iNdEx := len(m.MunicipalInflation) - 1; iNdEx >= 0; iNdEx-- {
 			{
 				size, err := m.MunicipalInflation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
@@ -358,6 +1275,37 @@ func (m *MunicipalInflation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Mode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Targets) > 0 {
+		for iNdEx := len(m.Targets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Targets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	{
 		size := m.Value.Size()
 		i -= size
@@ -378,7 +1326,7 @@ func (m *MunicipalInflation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MunicipalInflationPair) Marshal() (dAtA []byte, err error) {
+func (m *MunicipalInflationSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -388,39 +1336,84 @@ func (m *MunicipalInflationPair) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MunicipalInflationPair) MarshalTo(dAtA []byte) (int, error) {
+func (m *MunicipalInflationSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MunicipalInflationPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MunicipalInflationSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Inflation != nil {
-		{
-			size, err := m.Inflation.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMint(dAtA, i, uint64(size))
-		}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.ActivationHeight))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x40
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+	{
+		size := m.DecayFactor.Size()
+		i -= size
+		if _, err := m.DecayFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
-	return len(dAtA) - i, nil
+	i--
+	dAtA[i] = 0x3a
+	if m.DecayPeriod != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.DecayPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Steps) > 0 {
+		for iNdEx := len(m.Steps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Steps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if m.EndTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.EndTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintMint(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.StartTime != nil {
+		n3, err3 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.StartTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintMint(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Params) Marshal() (dAtA []byte, err error) {
+func (m *MunicipalInflationStep) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -430,128 +1423,2906 @@ func (m *Params) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+func (m *MunicipalInflationStep) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MunicipalInflationStep) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.BlocksPerYear != 0 {
-		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
+	{
+		size := m.Value.Size()
+		i -= size
+		if _, err := m.Value.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
 		i--
-		dAtA[i] = 0x30
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MunicipalInflationTarget) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MunicipalInflationTarget) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MunicipalInflationTarget) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
 	{
-		size := m.InflationRate.Size()
+		size := m.Weight.Size()
 		i -= size
-		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintMint(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x12
-	if len(m.MintDenom) > 0 {
-		i -= len(m.MintDenom)
-		copy(dAtA[i:], m.MintDenom)
-		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+	dAtA[i] = 0x1a
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Address)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMint(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MunicipalInflationPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Minter) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *MunicipalInflationPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MunicipalInflationPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Inflation != nil {
+		{
+			size, err := m.Inflation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMint(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpdateMunicipalInflationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMunicipalInflationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateMunicipalInflationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovMint(uint64(l))
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovMint(uint64(l))
 	if len(m.MunicipalInflation) > 0 {
-		for _, e := range m.MunicipalInflation {
-			l = e.Size()
-			n += 1 + l + sovMint(uint64(l))
+		for iNdEx := len(m.MunicipalInflation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MunicipalInflation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	return n
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MunicipalInflation) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *UpdateMunicipalInflationProposalWithDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *UpdateMunicipalInflationProposalWithDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpdateMunicipalInflationProposalWithDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.TargetAddress)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	if len(m.Deposit) > 0 {
+		i -= len(m.Deposit)
+		copy(dAtA[i:], m.Deposit)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Deposit)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = m.Value.Size()
-	n += 1 + l + sovMint(uint64(l))
-	return n
+	if len(m.MunicipalInflation) > 0 {
+		for iNdEx := len(m.MunicipalInflation) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MunicipalInflation[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MunicipalInflationPair) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *RemoveMunicipalInflationProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoveMunicipalInflationProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoveMunicipalInflationProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintMint(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
 	}
-	if m.Inflation != nil {
-		l = m.Inflation.Size()
-		n += 1 + l + sovMint(uint64(l))
+	if len(m.Description) > 0 {
+		i -= len(m.Description)
+		copy(dAtA[i:], m.Description)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Description)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Title) > 0 {
+		i -= len(m.Title)
+		copy(dAtA[i:], m.Title)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Title)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CalibrationWindow != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.CalibrationWindow))
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.MaxBlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MaxBlocksPerYear))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MinBlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.MinBlocksPerYear))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.CalibrateBlocksPerYear {
+		i--
+		if m.CalibrateBlocksPerYear {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.CheckpointInterval != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.CheckpointInterval))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size := m.InflationRateChange.Size()
+		i -= size
+		if _, err := m.InflationRateChange.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.InflationMode != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.InflationMode))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.MaxSupply) > 0 {
+		for iNdEx := len(m.MaxSupply) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MaxSupply[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size := m.GoalBonded.Size()
+		i -= size
+		if _, err := m.GoalBonded.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.InflationMin.Size()
+		i -= size
+		if _, err := m.InflationMin.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.InflationMax.Size()
+		i -= size
+		if _, err := m.InflationMax.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.InflationRate.Size()
+		i -= size
+		if _, err := m.InflationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MintDenom) > 0 {
+		i -= len(m.MintDenom)
+		copy(dAtA[i:], m.MintDenom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.MintDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RecipientMinted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RecipientMinted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RecipientMinted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MintCheckpoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintCheckpoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintCheckpoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n5, err5 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.Time):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintMint(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MunicipalIssuance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MunicipalIssuance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MunicipalIssuance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMint(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size, err := m.Supply.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MunicipalIssuanceRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MunicipalIssuanceRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MunicipalIssuanceRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Minted.Size()
+		i -= size
+		if _, err := m.Minted.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMint(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockTimeObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockTimeObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockTimeObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Samples != 0 {
+		i = encodeVarintMint(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.AverageBlockInterval.Size()
+		i -= size
+		if _, err := m.AverageBlockInterval.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMint(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	n7, err7 := github_com_gogo_protobuf_types.StdTimeMarshalTo(m.LastBlockTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime):])
+	if err7 != nil {
+		return 0, err7
+	}
+	i -= n7
+	i = encodeVarintMint(dAtA, i, uint64(n7))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMint(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMint(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.MunicipalInflation) > 0 {
+		for _, e := range m.MunicipalInflation {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MunicipalInflation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TargetAddress)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Targets) > 0 {
+		for _, e := range m.Targets {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.Mode != 0 {
+		n += 1 + sovMint(uint64(m.Mode))
+	}
+	return n
+}
+
+func (m *MunicipalInflationSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StartHeight != 0 {
+		n += 1 + sovMint(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovMint(uint64(m.EndHeight))
+	}
+	if m.StartTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.StartTime)
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.EndTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.EndTime)
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Steps) > 0 {
+		for _, e := range m.Steps {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.DecayPeriod != 0 {
+		n += 1 + sovMint(uint64(m.DecayPeriod))
+	}
+	l = m.DecayFactor.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.ActivationHeight != 0 {
+		n += 1 + sovMint(uint64(m.ActivationHeight))
+	}
+	return n
+}
+
+func (m *MunicipalInflationStep) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = m.Value.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MunicipalInflationTarget) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *MunicipalInflationPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if m.Inflation != nil {
+		l = m.Inflation.Size()
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *UpdateMunicipalInflationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.MunicipalInflation) > 0 {
+		for _, e := range m.MunicipalInflation {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UpdateMunicipalInflationProposalWithDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.MunicipalInflation) > 0 {
+		for _, e := range m.MunicipalInflation {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	l = len(m.Deposit)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoveMunicipalInflationProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Title)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintDenom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.InflationRate.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMax.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.InflationMin.Size()
+	n += 1 + l + sovMint(uint64(l))
+	l = m.GoalBonded.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	if len(m.MaxSupply) > 0 {
+		for _, e := range m.MaxSupply {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	if m.InflationMode != 0 {
+		n += 1 + sovMint(uint64(m.InflationMode))
+	}
+	l = m.InflationRateChange.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.CheckpointInterval != 0 {
+		n += 1 + sovMint(uint64(m.CheckpointInterval))
+	}
+	if m.CalibrateBlocksPerYear {
+		n += 2
+	}
+	if m.MinBlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.MinBlocksPerYear))
+	}
+	if m.MaxBlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.MaxBlocksPerYear))
+	}
+	if m.CalibrationWindow != 0 {
+		n += 1 + sovMint(uint64(m.CalibrationWindow))
+	}
+	return n
+}
+
+func (m *RecipientMinted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MintCheckpoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovMint(uint64(m.Height))
+	}
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MunicipalIssuance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Supply.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovMint(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MunicipalIssuanceRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMint(uint64(l))
+	}
+	l = m.Minted.Size()
+	n += 1 + l + sovMint(uint64(l))
+	return n
+}
+
+func (m *BlockTimeObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_gogo_protobuf_types.SizeOfStdTime(m.LastBlockTime)
+	n += 1 + l + sovMint(uint64(l))
+	l = m.AverageBlockInterval.Size()
+	n += 1 + l + sovMint(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovMint(uint64(m.Samples))
+	}
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	}
+	return n
+}
+
+func sovMint(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMint(x uint64) (n int) {
+	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MunicipalInflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MunicipalInflation = append(m.MunicipalInflation, &MunicipalInflationPair{})
+			if err := m.MunicipalInflation[len(m.MunicipalInflation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MunicipalInflation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MunicipalInflation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MunicipalInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Targets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Targets = append(m.Targets, &MunicipalInflationTarget{})
+			if err := m.Targets[len(m.Targets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &MunicipalInflationSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= MunicipalInflationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MunicipalInflationSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MunicipalInflationSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MunicipalInflationSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.StartTime == nil {
+				m.StartTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.StartTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EndTime == nil {
+				m.EndTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.EndTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Steps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Steps = append(m.Steps, MunicipalInflationStep{})
+			if err := m.Steps[len(m.Steps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayPeriod", wireType)
+			}
+			m.DecayPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DecayPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DecayFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MunicipalInflationStep) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MunicipalInflationStep: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MunicipalInflationStep: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MunicipalInflationTarget) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MunicipalInflationTarget: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MunicipalInflationTarget: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Module = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MunicipalInflationPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MunicipalInflationPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MunicipalInflationPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Inflation == nil {
+				m.Inflation = &MunicipalInflation{}
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateMunicipalInflationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMunicipalInflationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMunicipalInflationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MunicipalInflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MunicipalInflation = append(m.MunicipalInflation, &MunicipalInflationPair{})
+			if err := m.MunicipalInflation[len(m.MunicipalInflation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpdateMunicipalInflationProposalWithDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpdateMunicipalInflationProposalWithDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpdateMunicipalInflationProposalWithDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MunicipalInflation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MunicipalInflation = append(m.MunicipalInflation, &MunicipalInflationPair{})
+			if err := m.MunicipalInflation[len(m.MunicipalInflation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Deposit = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoveMunicipalInflationProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoveMunicipalInflationProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoveMunicipalInflationProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMax", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMax.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationMin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GoalBonded", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.GoalBonded.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MaxSupply = append(m.MaxSupply, types.Coin{})
+			if err := m.MaxSupply[len(m.MaxSupply)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationMode", wireType)
+			}
+			m.InflationMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InflationMode |= InflationMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InflationRateChange", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InflationRateChange.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CheckpointInterval", wireType)
+			}
+			m.CheckpointInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CheckpointInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalibrateBlocksPerYear", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CalibrateBlocksPerYear = bool(v != 0)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinBlocksPerYear", wireType)
+			}
+			m.MinBlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinBlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBlocksPerYear", wireType)
+			}
+			m.MaxBlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CalibrationWindow", wireType)
+			}
+			m.CalibrationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CalibrationWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MintDenom)
-	if l > 0 {
-		n += 1 + l + sovMint(uint64(l))
-	}
-	l = m.InflationRate.Size()
-	n += 1 + l + sovMint(uint64(l))
-	if m.BlocksPerYear != 0 {
-		n += 1 + sovMint(uint64(m.BlocksPerYear))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovMint(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMint(x uint64) (n int) {
-	return sovMint(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *Minter) Unmarshal(dAtA []byte) error {
+func (m *RecipientMinted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -574,15 +4345,15 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+			return fmt.Errorf("proto: RecipientMinted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RecipientMinted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -610,15 +4381,116 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMint(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMint
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MintCheckpoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMint
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintCheckpoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintCheckpoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -628,29 +4500,28 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MunicipalInflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -677,8 +4548,8 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MunicipalInflation = append(m.MunicipalInflation, &MunicipalInflationPair{})
-			if err := m.MunicipalInflation[len(m.MunicipalInflation)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -703,7 +4574,7 @@ func (m *Minter) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MunicipalInflation) Unmarshal(dAtA []byte) error {
+func (m *MunicipalIssuance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -726,15 +4597,15 @@ func (m *MunicipalInflation) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MunicipalInflation: wiretype end group for non-group")
+			return fmt.Errorf("proto: MunicipalIssuance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MunicipalInflation: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MunicipalIssuance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 2:
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TargetAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -762,13 +4633,46 @@ func (m *MunicipalInflation) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TargetAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMint
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMint
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -778,23 +4682,23 @@ func (m *MunicipalInflation) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Value.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Recipients = append(m.Recipients, MunicipalIssuanceRecipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -819,7 +4723,7 @@ func (m *MunicipalInflation) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MunicipalInflationPair) Unmarshal(dAtA []byte) error {
+func (m *MunicipalIssuanceRecipient) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -842,15 +4746,15 @@ func (m *MunicipalInflationPair) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MunicipalInflationPair: wiretype end group for non-group")
+			return fmt.Errorf("proto: MunicipalIssuanceRecipient: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MunicipalInflationPair: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MunicipalIssuanceRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -878,13 +4782,13 @@ func (m *MunicipalInflationPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -894,25 +4798,23 @@ func (m *MunicipalInflationPair) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Inflation == nil {
-				m.Inflation = &MunicipalInflation{}
-			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Minted.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -937,7 +4839,7 @@ func (m *MunicipalInflationPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *BlockTimeObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -960,17 +4862,17 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: BlockTimeObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BlockTimeObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastBlockTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMint
@@ -980,27 +4882,28 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMint
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMint
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintDenom = string(dAtA[iNdEx:postIndex])
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(&m.LastBlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InflationRate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockInterval", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1028,11 +4931,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InflationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AverageBlockInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMint
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
 			}
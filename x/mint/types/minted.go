@@ -0,0 +1,61 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// moduleRecipientPrefix prefixes the names of recipient module accounts
+const moduleRecipientPrefix = "module:"
+
+// ModuleRecipient returns the recipient identification of the module account
+// with the given name.
+func ModuleRecipient(module string) string {
+	return moduleRecipientPrefix + module
+}
+
+// ValidateRecipient ensures the recipient is either bech32 address, or a module
+// account name prefixed with "module:".
+func ValidateRecipient(recipient string) error {
+	if module := strings.TrimPrefix(recipient, moduleRecipientPrefix); module != recipient {
+		if len(module) == 0 {
+			return fmt.Errorf("recipient \"%s\" has empty module name", recipient)
+		}
+		return nil
+	}
+
+	if _, err := sdk.AccAddressFromBech32(recipient); err != nil {
+		return fmt.Errorf("recipient \"%s\" is neither bech32 address nor module name prefixed with \"%s\"",
+			recipient, moduleRecipientPrefix)
+	}
+
+	return nil
+}
+
+// Validate ensures validity of RecipientMinted object fields
+func (rm RecipientMinted) Validate() error {
+	if err := ValidateRecipient(rm.Recipient); err != nil {
+		return err
+	}
+
+	if err := rm.Minted.Validate(); err != nil {
+		return fmt.Errorf("invalid minted amounts of recipient \"%s\": %w", rm.Recipient, err)
+	}
+
+	return nil
+}
+
+// Validate ensures validity of MintCheckpoint object fields
+func (c MintCheckpoint) Validate() error {
+	if c.Height <= 0 {
+		return fmt.Errorf("mint checkpoint height must be positive, is %d", c.Height)
+	}
+
+	if err := c.Minted.Validate(); err != nil {
+		return fmt.Errorf("invalid minted amounts of checkpoint at height %d: %w", c.Height, err)
+	}
+
+	return nil
+}
@@ -44,9 +44,37 @@ func ValidateMinter(minter Minter) error {
 	return err
 }
 
-// NextInflationRate returns the new inflation rate for the next hour.
-func (m Minter) NextInflationRate(params Params) sdk.Dec {
-	return params.InflationRate
+// NextInflationRate returns the new inflation rate for the next block. The rate
+// is either fixed, or driven by the bonded ratio, depending on the inflation mode.
+func (m Minter) NextInflationRate(params Params, bondedRatio sdk.Dec) sdk.Dec {
+	if params.InflationMode != InflationModeBondedRatio {
+		return params.InflationRate
+	}
+
+	// The target annual inflation rate is recalculated for each previsions cycle. The
+	// inflation is also subject to a rate change (positive or negative) depending on
+	// the distance from the desired ratio (goal bonded). The maximum rate change
+	// possible is defined by inflation rate change per year, while the annual
+	// inflation is capped as between min and max inflation.
+	inflation := m.Inflation
+	if params.GoalBonded.IsPositive() {
+		inflationRateChangePerYear := sdk.OneDec().
+			Sub(bondedRatio.Quo(params.GoalBonded)).
+			Mul(params.InflationRateChange)
+		inflationRateChange := inflationRateChangePerYear.Quo(sdk.NewDec(int64(params.BlocksPerYear)))
+
+		// adjust the new annual inflation for this next cycle
+		inflation = inflation.Add(inflationRateChange) // note inflationRateChange may be negative
+	}
+
+	if inflation.GT(params.InflationMax) {
+		inflation = params.InflationMax
+	}
+	if inflation.LT(params.InflationMin) {
+		inflation = params.InflationMin
+	}
+
+	return inflation
 }
 
 // NextAnnualProvisions returns the annual provisions based on current total
@@ -16,6 +16,20 @@ var (
 	KeyMintDenom     = []byte("MintDenom")
 	KeyInflationRate = []byte("InflationRate")
 	KeyBlocksPerYear = []byte("BlocksPerYear")
+	KeyMaxSupply     = []byte("MaxSupply")
+
+	KeyInflationMode       = []byte("InflationMode")
+	KeyInflationRateChange = []byte("InflationRateChange")
+	KeyInflationMax        = []byte("InflationMax")
+	KeyInflationMin        = []byte("InflationMin")
+	KeyGoalBonded          = []byte("GoalBonded")
+
+	KeyCheckpointInterval = []byte("CheckpointInterval")
+
+	KeyCalibrateBlocksPerYear = []byte("CalibrateBlocksPerYear")
+	KeyMinBlocksPerYear       = []byte("MinBlocksPerYear")
+	KeyMaxBlocksPerYear       = []byte("MaxBlocksPerYear")
+	KeyCalibrationWindow      = []byte("CalibrationWindow")
 )
 
 // ParamTable for minting module.
@@ -23,11 +37,39 @@ func ParamKeyTable() paramtypes.KeyTable {
 	return paramtypes.NewKeyTable().RegisterParamSet(&Params{})
 }
 
-func NewParams(mintDenom string, inflationRate sdk.Dec, blocksPerYear uint64) Params {
+func NewParams(mintDenom string, inflationRate sdk.Dec, blocksPerYear uint64, maxSupply sdk.Coins) Params {
 	return Params{
 		MintDenom:     mintDenom,
 		InflationRate: inflationRate,
 		BlocksPerYear: blocksPerYear,
+		MaxSupply:     maxSupply,
+		InflationMode: InflationModeFixed,
+		// bonded ratio inflation mode is not used
+		InflationRateChange: sdk.ZeroDec(),
+		InflationMax:        sdk.ZeroDec(),
+		InflationMin:        sdk.ZeroDec(),
+		GoalBonded:          sdk.ZeroDec(),
+	}
+}
+
+// NewBondedRatioParams returns params of the bonded ratio inflation mode, where
+// the inflation rate moves between `inflationMin` and `inflationMax` by at most
+// `inflationRateChange` per year, depending on the distance of the bonded ratio
+// from the `goalBonded`.
+func NewBondedRatioParams(
+	mintDenom string, inflationRateChange, inflationMax, inflationMin, goalBonded sdk.Dec,
+	blocksPerYear uint64, maxSupply sdk.Coins,
+) Params {
+	return Params{
+		MintDenom:           mintDenom,
+		InflationRate:       sdk.ZeroDec(),
+		BlocksPerYear:       blocksPerYear,
+		MaxSupply:           maxSupply,
+		InflationMode:       InflationModeBondedRatio,
+		InflationRateChange: inflationRateChange,
+		InflationMax:        inflationMax,
+		InflationMin:        inflationMin,
+		GoalBonded:          goalBonded,
 	}
 }
 
@@ -36,7 +78,20 @@ func DefaultParams() Params {
 	return Params{
 		MintDenom:     sdk.DefaultBondDenom,
 		InflationRate: sdk.NewDecWithPrec(3, 2),
-		BlocksPerYear: uint64(60 * 60 * 8766 / 5), // assuming 5 second block times
+		BlocksPerYear: uint64(SecondsPerYear / 5), // assuming 5 second block times
+		MaxSupply:     sdk.Coins{},
+		InflationMode: InflationModeFixed,
+		// bonded ratio inflation mode
+		InflationRateChange: sdk.NewDecWithPrec(13, 2),
+		InflationMax:        sdk.NewDecWithPrec(20, 2),
+		InflationMin:        sdk.NewDecWithPrec(7, 2),
+		GoalBonded:          sdk.NewDecWithPrec(67, 2),
+		CheckpointInterval:  uint64(60 * 60 * 24 / 5), // daily, assuming 5 second block times
+		// blocks per year calibration
+		CalibrateBlocksPerYear: false,
+		MinBlocksPerYear:       uint64(SecondsPerYear / 10), // 10 second block times
+		MaxBlocksPerYear:       uint64(SecondsPerYear),      // 1 second block times
+		CalibrationWindow:      uint64(60 * 60 / 5),         // hourly, assuming 5 second block times
 	}
 }
 
@@ -51,6 +106,65 @@ func (p Params) Validate() error {
 	if err := validateBlocksPerYear(p.BlocksPerYear); err != nil {
 		return err
 	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := validateInflationMode(p.InflationMode); err != nil {
+		return err
+	}
+	if err := validateInflationRateChange(p.InflationRateChange); err != nil {
+		return err
+	}
+	if err := validateInflationMax(p.InflationMax); err != nil {
+		return err
+	}
+	if err := validateInflationMin(p.InflationMin); err != nil {
+		return err
+	}
+	if err := validateGoalBonded(p.GoalBonded); err != nil {
+		return err
+	}
+	if err := validateCheckpointInterval(p.CheckpointInterval); err != nil {
+		return err
+	}
+	if err := validateCalibrateBlocksPerYear(p.CalibrateBlocksPerYear); err != nil {
+		return err
+	}
+	// params of the calibration may be omitted altogether in genesis of the
+	// chains not calibrating blocks per year, but must be set otherwise
+	if p.CalibrateBlocksPerYear || p.MinBlocksPerYear != 0 || p.MaxBlocksPerYear != 0 || p.CalibrationWindow != 0 {
+		if err := validateBlocksPerYearBound(p.MinBlocksPerYear); err != nil {
+			return err
+		}
+		if err := validateBlocksPerYearBound(p.MaxBlocksPerYear); err != nil {
+			return err
+		}
+		if err := validateCalibrationWindow(p.CalibrationWindow); err != nil {
+			return err
+		}
+	}
+	if p.CalibrateBlocksPerYear && p.MaxBlocksPerYear < p.MinBlocksPerYear {
+		return fmt.Errorf(
+			"max blocks per year (%d) must be greater than or equal to min blocks per year (%d)",
+			p.MaxBlocksPerYear, p.MinBlocksPerYear,
+		)
+	}
+	if p.InflationMode == InflationModeBondedRatio {
+		// params of the bonded ratio mode may be omitted in genesis of the chains
+		// using the fixed inflation rate, but must be set if the mode is in use
+		if p.InflationRateChange.IsNil() || p.InflationMax.IsNil() || p.InflationMin.IsNil() || p.GoalBonded.IsNil() {
+			return errors.New("bonded ratio inflation mode requires inflation_rate_change, inflation_max, inflation_min and goal_bonded")
+		}
+		if !p.GoalBonded.IsPositive() {
+			return fmt.Errorf("goal bonded must be positive: %s", p.GoalBonded)
+		}
+		if p.InflationMax.LT(p.InflationMin) {
+			return fmt.Errorf(
+				"max inflation (%s) must be greater than or equal to min inflation (%s)",
+				p.InflationMax, p.InflationMin,
+			)
+		}
+	}
 	return nil
 }
 
@@ -66,6 +180,17 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMintDenom, &p.MintDenom, validateMintDenom),
 		paramtypes.NewParamSetPair(KeyInflationRate, &p.InflationRate, validateInflationRate),
 		paramtypes.NewParamSetPair(KeyBlocksPerYear, &p.BlocksPerYear, validateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyMaxSupply, &p.MaxSupply, validateMaxSupply),
+		paramtypes.NewParamSetPair(KeyInflationMode, &p.InflationMode, validateInflationMode),
+		paramtypes.NewParamSetPair(KeyInflationRateChange, &p.InflationRateChange, validateInflationRateChange),
+		paramtypes.NewParamSetPair(KeyInflationMax, &p.InflationMax, validateInflationMax),
+		paramtypes.NewParamSetPair(KeyInflationMin, &p.InflationMin, validateInflationMin),
+		paramtypes.NewParamSetPair(KeyGoalBonded, &p.GoalBonded, validateGoalBonded),
+		paramtypes.NewParamSetPair(KeyCheckpointInterval, &p.CheckpointInterval, validateCheckpointInterval),
+		paramtypes.NewParamSetPair(KeyCalibrateBlocksPerYear, &p.CalibrateBlocksPerYear, validateCalibrateBlocksPerYear),
+		paramtypes.NewParamSetPair(KeyMinBlocksPerYear, &p.MinBlocksPerYear, validateBlocksPerYearBound),
+		paramtypes.NewParamSetPair(KeyMaxBlocksPerYear, &p.MaxBlocksPerYear, validateBlocksPerYearBound),
+		paramtypes.NewParamSetPair(KeyCalibrationWindow, &p.CalibrationWindow, validateCalibrationWindow),
 	}
 }
 
@@ -113,3 +238,174 @@ func validateBlocksPerYear(i interface{}) error {
 
 	return nil
 }
+
+func validateMaxSupply(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid max supply: %w", err)
+	}
+
+	return nil
+}
+
+func validateInflationMode(i interface{}) error {
+	v, ok := i.(InflationMode)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if _, exists := InflationMode_name[int32(v)]; !exists {
+		return fmt.Errorf("unknown inflation mode: %d", v)
+	}
+
+	return nil
+}
+
+func validateInflationRateChange(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("inflation rate change cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("inflation rate change too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMax(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("max inflation cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("max inflation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateInflationMin(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("min inflation cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("min inflation too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateGoalBonded(i interface{}) error {
+	v, ok := i.(sdk.Dec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return nil
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("goal bonded cannot be negative: %s", v)
+	}
+	if v.GT(sdk.OneDec()) {
+		return fmt.Errorf("goal bonded too large: %s", v)
+	}
+
+	return nil
+}
+
+func validateCheckpointInterval(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateCalibrateBlocksPerYear(i interface{}) error {
+	_, ok := i.(bool)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
+
+func validateBlocksPerYearBound(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("blocks per year bound must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validateCalibrationWindow(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("calibration window must be positive: %d", v)
+	}
+
+	return nil
+}
+
+// BoundBlocksPerYear returns the given blocks per year bounded by the
+// min_blocks_per_year and max_blocks_per_year params.
+func (p Params) BoundBlocksPerYear(blocksPerYear uint64) uint64 {
+	if blocksPerYear < p.MinBlocksPerYear {
+		return p.MinBlocksPerYear
+	}
+	if blocksPerYear > p.MaxBlocksPerYear {
+		return p.MaxBlocksPerYear
+	}
+
+	return blocksPerYear
+}
+
+// MaxSupplyOf returns the maximum supply of the given denomination and true if
+// the denomination is capped, zero and false otherwise.
+func (p Params) MaxSupplyOf(denom string) (sdk.Int, bool) {
+	for _, coin := range p.MaxSupply {
+		if coin.Denom == denom {
+			return coin.Amount, true
+		}
+	}
+
+	return sdk.ZeroInt(), false
+}
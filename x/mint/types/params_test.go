@@ -0,0 +1,99 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestParamsValidationOfInflationMode(t *testing.T) {
+	params := types.DefaultParams()
+	require.NoError(t, params.Validate())
+
+	params.InflationMode = types.InflationModeBondedRatio
+	require.NoError(t, params.Validate())
+
+	params.InflationMin = sdk.NewDecWithPrec(30, 2)
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.InflationMode = types.InflationModeBondedRatio
+	params.GoalBonded = sdk.ZeroDec()
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.InflationMode = types.InflationMode(2)
+	require.Error(t, params.Validate())
+}
+
+func TestGenesisWithoutInflationModeKeepsFixedRate(t *testing.T) {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	// genesis params predating the inflation mode
+	bz := []byte(`{"mint_denom":"stake","inflation_rate":"0.050000000000000000","blocks_per_year":"6311520"}`)
+
+	var params types.Params
+	require.NoError(t, cdc.UnmarshalJSON(bz, &params))
+	require.NoError(t, params.Validate())
+	require.Equal(t, types.InflationModeFixed, params.InflationMode)
+
+	minter := types.DefaultInitialMinter()
+	require.Equal(t, sdk.NewDecWithPrec(5, 2), minter.NextInflationRate(params, sdk.OneDec()))
+
+	// bonded ratio mode cannot be selected without its params
+	params.InflationMode = types.InflationModeBondedRatio
+	require.Error(t, params.Validate())
+}
+
+func TestParamsValidationOfBlocksPerYearCalibration(t *testing.T) {
+	params := types.DefaultParams()
+	params.CalibrateBlocksPerYear = true
+	require.NoError(t, params.Validate())
+
+	params.MaxBlocksPerYear = params.MinBlocksPerYear - 1
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.CalibrateBlocksPerYear = true
+	params.MinBlocksPerYear = 0
+	require.Error(t, params.Validate())
+
+	params = types.DefaultParams()
+	params.CalibrateBlocksPerYear = true
+	params.CalibrationWindow = 0
+	require.Error(t, params.Validate())
+
+	// zero bounds are rejected even while the calibration is disabled, as each
+	// param may be changed on its own
+	params = types.DefaultParams()
+	params.MaxBlocksPerYear = 0
+	require.Error(t, params.Validate())
+
+	// the order of bounds is not required while the calibration is disabled
+	params = types.DefaultParams()
+	params.MaxBlocksPerYear = params.MinBlocksPerYear - 1
+	require.NoError(t, params.Validate())
+
+	// calibration params may be omitted altogether while it is disabled
+	params.MinBlocksPerYear, params.MaxBlocksPerYear, params.CalibrationWindow = 0, 0, 0
+	require.NoError(t, params.Validate())
+
+	// but each of them is rejected when changed to zero on its own
+	for _, pair := range params.ParamSetPairs() {
+		if string(pair.Key) == string(types.KeyMinBlocksPerYear) ||
+			string(pair.Key) == string(types.KeyMaxBlocksPerYear) ||
+			string(pair.Key) == string(types.KeyCalibrationWindow) {
+			require.Error(t, pair.ValidatorFn(uint64(0)), string(pair.Key))
+		}
+	}
+
+	params = types.DefaultParams()
+	require.Equal(t, params.MinBlocksPerYear, params.BoundBlocksPerYear(1))
+	require.Equal(t, params.MaxBlocksPerYear, params.BoundBlocksPerYear(params.MaxBlocksPerYear+1))
+	require.Equal(t, params.BlocksPerYear, params.BoundBlocksPerYear(params.BlocksPerYear))
+}
@@ -0,0 +1,134 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+)
+
+const (
+	// ProposalTypeUpdateMunicipalInflation defines the type for an UpdateMunicipalInflationProposal
+	ProposalTypeUpdateMunicipalInflation = "UpdateMunicipalInflation"
+	// ProposalTypeRemoveMunicipalInflation defines the type for a RemoveMunicipalInflationProposal
+	ProposalTypeRemoveMunicipalInflation = "RemoveMunicipalInflation"
+)
+
+// Assert municipal inflation proposals implement govtypes.Content at compile-time
+var (
+	_ govtypes.Content = &UpdateMunicipalInflationProposal{}
+	_ govtypes.Content = &RemoveMunicipalInflationProposal{}
+)
+
+func init() {
+	govtypes.RegisterProposalType(ProposalTypeUpdateMunicipalInflation)
+	govtypes.RegisterProposalTypeCodec(&UpdateMunicipalInflationProposal{}, "cosmos-sdk/UpdateMunicipalInflationProposal")
+	govtypes.RegisterProposalType(ProposalTypeRemoveMunicipalInflation)
+	govtypes.RegisterProposalTypeCodec(&RemoveMunicipalInflationProposal{}, "cosmos-sdk/RemoveMunicipalInflationProposal")
+}
+
+// NewUpdateMunicipalInflationProposal creates a new municipal inflation update proposal.
+func NewUpdateMunicipalInflationProposal(title, description string, inflations []*MunicipalInflationPair) *UpdateMunicipalInflationProposal {
+	return &UpdateMunicipalInflationProposal{title, description, inflations}
+}
+
+// GetTitle returns the title of a municipal inflation update proposal.
+func (p *UpdateMunicipalInflationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a municipal inflation update proposal.
+func (p *UpdateMunicipalInflationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a municipal inflation update proposal.
+func (p *UpdateMunicipalInflationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a municipal inflation update proposal.
+func (p *UpdateMunicipalInflationProposal) ProposalType() string {
+	return ProposalTypeUpdateMunicipalInflation
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *UpdateMunicipalInflationProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.MunicipalInflation) == 0 {
+		return ErrEmptyMunicipalInflationProposal
+	}
+
+	return ValidateMunicipalInflations(&p.MunicipalInflation)
+}
+
+// String implements the Stringer interface.
+func (p UpdateMunicipalInflationProposal) String() string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf(`Update Municipal Inflation Proposal:
+  Title:       %s
+  Description: %s
+  Inflations:
+`, p.Title, p.Description))
+	for _, pair := range p.MunicipalInflation {
+		if pair == nil || pair.Inflation == nil {
+			continue
+		}
+		b.WriteString(fmt.Sprintf("    %s: %s\n", pair.Denom, pair.Inflation.Value))
+		for _, target := range pair.Inflation.EffectiveTargets() {
+			b.WriteString(fmt.Sprintf("      %s: %s\n", target.Recipient(), target.Weight))
+		}
+	}
+	return b.String()
+}
+
+// NewRemoveMunicipalInflationProposal creates a new municipal inflation removal proposal.
+func NewRemoveMunicipalInflationProposal(title, description string, denoms []string) *RemoveMunicipalInflationProposal {
+	return &RemoveMunicipalInflationProposal{title, description, denoms}
+}
+
+// GetTitle returns the title of a municipal inflation removal proposal.
+func (p *RemoveMunicipalInflationProposal) GetTitle() string { return p.Title }
+
+// GetDescription returns the description of a municipal inflation removal proposal.
+func (p *RemoveMunicipalInflationProposal) GetDescription() string { return p.Description }
+
+// ProposalRoute returns the routing key of a municipal inflation removal proposal.
+func (p *RemoveMunicipalInflationProposal) ProposalRoute() string { return RouterKey }
+
+// ProposalType returns the type of a municipal inflation removal proposal.
+func (p *RemoveMunicipalInflationProposal) ProposalType() string {
+	return ProposalTypeRemoveMunicipalInflation
+}
+
+// ValidateBasic runs basic stateless validity checks
+func (p *RemoveMunicipalInflationProposal) ValidateBasic() error {
+	err := govtypes.ValidateAbstract(p)
+	if err != nil {
+		return err
+	}
+	if len(p.Denoms) == 0 {
+		return ErrEmptyMunicipalInflationProposal
+	}
+
+	denoms := map[string]struct{}{}
+	for _, denom := range p.Denoms {
+		if _, exists := denoms[denom]; exists {
+			return fmt.Errorf("municipal inflation: denomination \"%s\" listed more than once", denom)
+		}
+		denoms[denom] = struct{}{}
+
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return fmt.Errorf("municipal inflation proposal, denom: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// String implements the Stringer interface.
+func (p RemoveMunicipalInflationProposal) String() string {
+	return fmt.Sprintf(`Remove Municipal Inflation Proposal:
+  Title:       %s
+  Description: %s
+  Denoms:      %s
+`, p.Title, p.Description, strings.Join(p.Denoms, ", "))
+}
@@ -0,0 +1,19 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestUpdateMunicipalInflationProposalString(t *testing.T) {
+	proposal := types.NewUpdateMunicipalInflationProposal("title", "description", []*types.MunicipalInflationPair{
+		nil,
+		{Denom: "stake"},
+	})
+
+	require.NotPanics(t, func() { _ = proposal.String() })
+	require.Error(t, proposal.ValidateBasic())
+}
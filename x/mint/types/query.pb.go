@@ -7,9 +7,13 @@ import (
 	context "context"
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/gogo/protobuf/gogoproto"
 	grpc1 "github.com/gogo/protobuf/grpc"
 	proto "github.com/gogo/protobuf/proto"
+	github_com_gogo_protobuf_types "github.com/gogo/protobuf/types"
+	_ "github.com/golang/protobuf/ptypes/timestamp"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -17,12 +21,14 @@ import (
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -268,6 +274,9 @@ var xxx_messageInfo_QueryInflationResponse proto.InternalMessageInfo
 type QueryMunicipalInflationResponse struct {
 	// inflation is the current minting inflation value.
 	Inflations []*MunicipalInflationPair `protobuf:"bytes,1,rep,name=inflations,proto3" json:"inflations,omitempty"`
+	// statuses holds the currently active rate and the next scheduled change of
+	// each returned inflation, in the same order as `inflations`.
+	Statuses []MunicipalInflationStatus `protobuf:"bytes,2,rep,name=statuses,proto3" json:"statuses"`
 }
 
 func (m *QueryMunicipalInflationResponse) Reset()         { *m = QueryMunicipalInflationResponse{} }
@@ -310,6 +319,82 @@ func (m *QueryMunicipalInflationResponse) GetInflations() []*MunicipalInflationP
 	return nil
 }
 
+func (m *QueryMunicipalInflationResponse) GetStatuses() []MunicipalInflationStatus {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+// MunicipalInflationStatus represents the state of a municipal inflation
+// schedule as of the last processed block.
+type MunicipalInflationStatus struct {
+	// token denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// currently active ANNUAL inflation rate, zero if inflation is not active
+	CurrentRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,2,opt,name=current_rate,json=currentRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"current_rate" yaml:"current_rate"`
+	// height at which the rate changes next, zero if no change is scheduled by height
+	NextChangeHeight int64 `protobuf:"varint,3,opt,name=next_change_height,json=nextChangeHeight,proto3" json:"next_change_height,omitempty" yaml:"next_change_height"`
+	// time at which the rate changes next, not set if no change is scheduled by time
+	NextChangeTime *time.Time `protobuf:"bytes,4,opt,name=next_change_time,json=nextChangeTime,proto3,stdtime" json:"next_change_time,omitempty" yaml:"next_change_time"`
+	// ANNUAL inflation rate active after the next change
+	NextRate github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,5,opt,name=next_rate,json=nextRate,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"next_rate" yaml:"next_rate"`
+}
+
+func (m *MunicipalInflationStatus) Reset()         { *m = MunicipalInflationStatus{} }
+func (m *MunicipalInflationStatus) String() string { return proto.CompactTextString(m) }
+func (*MunicipalInflationStatus) ProtoMessage()    {}
+func (*MunicipalInflationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{6}
+}
+func (m *MunicipalInflationStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MunicipalInflationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MunicipalInflationStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MunicipalInflationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MunicipalInflationStatus.Merge(m, src)
+}
+func (m *MunicipalInflationStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *MunicipalInflationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_MunicipalInflationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MunicipalInflationStatus proto.InternalMessageInfo
+
+func (m *MunicipalInflationStatus) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *MunicipalInflationStatus) GetNextChangeHeight() int64 {
+	if m != nil {
+		return m.NextChangeHeight
+	}
+	return 0
+}
+
+func (m *MunicipalInflationStatus) GetNextChangeTime() *time.Time {
+	if m != nil {
+		return m.NextChangeTime
+	}
+	return nil
+}
+
 // QueryAnnualProvisionsRequest is the request type for the
 // Query/AnnualProvisions RPC method.
 type QueryAnnualProvisionsRequest struct {
@@ -319,7 +404,7 @@ func (m *QueryAnnualProvisionsRequest) Reset()         { *m = QueryAnnualProvisi
 func (m *QueryAnnualProvisionsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryAnnualProvisionsRequest) ProtoMessage()    {}
 func (*QueryAnnualProvisionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0a1e393be338aea, []int{6}
+	return fileDescriptor_d0a1e393be338aea, []int{7}
 }
 func (m *QueryAnnualProvisionsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +444,7 @@ func (m *QueryAnnualProvisionsResponse) Reset()         { *m = QueryAnnualProvis
 func (m *QueryAnnualProvisionsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryAnnualProvisionsResponse) ProtoMessage()    {}
 func (*QueryAnnualProvisionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_d0a1e393be338aea, []int{7}
+	return fileDescriptor_d0a1e393be338aea, []int{8}
 }
 func (m *QueryAnnualProvisionsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -388,631 +473,3877 @@ func (m *QueryAnnualProvisionsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryAnnualProvisionsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
-	proto.RegisterType((*QueryInflationRequest)(nil), "cosmos.mint.v1beta1.QueryInflationRequest")
-	proto.RegisterType((*QueryMunicipalInflationRequest)(nil), "cosmos.mint.v1beta1.QueryMunicipalInflationRequest")
-	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
-	proto.RegisterType((*QueryMunicipalInflationResponse)(nil), "cosmos.mint.v1beta1.QueryMunicipalInflationResponse")
-	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
-	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+// QuerySupplyHeadroomRequest is the request type for the Query/SupplyHeadroom
+// RPC method.
+type QuerySupplyHeadroomRequest struct {
+	// Types that are valid to be assigned to XDenom:
+	//	*QuerySupplyHeadroomRequest_Denom
+	XDenom isQuerySupplyHeadroomRequest_XDenom `protobuf_oneof:"_denom"`
 }
 
-func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
-
-var fileDescriptor_d0a1e393be338aea = []byte{
-	// 548 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0x3f, 0x6f, 0xd3, 0x40,
-	0x18, 0xc6, 0x7d, 0xd0, 0x46, 0xe4, 0x2d, 0x43, 0xb9, 0x96, 0x7f, 0x6e, 0x7b, 0xa9, 0x8c, 0x14,
-	0x4c, 0x2b, 0x6c, 0x92, 0xb2, 0x30, 0x12, 0x40, 0x02, 0x01, 0x52, 0xf0, 0x08, 0x43, 0x74, 0x49,
-	0x5d, 0x63, 0x11, 0xdf, 0xb9, 0x3e, 0xbb, 0xa2, 0x12, 0x03, 0x62, 0x62, 0x60, 0x40, 0xe2, 0x53,
-	0x30, 0xf2, 0x2d, 0x3a, 0x56, 0x62, 0x41, 0x0c, 0x15, 0x4a, 0xf8, 0x12, 0x6c, 0xc8, 0x77, 0x97,
-	0x14, 0x1c, 0x1b, 0x28, 0x53, 0xe2, 0x7b, 0xdf, 0xf7, 0x79, 0x7e, 0x7e, 0xef, 0x49, 0xa0, 0x31,
-	0xe0, 0x22, 0xe2, 0xc2, 0x8d, 0x42, 0x96, 0xba, 0x7b, 0xad, 0xbe, 0x9f, 0xd2, 0x96, 0xbb, 0x9b,
-	0xf9, 0xc9, 0xbe, 0x13, 0x27, 0x3c, 0xe5, 0x78, 0x49, 0x35, 0x38, 0x79, 0x83, 0xa3, 0x1b, 0xcc,
-	0xe5, 0x80, 0x07, 0x5c, 0xd6, 0xdd, 0xfc, 0x9b, 0x6a, 0x35, 0x57, 0x03, 0xce, 0x83, 0xa1, 0xef,
-	0xd2, 0x38, 0x74, 0x29, 0x63, 0x3c, 0xa5, 0x69, 0xc8, 0x99, 0xd0, 0x55, 0x52, 0xe6, 0x24, 0x55,
-	0x65, 0xdd, 0x5a, 0x06, 0xfc, 0x24, 0xf7, 0xed, 0xd2, 0x84, 0x46, 0xc2, 0xf3, 0x77, 0x33, 0x5f,
-	0xa4, 0x56, 0x17, 0x96, 0x7e, 0x3b, 0x15, 0x31, 0x67, 0xc2, 0xc7, 0xb7, 0xa0, 0x16, 0xcb, 0x93,
-	0x4b, 0x68, 0x1d, 0xd9, 0x0b, 0xed, 0x15, 0xa7, 0x04, 0xd3, 0x51, 0x43, 0x9d, 0xb9, 0x83, 0xa3,
-	0x86, 0xe1, 0xe9, 0x01, 0xeb, 0x22, 0x9c, 0x97, 0x8a, 0x0f, 0xd8, 0xce, 0x50, 0x02, 0x4e, 0xac,
-	0xee, 0x01, 0x91, 0x85, 0xc7, 0x19, 0x0b, 0x07, 0x61, 0x4c, 0x87, 0xc5, 0x0e, 0x7c, 0x19, 0xe6,
-	0xb7, 0x7d, 0xc6, 0x23, 0x69, 0x5a, 0xbf, 0x6f, 0x78, 0xea, 0xf1, 0x2d, 0x42, 0x9d, 0x33, 0x50,
-	0xeb, 0xc9, 0x07, 0x6b, 0x07, 0x2e, 0x14, 0xf5, 0x35, 0xf4, 0x23, 0xa8, 0x87, 0x93, 0x43, 0x29,
-	0x71, 0xb6, 0xe3, 0xe4, 0x68, 0x5f, 0x8f, 0x1a, 0xcd, 0x20, 0x4c, 0x9f, 0x67, 0x7d, 0x67, 0xc0,
-	0x23, 0x57, 0xef, 0x49, 0x7d, 0x5c, 0x17, 0xdb, 0x2f, 0xdc, 0x74, 0x3f, 0xf6, 0x85, 0x73, 0xd7,
-	0x1f, 0x78, 0xc7, 0x02, 0x16, 0x83, 0x46, 0x25, 0xae, 0x36, 0x7c, 0x08, 0x30, 0xed, 0xcf, 0x37,
-	0x75, 0xda, 0x5e, 0x68, 0x6f, 0x96, 0x6e, 0x6a, 0x56, 0xa4, 0x4b, 0xc3, 0xc4, 0xfb, 0x65, 0xdc,
-	0x22, 0xb0, 0x2a, 0xfd, 0x6e, 0x33, 0x96, 0xd1, 0x61, 0x37, 0xe1, 0x7b, 0xa1, 0xc8, 0x0b, 0x93,
-	0xf5, 0xbd, 0x82, 0xb5, 0x8a, 0xba, 0xa6, 0x79, 0x06, 0xe7, 0xa8, 0xac, 0xf5, 0xe2, 0x69, 0xf1,
-	0x3f, 0xd7, 0xb0, 0x48, 0x0b, 0x26, 0xed, 0x1f, 0x73, 0x30, 0x2f, 0xed, 0xf1, 0x6b, 0x04, 0x35,
-	0x75, 0xf1, 0xf8, 0x6a, 0xe9, 0xbb, 0xce, 0xa6, 0xcc, 0xb4, 0xff, 0xde, 0xa8, 0x5e, 0xc2, 0xba,
-	0xf2, 0xe6, 0xf3, 0xf7, 0x0f, 0xa7, 0xd6, 0xf0, 0x8a, 0x5b, 0x16, 0x67, 0x15, 0x31, 0xfc, 0x0e,
-	0x41, 0x7d, 0xba, 0x48, 0xbc, 0x51, 0x2d, 0x5e, 0x4c, 0x98, 0xb9, 0xf9, 0x4f, 0xbd, 0x9a, 0xa5,
-	0x29, 0x59, 0xd6, 0x31, 0x29, 0x65, 0x99, 0x5e, 0x1d, 0xfe, 0x84, 0x00, 0xcf, 0x5e, 0x30, 0xde,
-	0xaa, 0xf6, 0xaa, 0xfc, 0x09, 0x98, 0x37, 0x4f, 0x36, 0xa4, 0x49, 0x6f, 0x48, 0xd2, 0x0d, 0x6c,
-	0x97, 0x92, 0x46, 0x93, 0xc1, 0xde, 0x31, 0xf3, 0x47, 0x04, 0x8b, 0xc5, 0x24, 0xe1, 0x56, 0xb5,
-	0x79, 0x45, 0x2a, 0xcd, 0xf6, 0x49, 0x46, 0x34, 0xad, 0x23, 0x69, 0x6d, 0xdc, 0x2c, 0xa5, 0x9d,
-	0xc9, 0x70, 0xe7, 0xce, 0xc1, 0x88, 0xa0, 0xc3, 0x11, 0x41, 0xdf, 0x46, 0x04, 0xbd, 0x1f, 0x13,
-	0xe3, 0x70, 0x4c, 0x8c, 0x2f, 0x63, 0x62, 0x3c, 0xbd, 0xf6, 0xc7, 0x3c, 0xbf, 0x54, 0xc2, 0x32,
-	0xd6, 0xfd, 0x9a, 0xfc, 0x17, 0xdc, 0xfa, 0x19, 0x00, 0x00, 0xff, 0xff, 0x60, 0x1d, 0xe7, 0x7b,
-	0x91, 0x05, 0x00, 0x00,
+func (m *QuerySupplyHeadroomRequest) Reset()         { *m = QuerySupplyHeadroomRequest{} }
+func (m *QuerySupplyHeadroomRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyHeadroomRequest) ProtoMessage()    {}
+func (*QuerySupplyHeadroomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{9}
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyHeadroomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyHeadroomRequest.Merge(m, src)
+}
+func (m *QuerySupplyHeadroomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyHeadroomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyHeadroomRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QuerySupplyHeadroomRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the total set of minting parameters.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
-	// Inflation returns the current minting inflation value.
-	MunicipalInflation(ctx context.Context, in *QueryMunicipalInflationRequest, opts ...grpc.CallOption) (*QueryMunicipalInflationResponse, error)
-	// AnnualProvisions current minting annual provisions value.
-	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+type isQuerySupplyHeadroomRequest_XDenom interface {
+	isQuerySupplyHeadroomRequest_XDenom()
+	MarshalTo([]byte) (int, error)
+	Size() int
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+type QuerySupplyHeadroomRequest_Denom struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3,oneof" json:"denom,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
-}
+func (*QuerySupplyHeadroomRequest_Denom) isQuerySupplyHeadroomRequest_XDenom() {}
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySupplyHeadroomRequest) GetXDenom() isQuerySupplyHeadroomRequest_XDenom {
+	if m != nil {
+		return m.XDenom
 	}
-	return out, nil
+	return nil
 }
 
-func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
-	out := new(QueryInflationResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/Inflation", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QuerySupplyHeadroomRequest) GetDenom() string {
+	if x, ok := m.GetXDenom().(*QuerySupplyHeadroomRequest_Denom); ok {
+		return x.Denom
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) MunicipalInflation(ctx context.Context, in *QueryMunicipalInflationRequest, opts ...grpc.CallOption) (*QueryMunicipalInflationResponse, error) {
-	out := new(QueryMunicipalInflationResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/MunicipalInflation", in, out, opts...)
-	if err != nil {
-		return nil, err
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*QuerySupplyHeadroomRequest) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*QuerySupplyHeadroomRequest_Denom)(nil),
 	}
-	return out, nil
 }
 
-func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
-	out := new(QueryAnnualProvisionsResponse)
-	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/AnnualProvisions", in, out, opts...)
-	if err != nil {
-		return nil, err
+// QuerySupplyHeadroomResponse is the response type for the Query/SupplyHeadroom
+// RPC method.
+type QuerySupplyHeadroomResponse struct {
+	// headrooms of the requested capped denominations
+	Headrooms []SupplyHeadroom `protobuf:"bytes,1,rep,name=headrooms,proto3" json:"headrooms"`
+}
+
+func (m *QuerySupplyHeadroomResponse) Reset()         { *m = QuerySupplyHeadroomResponse{} }
+func (m *QuerySupplyHeadroomResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySupplyHeadroomResponse) ProtoMessage()    {}
+func (*QuerySupplyHeadroomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{10}
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySupplyHeadroomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySupplyHeadroomResponse.Merge(m, src)
+}
+func (m *QuerySupplyHeadroomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySupplyHeadroomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySupplyHeadroomResponse.DiscardUnknown(m)
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the total set of minting parameters.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// Inflation returns the current minting inflation value.
-	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
-	// Inflation returns the current minting inflation value.
-	MunicipalInflation(context.Context, *QueryMunicipalInflationRequest) (*QueryMunicipalInflationResponse, error)
-	// AnnualProvisions current minting annual provisions value.
-	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+var xxx_messageInfo_QuerySupplyHeadroomResponse proto.InternalMessageInfo
+
+func (m *QuerySupplyHeadroomResponse) GetHeadrooms() []SupplyHeadroom {
+	if m != nil {
+		return m.Headrooms
+	}
+	return nil
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// SupplyHeadroom represents the remaining amount of a capped denomination which
+// can be minted.
+type SupplyHeadroom struct {
+	// token denomination
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// maximum total supply
+	MaxSupply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"max_supply" yaml:"max_supply"`
+	// current total supply
+	Supply github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,3,opt,name=supply,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"supply"`
+	// amount which can still be minted, zero once the cap is reached
+	Headroom github_com_cosmos_cosmos_sdk_types.Int `protobuf:"bytes,4,opt,name=headroom,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Int" json:"headroom"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *SupplyHeadroom) Reset()         { *m = SupplyHeadroom{} }
+func (m *SupplyHeadroom) String() string { return proto.CompactTextString(m) }
+func (*SupplyHeadroom) ProtoMessage()    {}
+func (*SupplyHeadroom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{11}
 }
-func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+func (m *SupplyHeadroom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) MunicipalInflation(ctx context.Context, req *QueryMunicipalInflationRequest) (*QueryMunicipalInflationResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method MunicipalInflation not implemented")
+func (m *SupplyHeadroom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SupplyHeadroom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+func (m *SupplyHeadroom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SupplyHeadroom.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *SupplyHeadroom) XXX_Size() int {
+	return m.Size()
+}
+func (m *SupplyHeadroom) XXX_DiscardUnknown() {
+	xxx_messageInfo_SupplyHeadroom.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.mint.v1beta1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_SupplyHeadroom proto.InternalMessageInfo
+
+func (m *SupplyHeadroom) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryInflationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Inflation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.mint.v1beta1.Query/Inflation",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryMintedTotalRequest is the request type for the Query/MintedTotal RPC
+// method.
+type QueryMintedTotalRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_MunicipalInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryMunicipalInflationRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).MunicipalInflation(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.mint.v1beta1.Query/MunicipalInflation",
+func (m *QueryMintedTotalRequest) Reset()         { *m = QueryMintedTotalRequest{} }
+func (m *QueryMintedTotalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintedTotalRequest) ProtoMessage()    {}
+func (*QueryMintedTotalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{12}
+}
+func (m *QueryMintedTotalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintedTotalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintedTotalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).MunicipalInflation(ctx, req.(*QueryMunicipalInflationRequest))
+}
+func (m *QueryMintedTotalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintedTotalRequest.Merge(m, src)
+}
+func (m *QueryMintedTotalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintedTotalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintedTotalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintedTotalRequest proto.InternalMessageInfo
+
+func (m *QueryMintedTotalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAnnualProvisionsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).AnnualProvisions(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/cosmos.mint.v1beta1.Query/AnnualProvisions",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryMintedTotalResponse is the response type for the Query/MintedTotal RPC
+// method.
+type QueryMintedTotalResponse struct {
+	// minted is the total amounts of tokens minted per denomination.
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "cosmos.mint.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "Inflation",
-			Handler:    _Query_Inflation_Handler,
-		},
-		{
-			MethodName: "MunicipalInflation",
-			Handler:    _Query_MunicipalInflation_Handler,
-		},
-		{
-			MethodName: "AnnualProvisions",
-			Handler:    _Query_AnnualProvisions_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "cosmos/mint/v1beta1/query.proto",
+func (m *QueryMintedTotalResponse) Reset()         { *m = QueryMintedTotalResponse{} }
+func (m *QueryMintedTotalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintedTotalResponse) ProtoMessage()    {}
+func (*QueryMintedTotalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{13}
 }
-
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintedTotalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintedTotalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintedTotalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintedTotalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintedTotalResponse.Merge(m, src)
+}
+func (m *QueryMintedTotalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintedTotalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintedTotalResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryMintedTotalResponse proto.InternalMessageInfo
+
+func (m *QueryMintedTotalResponse) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
+	}
+	return nil
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintedTotalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRecipientMintedRequest is the request type for the Query/RecipientMinted
+// RPC method.
+type QueryRecipientMintedRequest struct {
+	// recipient is the bech32 address of the recipient account, or name of the
+	// recipient module account prefixed with "module:".
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryRecipientMintedRequest) Reset()         { *m = QueryRecipientMintedRequest{} }
+func (m *QueryRecipientMintedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRecipientMintedRequest) ProtoMessage()    {}
+func (*QueryRecipientMintedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{14}
+}
+func (m *QueryRecipientMintedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecipientMintedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecipientMintedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRecipientMintedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecipientMintedRequest.Merge(m, src)
 }
-
-func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRecipientMintedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRecipientMintedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecipientMintedRequest.DiscardUnknown(m)
 }
 
-func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+var xxx_messageInfo_QueryRecipientMintedRequest proto.InternalMessageInfo
+
+func (m *QueryRecipientMintedRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
 }
 
-func (m *QueryMunicipalInflationRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRecipientMintedRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMunicipalInflationRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryRecipientMintedResponse is the response type for the
+// Query/RecipientMinted RPC method.
+type QueryRecipientMintedResponse struct {
+	// minted is the total amounts of tokens minted to the recipient per
+	// denomination.
+	Minted github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=minted,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"minted"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMunicipalInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.XDenom != nil {
-		{
-			size := m.XDenom.Size()
-			i -= size
-			if _, err := m.XDenom.MarshalTo(dAtA[i:]); err != nil {
-				return 0, err
-			}
+func (m *QueryRecipientMintedResponse) Reset()         { *m = QueryRecipientMintedResponse{} }
+func (m *QueryRecipientMintedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRecipientMintedResponse) ProtoMessage()    {}
+func (*QueryRecipientMintedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{15}
+}
+func (m *QueryRecipientMintedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRecipientMintedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRecipientMintedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *QueryMunicipalInflationRequest_Denom) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryRecipientMintedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRecipientMintedResponse.Merge(m, src)
 }
-
-func (m *QueryMunicipalInflationRequest_Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	i -= len(m.Denom)
-	copy(dAtA[i:], m.Denom)
-	i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+func (m *QueryRecipientMintedResponse) XXX_Size() int {
+	return m.Size()
 }
-func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *QueryRecipientMintedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRecipientMintedResponse.DiscardUnknown(m)
 }
 
-func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
+var xxx_messageInfo_QueryRecipientMintedResponse proto.InternalMessageInfo
 
-func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Inflation.Size()
-		i -= size
-		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryRecipientMintedResponse) GetMinted() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Minted
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *QueryMunicipalInflationResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryRecipientMintedResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryMunicipalInflationResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintCheckpointsRequest is the request type for the Query/MintCheckpoints
+// RPC method.
+type QueryMintCheckpointsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryMunicipalInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Inflations) > 0 {
-		for iNdEx := len(m.Inflations) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Inflations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryMintCheckpointsRequest) Reset()         { *m = QueryMintCheckpointsRequest{} }
+func (m *QueryMintCheckpointsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintCheckpointsRequest) ProtoMessage()    {}
+func (*QueryMintCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{16}
+}
+func (m *QueryMintCheckpointsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintCheckpointsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintCheckpointsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryMintCheckpointsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintCheckpointsRequest.Merge(m, src)
+}
+func (m *QueryMintCheckpointsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintCheckpointsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintCheckpointsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMintCheckpointsRequest proto.InternalMessageInfo
+
+func (m *QueryMintCheckpointsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryMintCheckpointsResponse is the response type for the
+// Query/MintCheckpoints RPC method.
+type QueryMintCheckpointsResponse struct {
+	// checkpoints ordered by height.
+	Checkpoints []MintCheckpoint `protobuf:"bytes,1,rep,name=checkpoints,proto3" json:"checkpoints"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryMintCheckpointsResponse) Reset()         { *m = QueryMintCheckpointsResponse{} }
+func (m *QueryMintCheckpointsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintCheckpointsResponse) ProtoMessage()    {}
+func (*QueryMintCheckpointsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{17}
+}
+func (m *QueryMintCheckpointsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintCheckpointsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintCheckpointsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintCheckpointsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintCheckpointsResponse.Merge(m, src)
+}
+func (m *QueryMintCheckpointsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintCheckpointsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintCheckpointsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryMintCheckpointsResponse proto.InternalMessageInfo
+
+func (m *QueryMintCheckpointsResponse) GetCheckpoints() []MintCheckpoint {
+	if m != nil {
+		return m.Checkpoints
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryMintCheckpointsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.AnnualProvisions.Size()
-		i -= size
-		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// QueryBlocksPerYearRequest is the request type for the Query/BlocksPerYear RPC
+// method.
+type QueryBlocksPerYearRequest struct {
+}
+
+func (m *QueryBlocksPerYearRequest) Reset()         { *m = QueryBlocksPerYearRequest{} }
+func (m *QueryBlocksPerYearRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocksPerYearRequest) ProtoMessage()    {}
+func (*QueryBlocksPerYearRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{18}
+}
+func (m *QueryBlocksPerYearRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocksPerYearRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocksPerYearRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryBlocksPerYearRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocksPerYearRequest.Merge(m, src)
+}
+func (m *QueryBlocksPerYearRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocksPerYearRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocksPerYearRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+var xxx_messageInfo_QueryBlocksPerYearRequest proto.InternalMessageInfo
+
+// QueryBlocksPerYearResponse is the response type for the Query/BlocksPerYear
+// RPC method.
+type QueryBlocksPerYearResponse struct {
+	// blocks_per_year is the effective blocks per year used for minting.
+	BlocksPerYear uint64 `protobuf:"varint,1,opt,name=blocks_per_year,json=blocksPerYear,proto3" json:"blocks_per_year,omitempty"`
+	// observed_blocks_per_year is the blocks per year given by the average block
+	// interval, without bounds applied, zero if no block times were observed.
+	ObservedBlocksPerYear uint64 `protobuf:"varint,2,opt,name=observed_blocks_per_year,json=observedBlocksPerYear,proto3" json:"observed_blocks_per_year,omitempty"`
+	// average_block_interval is the moving average of intervals between blocks,
+	// in seconds.
+	AverageBlockInterval github_com_cosmos_cosmos_sdk_types.Dec `protobuf:"bytes,3,opt,name=average_block_interval,json=averageBlockInterval,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Dec" json:"average_block_interval"`
+	// samples is the number of observed intervals between blocks.
+	Samples uint64 `protobuf:"varint,4,opt,name=samples,proto3" json:"samples,omitempty"`
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryBlocksPerYearResponse) Reset()         { *m = QueryBlocksPerYearResponse{} }
+func (m *QueryBlocksPerYearResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBlocksPerYearResponse) ProtoMessage()    {}
+func (*QueryBlocksPerYearResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{19}
+}
+func (m *QueryBlocksPerYearResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBlocksPerYearResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBlocksPerYearResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *QueryBlocksPerYearResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBlocksPerYearResponse.Merge(m, src)
+}
+func (m *QueryBlocksPerYearResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBlocksPerYearResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBlocksPerYearResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBlocksPerYearResponse proto.InternalMessageInfo
+
+func (m *QueryBlocksPerYearResponse) GetBlocksPerYear() uint64 {
+	if m != nil {
+		return m.BlocksPerYear
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return 0
 }
 
-func (m *QueryInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBlocksPerYearResponse) GetObservedBlocksPerYear() uint64 {
+	if m != nil {
+		return m.ObservedBlocksPerYear
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryMunicipalInflationRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.XDenom != nil {
-		n += m.XDenom.Size()
+func (m *QueryBlocksPerYearResponse) GetSamples() uint64 {
+	if m != nil {
+		return m.Samples
 	}
-	return n
+	return 0
 }
 
-func (m *QueryMunicipalInflationRequest_Denom) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryBurnedTotalRequest is the request type for the Query/BurnedTotal RPC
+// method.
+type QueryBurnedTotalRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
-func (m *QueryInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryBurnedTotalRequest) Reset()         { *m = QueryBurnedTotalRequest{} }
+func (m *QueryBurnedTotalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedTotalRequest) ProtoMessage()    {}
+func (*QueryBurnedTotalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{20}
+}
+func (m *QueryBurnedTotalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedTotalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedTotalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.Inflation.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryBurnedTotalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedTotalRequest.Merge(m, src)
+}
+func (m *QueryBurnedTotalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedTotalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedTotalRequest.DiscardUnknown(m)
 }
 
-func (m *QueryMunicipalInflationResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBurnedTotalRequest proto.InternalMessageInfo
+
+func (m *QueryBurnedTotalRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	if len(m.Inflations) > 0 {
-		for _, e := range m.Inflations {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return nil
+}
+
+// QueryBurnedTotalResponse is the response type for the Query/BurnedTotal RPC
+// method.
+type QueryBurnedTotalResponse struct {
+	// burned is the total amounts of tokens burned per denomination.
+	Burned github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=burned,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"burned"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryBurnedTotalResponse) Reset()         { *m = QueryBurnedTotalResponse{} }
+func (m *QueryBurnedTotalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedTotalResponse) ProtoMessage()    {}
+func (*QueryBurnedTotalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0a1e393be338aea, []int{21}
+}
+func (m *QueryBurnedTotalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedTotalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedTotalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryBurnedTotalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedTotalResponse.Merge(m, src)
+}
+func (m *QueryBurnedTotalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedTotalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedTotalResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAnnualProvisionsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryBurnedTotalResponse proto.InternalMessageInfo
+
+func (m *QueryBurnedTotalResponse) GetBurned() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Burned
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryAnnualProvisionsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBurnedTotalResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.AnnualProvisions.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "cosmos.mint.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "cosmos.mint.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*QueryInflationRequest)(nil), "cosmos.mint.v1beta1.QueryInflationRequest")
+	proto.RegisterType((*QueryMunicipalInflationRequest)(nil), "cosmos.mint.v1beta1.QueryMunicipalInflationRequest")
+	proto.RegisterType((*QueryInflationResponse)(nil), "cosmos.mint.v1beta1.QueryInflationResponse")
+	proto.RegisterType((*QueryMunicipalInflationResponse)(nil), "cosmos.mint.v1beta1.QueryMunicipalInflationResponse")
+	proto.RegisterType((*MunicipalInflationStatus)(nil), "cosmos.mint.v1beta1.MunicipalInflationStatus")
+	proto.RegisterType((*QueryAnnualProvisionsRequest)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsRequest")
+	proto.RegisterType((*QueryAnnualProvisionsResponse)(nil), "cosmos.mint.v1beta1.QueryAnnualProvisionsResponse")
+	proto.RegisterType((*QuerySupplyHeadroomRequest)(nil), "cosmos.mint.v1beta1.QuerySupplyHeadroomRequest")
+	proto.RegisterType((*QuerySupplyHeadroomResponse)(nil), "cosmos.mint.v1beta1.QuerySupplyHeadroomResponse")
+	proto.RegisterType((*SupplyHeadroom)(nil), "cosmos.mint.v1beta1.SupplyHeadroom")
+	proto.RegisterType((*QueryMintedTotalRequest)(nil), "cosmos.mint.v1beta1.QueryMintedTotalRequest")
+	proto.RegisterType((*QueryMintedTotalResponse)(nil), "cosmos.mint.v1beta1.QueryMintedTotalResponse")
+	proto.RegisterType((*QueryRecipientMintedRequest)(nil), "cosmos.mint.v1beta1.QueryRecipientMintedRequest")
+	proto.RegisterType((*QueryRecipientMintedResponse)(nil), "cosmos.mint.v1beta1.QueryRecipientMintedResponse")
+	proto.RegisterType((*QueryMintCheckpointsRequest)(nil), "cosmos.mint.v1beta1.QueryMintCheckpointsRequest")
+	proto.RegisterType((*QueryMintCheckpointsResponse)(nil), "cosmos.mint.v1beta1.QueryMintCheckpointsResponse")
+	proto.RegisterType((*QueryBlocksPerYearRequest)(nil), "cosmos.mint.v1beta1.QueryBlocksPerYearRequest")
+	proto.RegisterType((*QueryBlocksPerYearResponse)(nil), "cosmos.mint.v1beta1.QueryBlocksPerYearResponse")
+	proto.RegisterType((*QueryBurnedTotalRequest)(nil), "cosmos.mint.v1beta1.QueryBurnedTotalRequest")
+	proto.RegisterType((*QueryBurnedTotalResponse)(nil), "cosmos.mint.v1beta1.QueryBurnedTotalResponse")
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func init() { proto.RegisterFile("cosmos/mint/v1beta1/query.proto", fileDescriptor_d0a1e393be338aea) }
+
+var fileDescriptor_d0a1e393be338aea = []byte{
+	// 1376 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x3a, 0xa9, 0xbf, 0xf1, 0x4b, 0x7f, 0xa4, 0xd3, 0xb4, 0x75, 0x36, 0xa9, 0x37, 0xdf,
+	0x2d, 0x72, 0xdd, 0x1f, 0xd9, 0x4d, 0x52, 0x10, 0x82, 0x5b, 0x1d, 0x9a, 0x26, 0xfc, 0x10, 0x61,
+	0xdb, 0x0b, 0x70, 0x58, 0x8d, 0xd7, 0x13, 0x7b, 0x15, 0xef, 0x8f, 0xee, 0xae, 0xa3, 0x44, 0x80,
+	0x84, 0xe0, 0xc2, 0x81, 0x43, 0x25, 0xc4, 0xb5, 0x82, 0x23, 0x48, 0x08, 0x21, 0x71, 0x47, 0xdc,
+	0x72, 0xe0, 0x50, 0x89, 0x0b, 0xe2, 0xe0, 0xa2, 0x84, 0xbf, 0x20, 0x77, 0x24, 0xb4, 0x33, 0xb3,
+	0xb6, 0xd7, 0xde, 0x75, 0xec, 0x28, 0x3d, 0x70, 0xb2, 0x77, 0xde, 0x7b, 0x9f, 0xf7, 0x79, 0x6f,
+	0xde, 0xcc, 0x7b, 0x03, 0x92, 0xe1, 0xf8, 0x96, 0xe3, 0xab, 0x96, 0x69, 0x07, 0xea, 0xce, 0x72,
+	0x85, 0x04, 0x78, 0x59, 0x7d, 0xdc, 0x24, 0xde, 0x9e, 0xe2, 0x7a, 0x4e, 0xe0, 0xa0, 0x4b, 0x4c,
+	0x41, 0x09, 0x15, 0x14, 0xae, 0x20, 0xce, 0xd4, 0x9c, 0x9a, 0x43, 0xe5, 0x6a, 0xf8, 0x8f, 0xa9,
+	0x8a, 0xf3, 0x35, 0xc7, 0xa9, 0x35, 0x88, 0x8a, 0x5d, 0x53, 0xc5, 0xb6, 0xed, 0x04, 0x38, 0x30,
+	0x1d, 0xdb, 0xe7, 0xd2, 0x5b, 0xdc, 0x53, 0x05, 0xfb, 0x84, 0x79, 0x68, 0xfb, 0x73, 0x71, 0xcd,
+	0xb4, 0xa9, 0x32, 0xd7, 0x2d, 0x74, 0xeb, 0x46, 0x5a, 0x86, 0x63, 0x46, 0x72, 0x89, 0x7b, 0xa2,
+	0x5f, 0x95, 0xe6, 0x96, 0x1a, 0x98, 0x16, 0xf1, 0x03, 0x6c, 0xb9, 0x3d, 0x00, 0xb1, 0xb0, 0x68,
+	0x08, 0x54, 0x2e, 0xcf, 0x00, 0x7a, 0x2f, 0xa4, 0xb0, 0x89, 0x3d, 0x6c, 0xf9, 0x1a, 0x79, 0xdc,
+	0x24, 0x7e, 0x20, 0x6f, 0xc2, 0xa5, 0xd8, 0xaa, 0xef, 0x3a, 0xb6, 0x4f, 0xd0, 0x6b, 0x90, 0x75,
+	0xe9, 0x4a, 0x5e, 0x58, 0x10, 0x4a, 0x53, 0x2b, 0x73, 0x4a, 0x42, 0x4e, 0x14, 0x66, 0x54, 0x9e,
+	0xd8, 0x6f, 0x49, 0x63, 0x1a, 0x37, 0x90, 0xaf, 0xc2, 0x65, 0x8a, 0xb8, 0x61, 0x6f, 0x35, 0x68,
+	0x80, 0x91, 0xab, 0xfb, 0x50, 0xa0, 0x82, 0x77, 0x9a, 0xb6, 0x69, 0x98, 0x2e, 0x6e, 0xf4, 0x6a,
+	0xa0, 0x59, 0x38, 0x53, 0x25, 0xb6, 0x63, 0x51, 0xa7, 0xb9, 0xf5, 0x31, 0x8d, 0x7d, 0x7e, 0x21,
+	0x08, 0xe5, 0x49, 0xc8, 0xea, 0xf4, 0x43, 0xde, 0x82, 0x2b, 0xbd, 0xf8, 0x9c, 0xf4, 0xdb, 0x90,
+	0x33, 0xa3, 0x45, 0x0a, 0x71, 0xb6, 0xac, 0x84, 0xd4, 0xfe, 0x6c, 0x49, 0xc5, 0x9a, 0x19, 0xd4,
+	0x9b, 0x15, 0xc5, 0x70, 0x2c, 0x95, 0xe7, 0x89, 0xfd, 0x2c, 0xfa, 0xd5, 0x6d, 0x35, 0xd8, 0x73,
+	0x89, 0xaf, 0xbc, 0x41, 0x0c, 0xad, 0x03, 0x20, 0xff, 0x22, 0x80, 0x94, 0xca, 0x97, 0x7b, 0x7c,
+	0x0b, 0xa0, 0x6d, 0x10, 0xa6, 0x6a, 0xbc, 0x34, 0xb5, 0x72, 0x3b, 0x31, 0x55, 0xfd, 0x20, 0x9b,
+	0xd8, 0xf4, 0xb4, 0x2e, 0x73, 0xf4, 0x2e, 0x4c, 0xfa, 0x01, 0x0e, 0x9a, 0x3e, 0xf1, 0xf3, 0x19,
+	0x0a, 0xb5, 0x38, 0x24, 0xd4, 0x43, 0x6a, 0xc6, 0xf7, 0xa1, 0x0d, 0x22, 0xff, 0x38, 0x0e, 0xf9,
+	0x34, 0x65, 0x34, 0x13, 0xcb, 0x35, 0xcf, 0x34, 0xaa, 0xc3, 0x59, 0xa3, 0xe9, 0x79, 0xc4, 0x0e,
+	0x74, 0x0f, 0x07, 0x24, 0x9f, 0x09, 0x85, 0xe5, 0xfb, 0xa3, 0x65, 0xf1, 0xa8, 0x25, 0x5d, 0xda,
+	0xc3, 0x56, 0xe3, 0x75, 0xb9, 0x1b, 0x4b, 0xd6, 0xa6, 0xf8, 0xa7, 0x86, 0x83, 0x30, 0x75, 0xc8,
+	0x26, 0xbb, 0x81, 0x6e, 0xd4, 0xb1, 0x5d, 0x23, 0x7a, 0x9d, 0x98, 0xb5, 0x7a, 0x90, 0x1f, 0x5f,
+	0x10, 0x4a, 0xe3, 0xe5, 0x6b, 0x47, 0x2d, 0x69, 0x96, 0x21, 0xf4, 0xeb, 0xc8, 0xda, 0x74, 0xb8,
+	0xb8, 0x4a, 0xd7, 0xd6, 0xe9, 0x12, 0x22, 0x30, 0xdd, 0xad, 0x18, 0x1e, 0x8d, 0xfc, 0x04, 0x2d,
+	0x5c, 0x51, 0x61, 0xe7, 0x46, 0x89, 0xce, 0x8d, 0xf2, 0x28, 0x3a, 0x37, 0x65, 0xe9, 0xa8, 0x25,
+	0x5d, 0xed, 0x77, 0x13, 0x5a, 0xcb, 0x4f, 0x9e, 0x4b, 0x82, 0x76, 0xbe, 0xe3, 0x28, 0xb4, 0x42,
+	0x3a, 0xe4, 0xa8, 0x22, 0x4d, 0xcd, 0x19, 0x9a, 0x9a, 0xf2, 0xc8, 0xa9, 0x99, 0xee, 0xf2, 0xc8,
+	0xf2, 0x32, 0x19, 0xfe, 0x0f, 0x93, 0x22, 0x17, 0x60, 0x9e, 0x96, 0xdc, 0x3d, 0xdb, 0x6e, 0xe2,
+	0xc6, 0xa6, 0xe7, 0xec, 0x98, 0x7e, 0x58, 0x1b, 0xd1, 0x11, 0xfa, 0x18, 0xae, 0xa5, 0xc8, 0x79,
+	0x41, 0x7e, 0x08, 0x17, 0x31, 0x95, 0xe9, 0x6e, 0x5b, 0x78, 0xc2, 0xa3, 0x30, 0x8d, 0x7b, 0x9c,
+	0xc8, 0xf7, 0x40, 0xa4, 0xde, 0x1f, 0x36, 0x5d, 0xb7, 0xb1, 0xb7, 0x4e, 0x70, 0xd5, 0x73, 0x1c,
+	0x6b, 0xc4, 0xc3, 0x3b, 0x97, 0x08, 0xc1, 0xe9, 0x3f, 0x80, 0x5c, 0x9d, 0xaf, 0x45, 0xc7, 0xe9,
+	0x7a, 0xe2, 0x19, 0x88, 0xdb, 0xf3, 0xca, 0xef, 0xd8, 0xca, 0x4f, 0x33, 0x70, 0x3e, 0xae, 0x93,
+	0x52, 0xf0, 0x15, 0x00, 0x0b, 0xef, 0xea, 0x3e, 0xd5, 0xe5, 0xe5, 0xbe, 0x3a, 0x42, 0xa6, 0x36,
+	0xec, 0xe0, 0xa8, 0x25, 0x5d, 0x64, 0x7b, 0xda, 0x41, 0x92, 0xb5, 0x9c, 0x85, 0x77, 0x19, 0x03,
+	0xb4, 0x06, 0x59, 0x8e, 0x3f, 0x4e, 0xf1, 0x95, 0xd1, 0xf0, 0x35, 0x6e, 0x8d, 0xde, 0x84, 0xc9,
+	0x28, 0xc2, 0xfc, 0xc4, 0x89, 0x90, 0xda, 0xf6, 0x32, 0x86, 0xab, 0xec, 0x72, 0x33, 0xed, 0x80,
+	0x54, 0x1f, 0x39, 0x01, 0x6e, 0x44, 0x1b, 0xb9, 0x06, 0xd0, 0xe9, 0x4e, 0xfc, 0xfe, 0x2f, 0x46,
+	0xbb, 0x10, 0xb6, 0x27, 0x85, 0x35, 0xcb, 0x4e, 0x17, 0xa8, 0x11, 0x6e, 0xab, 0x75, 0x59, 0xca,
+	0xfb, 0x02, 0xe4, 0xfb, 0x7d, 0xf0, 0x9d, 0x36, 0x20, 0x6b, 0xd1, 0x65, 0xbe, 0xcd, 0xb3, 0x31,
+	0x07, 0x11, 0xf4, 0xaa, 0x63, 0xda, 0xe5, 0xa5, 0x30, 0xc8, 0xef, 0x9f, 0x4b, 0xa5, 0x21, 0x82,
+	0x0c, 0x0d, 0x7c, 0x8d, 0x43, 0xa3, 0x07, 0xb1, 0x48, 0x32, 0x34, 0x92, 0x1b, 0xc7, 0x46, 0xc2,
+	0x18, 0xc6, 0x42, 0xf9, 0x5c, 0xe0, 0x75, 0xab, 0x11, 0xc3, 0x74, 0x4d, 0x62, 0x07, 0x2c, 0xa6,
+	0x28, 0x65, 0xf3, 0x90, 0xf3, 0x22, 0x09, 0xaf, 0xaf, 0xce, 0x02, 0x5a, 0x4b, 0xa0, 0x71, 0x92,
+	0x84, 0xfe, 0x26, 0xc0, 0x7c, 0x32, 0x8b, 0xff, 0x64, 0x52, 0x09, 0xcf, 0x69, 0x18, 0xc4, 0x6a,
+	0x9d, 0x18, 0xdb, 0xae, 0x63, 0xda, 0x81, 0x7f, 0xda, 0x65, 0xf8, 0x73, 0x94, 0xb5, 0x3e, 0x3f,
+	0xed, 0x26, 0x3e, 0x65, 0x74, 0x96, 0x07, 0x5e, 0x3b, 0x71, 0x08, 0x7e, 0xed, 0x74, 0x5b, 0x9f,
+	0x5e, 0x76, 0xe6, 0x60, 0x96, 0xb2, 0x2e, 0x37, 0x1c, 0x63, 0xdb, 0xdf, 0x24, 0xde, 0xfb, 0x04,
+	0x7b, 0x51, 0x1f, 0xf8, 0x47, 0x00, 0x31, 0x49, 0xca, 0x23, 0x2a, 0xc2, 0x85, 0x0a, 0x15, 0xe8,
+	0x2e, 0xf1, 0xf4, 0x3d, 0x82, 0x3d, 0x9a, 0xbf, 0x09, 0xed, 0x5c, 0xa5, 0x5b, 0x1f, 0xbd, 0x0a,
+	0x79, 0xa7, 0xe2, 0x13, 0x6f, 0x87, 0x54, 0xf5, 0x5e, 0x83, 0x0c, 0x35, 0xb8, 0x1c, 0xc9, 0x63,
+	0x8e, 0x50, 0x15, 0xae, 0xe0, 0x1d, 0xe2, 0xe1, 0x1a, 0x61, 0x76, 0x7a, 0x58, 0x1a, 0xde, 0x0e,
+	0x6e, 0x9c, 0xe0, 0x86, 0x0b, 0x7b, 0xcd, 0x0c, 0x47, 0xa3, 0x5e, 0x36, 0x38, 0x16, 0xca, 0xc3,
+	0xff, 0x7c, 0x6c, 0xb9, 0x0d, 0xe2, 0xd3, 0xeb, 0x6e, 0x42, 0x8b, 0x3e, 0xdb, 0xb7, 0x57, 0xb9,
+	0xe9, 0xd9, 0x2f, 0xfa, 0xf6, 0x8a, 0xf9, 0xe8, 0x1c, 0xb4, 0x0a, 0x5d, 0x7e, 0x21, 0x07, 0x8d,
+	0x41, 0x9f, 0x5a, 0x29, 0xad, 0xfc, 0x7a, 0x16, 0xce, 0xd0, 0x50, 0xd0, 0xa7, 0x02, 0x64, 0xd9,
+	0xd0, 0x8e, 0x6e, 0x24, 0x16, 0x78, 0xff, 0x0b, 0x41, 0x2c, 0x1d, 0xaf, 0xc8, 0x7c, 0xca, 0xd7,
+	0x3f, 0xfb, 0xfd, 0xef, 0xaf, 0x32, 0xd7, 0xd0, 0x9c, 0x9a, 0xf4, 0x14, 0x61, 0xcf, 0x03, 0xf4,
+	0xa5, 0x00, 0xb9, 0xf6, 0x2c, 0x8a, 0x6e, 0xa5, 0x83, 0xf7, 0xbe, 0x0e, 0xc4, 0xdb, 0x43, 0xe9,
+	0x72, 0x2e, 0x45, 0xca, 0x65, 0x01, 0x15, 0x12, 0xb9, 0xb4, 0xa7, 0x6e, 0xf4, 0x93, 0x00, 0xa8,
+	0x7f, 0x46, 0x46, 0x77, 0xd3, 0x7d, 0xa5, 0x3e, 0x5f, 0xc4, 0x97, 0x47, 0x33, 0xe2, 0x4c, 0x97,
+	0x28, 0xd3, 0x5b, 0xa8, 0x94, 0xc8, 0xd4, 0x8a, 0x0c, 0xf5, 0x0e, 0xe7, 0xef, 0x04, 0x98, 0xee,
+	0x9d, 0x00, 0xd1, 0x72, 0xba, 0xf3, 0x94, 0x69, 0x52, 0x5c, 0x19, 0xc5, 0x84, 0xb3, 0x55, 0x28,
+	0xdb, 0x12, 0x2a, 0x26, 0xb2, 0xed, 0x9b, 0x3d, 0xd1, 0xb7, 0x42, 0xdf, 0x20, 0xa6, 0xa6, 0xbb,
+	0x4d, 0x9c, 0x2c, 0xc5, 0xa5, 0xe1, 0x0d, 0x38, 0xcb, 0x3b, 0x94, 0x65, 0x11, 0xbd, 0x94, 0xc8,
+	0x92, 0x8d, 0x53, 0x7a, 0x34, 0x0b, 0xa1, 0xaf, 0x05, 0x98, 0xea, 0x9a, 0x51, 0xd0, 0x9d, 0x01,
+	0xfb, 0xd8, 0x37, 0x2e, 0x89, 0x8b, 0x43, 0x6a, 0x73, 0x6a, 0x37, 0x29, 0xb5, 0xeb, 0xe8, 0xff,
+	0x6a, 0xda, 0x7b, 0x9d, 0x54, 0xf5, 0x80, 0xf2, 0xf8, 0x41, 0x80, 0x0b, 0x3d, 0xad, 0x1e, 0x0d,
+	0xc8, 0x45, 0xf2, 0x6c, 0x22, 0x2e, 0x8f, 0x60, 0xc1, 0x39, 0xbe, 0x42, 0x39, 0xaa, 0x68, 0xf1,
+	0x58, 0x8e, 0xea, 0x47, 0xed, 0x31, 0xe7, 0x13, 0xf4, 0x8d, 0x00, 0x17, 0x7a, 0x9a, 0xec, 0x20,
+	0xbe, 0xc9, 0x7d, 0x5f, 0x5c, 0x1e, 0xc1, 0x82, 0xf3, 0x2d, 0x51, 0xbe, 0x32, 0x5a, 0x48, 0xe4,
+	0xdb, 0xdd, 0x9e, 0x9f, 0x0a, 0x70, 0x2e, 0xde, 0xca, 0x94, 0x74, 0x77, 0x49, 0xad, 0x57, 0x54,
+	0x87, 0xd6, 0x1f, 0xaa, 0x16, 0x7b, 0xda, 0x2e, 0xad, 0xc5, 0xae, 0x8e, 0x33, 0xa8, 0x16, 0xfb,
+	0x9b, 0x9f, 0xb8, 0x38, 0xa4, 0xf6, 0x50, 0xb5, 0xc8, 0xda, 0x10, 0xdb, 0xe7, 0xf2, 0xea, 0xfe,
+	0x41, 0x41, 0x78, 0x76, 0x50, 0x10, 0xfe, 0x3a, 0x28, 0x08, 0x4f, 0x0e, 0x0b, 0x63, 0xcf, 0x0e,
+	0x0b, 0x63, 0x7f, 0x1c, 0x16, 0xc6, 0x3e, 0xb8, 0x39, 0xb0, 0xb1, 0xed, 0x32, 0x4c, 0xda, 0xdf,
+	0x2a, 0x59, 0xfa, 0x08, 0xbf, 0xfb, 0xef, 0x00, 0xfc, 0x40, 0x27, 0xb0, 0x82, 0x13, 0x00, 0x00,
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the total set of minting parameters.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error)
+	// Inflation returns the current minting inflation value.
+	MunicipalInflation(ctx context.Context, in *QueryMunicipalInflationRequest, opts ...grpc.CallOption) (*QueryMunicipalInflationResponse, error)
+	// AnnualProvisions current minting annual provisions value.
+	AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error)
+	// SupplyHeadroom returns the remaining amount which can be minted before the
+	// supply of capped denominations reaches the maximum supply.
+	SupplyHeadroom(ctx context.Context, in *QuerySupplyHeadroomRequest, opts ...grpc.CallOption) (*QuerySupplyHeadroomResponse, error)
+	// MintedTotal returns the total amounts of tokens minted per denomination.
+	MintedTotal(ctx context.Context, in *QueryMintedTotalRequest, opts ...grpc.CallOption) (*QueryMintedTotalResponse, error)
+	// RecipientMinted returns the total amounts of tokens minted to a recipient.
+	RecipientMinted(ctx context.Context, in *QueryRecipientMintedRequest, opts ...grpc.CallOption) (*QueryRecipientMintedResponse, error)
+	// MintCheckpoints returns the periodic checkpoints of the total minted amounts.
+	MintCheckpoints(ctx context.Context, in *QueryMintCheckpointsRequest, opts ...grpc.CallOption) (*QueryMintCheckpointsResponse, error)
+	// BlocksPerYear returns the effective blocks per year, together with the
+	// observed block rate.
+	BlocksPerYear(ctx context.Context, in *QueryBlocksPerYearRequest, opts ...grpc.CallOption) (*QueryBlocksPerYearResponse, error)
+	// BurnedTotal returns the total amounts of tokens burned by municipal
+	// inflation in burn mode, per denomination.
+	BurnedTotal(ctx context.Context, in *QueryBurnedTotalRequest, opts ...grpc.CallOption) (*QueryBurnedTotalResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Inflation(ctx context.Context, in *QueryInflationRequest, opts ...grpc.CallOption) (*QueryInflationResponse, error) {
+	out := new(QueryInflationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/Inflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MunicipalInflation(ctx context.Context, in *QueryMunicipalInflationRequest, opts ...grpc.CallOption) (*QueryMunicipalInflationResponse, error) {
+	out := new(QueryMunicipalInflationResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/MunicipalInflation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AnnualProvisions(ctx context.Context, in *QueryAnnualProvisionsRequest, opts ...grpc.CallOption) (*QueryAnnualProvisionsResponse, error) {
+	out := new(QueryAnnualProvisionsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/AnnualProvisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SupplyHeadroom(ctx context.Context, in *QuerySupplyHeadroomRequest, opts ...grpc.CallOption) (*QuerySupplyHeadroomResponse, error) {
+	out := new(QuerySupplyHeadroomResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/SupplyHeadroom", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintedTotal(ctx context.Context, in *QueryMintedTotalRequest, opts ...grpc.CallOption) (*QueryMintedTotalResponse, error) {
+	out := new(QueryMintedTotalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/MintedTotal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RecipientMinted(ctx context.Context, in *QueryRecipientMintedRequest, opts ...grpc.CallOption) (*QueryRecipientMintedResponse, error) {
+	out := new(QueryRecipientMintedResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/RecipientMinted", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintCheckpoints(ctx context.Context, in *QueryMintCheckpointsRequest, opts ...grpc.CallOption) (*QueryMintCheckpointsResponse, error) {
+	out := new(QueryMintCheckpointsResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/MintCheckpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BlocksPerYear(ctx context.Context, in *QueryBlocksPerYearRequest, opts ...grpc.CallOption) (*QueryBlocksPerYearResponse, error) {
+	out := new(QueryBlocksPerYearResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/BlocksPerYear", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedTotal(ctx context.Context, in *QueryBurnedTotalRequest, opts ...grpc.CallOption) (*QueryBurnedTotalResponse, error) {
+	out := new(QueryBurnedTotalResponse)
+	err := c.cc.Invoke(ctx, "/cosmos.mint.v1beta1.Query/BurnedTotal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the total set of minting parameters.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Inflation returns the current minting inflation value.
+	Inflation(context.Context, *QueryInflationRequest) (*QueryInflationResponse, error)
+	// Inflation returns the current minting inflation value.
+	MunicipalInflation(context.Context, *QueryMunicipalInflationRequest) (*QueryMunicipalInflationResponse, error)
+	// AnnualProvisions current minting annual provisions value.
+	AnnualProvisions(context.Context, *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error)
+	// SupplyHeadroom returns the remaining amount which can be minted before the
+	// supply of capped denominations reaches the maximum supply.
+	SupplyHeadroom(context.Context, *QuerySupplyHeadroomRequest) (*QuerySupplyHeadroomResponse, error)
+	// MintedTotal returns the total amounts of tokens minted per denomination.
+	MintedTotal(context.Context, *QueryMintedTotalRequest) (*QueryMintedTotalResponse, error)
+	// RecipientMinted returns the total amounts of tokens minted to a recipient.
+	RecipientMinted(context.Context, *QueryRecipientMintedRequest) (*QueryRecipientMintedResponse, error)
+	// MintCheckpoints returns the periodic checkpoints of the total minted amounts.
+	MintCheckpoints(context.Context, *QueryMintCheckpointsRequest) (*QueryMintCheckpointsResponse, error)
+	// BlocksPerYear returns the effective blocks per year, together with the
+	// observed block rate.
+	BlocksPerYear(context.Context, *QueryBlocksPerYearRequest) (*QueryBlocksPerYearResponse, error)
+	// BurnedTotal returns the total amounts of tokens burned by municipal
+	// inflation in burn mode, per denomination.
+	BurnedTotal(context.Context, *QueryBurnedTotalRequest) (*QueryBurnedTotalResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Inflation(ctx context.Context, req *QueryInflationRequest) (*QueryInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Inflation not implemented")
+}
+func (*UnimplementedQueryServer) MunicipalInflation(ctx context.Context, req *QueryMunicipalInflationRequest) (*QueryMunicipalInflationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MunicipalInflation not implemented")
+}
+func (*UnimplementedQueryServer) AnnualProvisions(ctx context.Context, req *QueryAnnualProvisionsRequest) (*QueryAnnualProvisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AnnualProvisions not implemented")
+}
+func (*UnimplementedQueryServer) SupplyHeadroom(ctx context.Context, req *QuerySupplyHeadroomRequest) (*QuerySupplyHeadroomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SupplyHeadroom not implemented")
+}
+func (*UnimplementedQueryServer) MintedTotal(ctx context.Context, req *QueryMintedTotalRequest) (*QueryMintedTotalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintedTotal not implemented")
+}
+func (*UnimplementedQueryServer) RecipientMinted(ctx context.Context, req *QueryRecipientMintedRequest) (*QueryRecipientMintedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecipientMinted not implemented")
+}
+func (*UnimplementedQueryServer) MintCheckpoints(ctx context.Context, req *QueryMintCheckpointsRequest) (*QueryMintCheckpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintCheckpoints not implemented")
+}
+func (*UnimplementedQueryServer) BlocksPerYear(ctx context.Context, req *QueryBlocksPerYearRequest) (*QueryBlocksPerYearResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlocksPerYear not implemented")
+}
+func (*UnimplementedQueryServer) BurnedTotal(ctx context.Context, req *QueryBurnedTotalRequest) (*QueryBurnedTotalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedTotal not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Inflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Inflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/Inflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Inflation(ctx, req.(*QueryInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MunicipalInflation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMunicipalInflationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MunicipalInflation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/MunicipalInflation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MunicipalInflation(ctx, req.(*QueryMunicipalInflationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AnnualProvisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAnnualProvisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AnnualProvisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/AnnualProvisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AnnualProvisions(ctx, req.(*QueryAnnualProvisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SupplyHeadroom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySupplyHeadroomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SupplyHeadroom(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/SupplyHeadroom",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SupplyHeadroom(ctx, req.(*QuerySupplyHeadroomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintedTotal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintedTotalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintedTotal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/MintedTotal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintedTotal(ctx, req.(*QueryMintedTotalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RecipientMinted_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRecipientMintedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RecipientMinted(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/RecipientMinted",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RecipientMinted(ctx, req.(*QueryRecipientMintedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/MintCheckpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintCheckpoints(ctx, req.(*QueryMintCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BlocksPerYear_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBlocksPerYearRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BlocksPerYear(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/BlocksPerYear",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BlocksPerYear(ctx, req.(*QueryBlocksPerYearRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedTotal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedTotalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedTotal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cosmos.mint.v1beta1.Query/BurnedTotal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedTotal(ctx, req.(*QueryBurnedTotalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cosmos.mint.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Inflation",
+			Handler:    _Query_Inflation_Handler,
+		},
+		{
+			MethodName: "MunicipalInflation",
+			Handler:    _Query_MunicipalInflation_Handler,
+		},
+		{
+			MethodName: "AnnualProvisions",
+			Handler:    _Query_AnnualProvisions_Handler,
+		},
+		{
+			MethodName: "SupplyHeadroom",
+			Handler:    _Query_SupplyHeadroom_Handler,
+		},
+		{
+			MethodName: "MintedTotal",
+			Handler:    _Query_MintedTotal_Handler,
+		},
+		{
+			MethodName: "RecipientMinted",
+			Handler:    _Query_RecipientMinted_Handler,
+		},
+		{
+			MethodName: "MintCheckpoints",
+			Handler:    _Query_MintCheckpoints_Handler,
+		},
+		{
+			MethodName: "BlocksPerYear",
+			Handler:    _Query_BlocksPerYear_Handler,
+		},
+		{
+			MethodName: "BurnedTotal",
+			Handler:    _Query_BurnedTotal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cosmos/mint/v1beta1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMunicipalInflationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMunicipalInflationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMunicipalInflationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XDenom != nil {
+		{
+			size := m.XDenom.Size()
+			i -= size
+			if _, err := m.XDenom.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMunicipalInflationRequest_Denom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMunicipalInflationRequest_Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Denom)
+	copy(dAtA[i:], m.Denom)
+	i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *QueryInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Inflation.Size()
+		i -= size
+		if _, err := m.Inflation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMunicipalInflationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMunicipalInflationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMunicipalInflationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Statuses) > 0 {
+		for iNdEx := len(m.Statuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Statuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Inflations) > 0 {
+		for iNdEx := len(m.Inflations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Inflations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MunicipalInflationStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MunicipalInflationStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MunicipalInflationStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NextRate.Size()
+		i -= size
+		if _, err := m.NextRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.NextChangeTime != nil {
+		n2, err2 := github_com_gogo_protobuf_types.StdTimeMarshalTo(*m.NextChangeTime, dAtA[i-github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextChangeTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintQuery(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.NextChangeHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.NextChangeHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.CurrentRate.Size()
+		i -= size
+		if _, err := m.CurrentRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAnnualProvisionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAnnualProvisionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.AnnualProvisions.Size()
+		i -= size
+		if _, err := m.AnnualProvisions.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyHeadroomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyHeadroomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyHeadroomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.XDenom != nil {
+		{
+			size := m.XDenom.Size()
+			i -= size
+			if _, err := m.XDenom.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySupplyHeadroomRequest_Denom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyHeadroomRequest_Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	i -= len(m.Denom)
+	copy(dAtA[i:], m.Denom)
+	i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+func (m *QuerySupplyHeadroomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySupplyHeadroomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySupplyHeadroomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Headrooms) > 0 {
+		for iNdEx := len(m.Headrooms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Headrooms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SupplyHeadroom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SupplyHeadroom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SupplyHeadroom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Headroom.Size()
+		i -= size
+		if _, err := m.Headroom.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintedTotalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintedTotalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintedTotalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintedTotalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintedTotalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintedTotalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipientMintedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipientMintedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipientMintedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRecipientMintedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRecipientMintedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRecipientMintedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minted) > 0 {
+		for iNdEx := len(m.Minted) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minted[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintCheckpointsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintCheckpointsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintCheckpointsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintCheckpointsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintCheckpointsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintCheckpointsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Checkpoints) > 0 {
+		for iNdEx := len(m.Checkpoints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Checkpoints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksPerYearRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksPerYearRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksPerYearRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBlocksPerYearResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBlocksPerYearResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBlocksPerYearResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Samples != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Samples))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.AverageBlockInterval.Size()
+		i -= size
+		if _, err := m.AverageBlockInterval.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.ObservedBlocksPerYear != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.ObservedBlocksPerYear))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlocksPerYear != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlocksPerYear))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedTotalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedTotalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedTotalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedTotalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedTotalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedTotalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Burned) > 0 {
+		for iNdEx := len(m.Burned) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burned[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryMunicipalInflationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XDenom != nil {
+		n += m.XDenom.Size()
+	}
+	return n
+}
+
+func (m *QueryMunicipalInflationRequest_Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+func (m *QueryInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Inflation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMunicipalInflationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Inflations) > 0 {
+		for _, e := range m.Inflations {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Statuses) > 0 {
+		for _, e := range m.Statuses {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MunicipalInflationStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.CurrentRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.NextChangeHeight != 0 {
+		n += 1 + sovQuery(uint64(m.NextChangeHeight))
+	}
+	if m.NextChangeTime != nil {
+		l = github_com_gogo_protobuf_types.SizeOfStdTime(*m.NextChangeTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.NextRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAnnualProvisionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryAnnualProvisionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.AnnualProvisions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySupplyHeadroomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.XDenom != nil {
+		n += m.XDenom.Size()
+	}
+	return n
+}
+
+func (m *QuerySupplyHeadroomRequest_Denom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+func (m *QuerySupplyHeadroomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Headrooms) > 0 {
+		for _, e := range m.Headrooms {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SupplyHeadroom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Headroom.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintedTotalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintedTotalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecipientMintedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRecipientMintedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minted) > 0 {
+		for _, e := range m.Minted {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintCheckpointsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintCheckpointsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Checkpoints) > 0 {
+		for _, e := range m.Checkpoints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBlocksPerYearRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBlocksPerYearResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlocksPerYear != 0 {
+		n += 1 + sovQuery(uint64(m.BlocksPerYear))
+	}
+	if m.ObservedBlocksPerYear != 0 {
+		n += 1 + sovQuery(uint64(m.ObservedBlocksPerYear))
+	}
+	l = m.AverageBlockInterval.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Samples != 0 {
+		n += 1 + sovQuery(uint64(m.Samples))
+	}
+	return n
+}
+
+func (m *QueryBurnedTotalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBurnedTotalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burned) > 0 {
+		for _, e := range m.Burned {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMunicipalInflationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMunicipalInflationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMunicipalInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XDenom = &QueryMunicipalInflationRequest_Denom{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMunicipalInflationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMunicipalInflationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMunicipalInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Inflations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Inflations = append(m.Inflations, &MunicipalInflationPair{})
+			if err := m.Inflations[len(m.Inflations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Statuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Statuses = append(m.Statuses, MunicipalInflationStatus{})
+			if err := m.Statuses[len(m.Statuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MunicipalInflationStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MunicipalInflationStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MunicipalInflationStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChangeHeight", wireType)
+			}
+			m.NextChangeHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextChangeHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextChangeTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NextChangeTime == nil {
+				m.NextChangeTime = new(time.Time)
+			}
+			if err := github_com_gogo_protobuf_types.StdTimeUnmarshal(m.NextChangeTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyHeadroomRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.XDenom = &QuerySupplyHeadroomRequest_Denom{string(dAtA[iNdEx:postIndex])}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySupplyHeadroomResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySupplyHeadroomResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headrooms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Headrooms = append(m.Headrooms, SupplyHeadroom{})
+			if err := m.Headrooms[len(m.Headrooms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SupplyHeadroom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SupplyHeadroom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SupplyHeadroom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Headroom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Headroom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintedTotalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintedTotalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintedTotalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintedTotalResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintedTotalResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintedTotalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecipientMintedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
 			b := dAtA[iNdEx]
 			iNdEx++
@@ -1024,12 +4355,200 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryRecipientMintedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryRecipientMintedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRecipientMintedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRecipientMintedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRecipientMintedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minted", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minted = append(m.Minted, types.Coin{})
+			if err := m.Minted[len(m.Minted)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1051,7 +4570,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintCheckpointsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1074,15 +4593,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintCheckpointsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintCheckpointsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1109,7 +4628,10 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1134,7 +4656,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintCheckpointsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1157,12 +4679,82 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintCheckpointsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintCheckpointsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Checkpoints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Checkpoints = append(m.Checkpoints, MintCheckpoint{})
+			if err := m.Checkpoints[len(m.Checkpoints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1184,7 +4776,7 @@ func (m *QueryInflationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMunicipalInflationRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBlocksPerYearRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1207,44 +4799,12 @@ func (m *QueryMunicipalInflationRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMunicipalInflationRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlocksPerYearRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMunicipalInflationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlocksPerYearRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.XDenom = &QueryMunicipalInflationRequest_Denom{string(dAtA[iNdEx:postIndex])}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1266,7 +4826,7 @@ func (m *QueryMunicipalInflationRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBlocksPerYearResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1289,17 +4849,55 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryInflationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBlocksPerYearResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBlocksPerYearResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlocksPerYear", wireType)
+			}
+			m.BlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservedBlocksPerYear", wireType)
+			}
+			m.ObservedBlocksPerYear = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ObservedBlocksPerYear |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageBlockInterval", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1309,25 +4907,45 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Inflation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.AverageBlockInterval.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Samples", wireType)
+			}
+			m.Samples = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Samples |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1349,7 +4967,7 @@ func (m *QueryInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryMunicipalInflationResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedTotalRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1372,15 +4990,15 @@ func (m *QueryMunicipalInflationResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryMunicipalInflationResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedTotalRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryMunicipalInflationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedTotalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Inflations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1407,8 +5025,10 @@ func (m *QueryMunicipalInflationResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Inflations = append(m.Inflations, &MunicipalInflationPair{})
-			if err := m.Inflations[len(m.Inflations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1433,7 +5053,7 @@ func (m *QueryMunicipalInflationResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBurnedTotalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1456,67 +5076,51 @@ func (m *QueryAnnualProvisionsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBurnedTotalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBurnedTotalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burned", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Burned = append(m.Burned, types.Coin{})
+			if err := m.Burned[len(m.Burned)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAnnualProvisionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AnnualProvisions", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1526,22 +5130,25 @@ func (m *QueryAnnualProvisionsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AnnualProvisions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,222 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MunicipalInflationSegment describes the annual municipal inflation rate active
+// in a given block, together with the point at which the rate changes next.
+type MunicipalInflationSegment struct {
+	// Rate is the active ANNUAL inflation rate, zero if inflation is not active
+	Rate sdk.Dec
+	// NextChangeHeight is the height of the next scheduled change, zero if none
+	NextChangeHeight int64
+	// NextChangeTime is the time of the next scheduled change, nil if none
+	NextChangeTime *time.Time
+}
+
+// IsActive returns true if new tokens are minted, or existing tokens burned,
+// within the segment.
+func (s MunicipalInflationSegment) IsActive() bool {
+	return !s.Rate.IsZero()
+}
+
+// IsChangeDue returns true if the segment is no longer valid for the block with
+// the given height and time.
+func (s MunicipalInflationSegment) IsChangeDue(height int64, blockTime time.Time) bool {
+	if s.NextChangeHeight > 0 && height >= s.NextChangeHeight {
+		return true
+	}
+
+	return s.NextChangeTime != nil && !blockTime.Before(*s.NextChangeTime)
+}
+
+// ActiveSegment returns the segment of the inflation schedule active in the
+// block with the given height and time.
+func (inflation *MunicipalInflation) ActiveSegment(height int64, blockTime time.Time) MunicipalInflationSegment {
+	schedule := inflation.Schedule
+	if schedule == nil {
+		return MunicipalInflationSegment{Rate: inflation.Value}
+	}
+
+	segment := MunicipalInflationSegment{Rate: sdk.ZeroDec()}
+
+	if schedule.isEnded(height, blockTime) {
+		return segment
+	}
+
+	segment.addNextChangeHeight(schedule.EndHeight)
+	segment.addNextChangeTime(schedule.EndTime)
+
+	if !schedule.isStarted(height, blockTime) {
+		if schedule.StartHeight > 0 && height < schedule.StartHeight {
+			segment.addNextChangeHeight(schedule.StartHeight)
+		}
+		if schedule.StartTime != nil && blockTime.Before(*schedule.StartTime) {
+			segment.addNextChangeTime(schedule.StartTime)
+		}
+		return segment
+	}
+
+	rate := inflation.Value
+	anchor := schedule.firstActiveHeight(height)
+	for _, step := range schedule.Steps {
+		if step.Height > height {
+			segment.addNextChangeHeight(step.Height)
+			break
+		}
+		rate = step.Value
+		if step.Height > anchor {
+			anchor = step.Height
+		}
+	}
+
+	if schedule.DecayPeriod > 0 && !rate.IsZero() {
+		periods := uint64(height-anchor) / schedule.DecayPeriod
+		rate = rate.Mul(schedule.DecayFactor.Power(periods))
+		segment.addNextChangeHeight(anchor + int64((periods+1)*schedule.DecayPeriod))
+	}
+
+	segment.Rate = rate
+	return segment
+}
+
+// NextSegment returns the segment following the given one, which is active in
+// the block with the given height and time. If changes are scheduled both by
+// height and by time, the earlier one is selected, the time of the change by
+// height being estimated from the number of blocks per year.
+func (inflation *MunicipalInflation) NextSegment(current MunicipalInflationSegment, height int64, blockTime time.Time, blocksPerYear uint64) (MunicipalInflationSegment, bool) {
+	byHeight := current.NextChangeHeight > 0
+	if byHeight && current.NextChangeTime != nil && blocksPerYear > 0 {
+		blockInterval := time.Duration(SecondsPerYear * float64(time.Second) / float64(blocksPerYear))
+		changeTime := blockTime.Add(time.Duration(current.NextChangeHeight-height) * blockInterval)
+		byHeight = changeTime.Before(*current.NextChangeTime)
+	}
+
+	switch {
+	case byHeight:
+		return inflation.ActiveSegment(current.NextChangeHeight, blockTime), true
+	case current.NextChangeTime != nil:
+		return inflation.ActiveSegment(height, *current.NextChangeTime), true
+	default:
+		return MunicipalInflationSegment{}, false
+	}
+}
+
+// RecordActivation returns a copy of the inflation with the activation height
+// of its schedule set to the given height, if the schedule is started by time
+// and becomes active in the block with the given height and time. The height
+// at which such a schedule becomes active, which anchors its decay, can't be
+// derived from the schedule itself.
+func (inflation *MunicipalInflation) RecordActivation(height int64, blockTime time.Time) (*MunicipalInflation, bool) {
+	schedule := inflation.Schedule
+	if schedule == nil || schedule.StartTime == nil || schedule.ActivationHeight > 0 ||
+		!schedule.isStarted(height, blockTime) || schedule.isEnded(height, blockTime) {
+		return inflation, false
+	}
+
+	activated := *schedule
+	activated.ActivationHeight = height
+
+	recorded := *inflation
+	recorded.Schedule = &activated
+	return &recorded, true
+}
+
+// isStarted returns true if the schedule has started by the block with the
+// given height and time.
+func (schedule *MunicipalInflationSchedule) isStarted(height int64, blockTime time.Time) bool {
+	return (schedule.StartHeight == 0 || height >= schedule.StartHeight) &&
+		(schedule.StartTime == nil || !blockTime.Before(*schedule.StartTime))
+}
+
+// isEnded returns true if the schedule has ended by the block with the given
+// height and time.
+func (schedule *MunicipalInflationSchedule) isEnded(height int64, blockTime time.Time) bool {
+	return (schedule.EndHeight > 0 && height >= schedule.EndHeight) ||
+		(schedule.EndTime != nil && !blockTime.Before(*schedule.EndTime))
+}
+
+// firstActiveHeight returns the height of the first block in which the started
+// schedule is active. For schedules started by time, it is the recorded
+// activation height, or the given height if not recorded yet, i.e. if the
+// schedule becomes active in the block with the given height.
+func (schedule *MunicipalInflationSchedule) firstActiveHeight(height int64) int64 {
+	if schedule.StartTime == nil {
+		return schedule.StartHeight
+	}
+
+	if schedule.ActivationHeight > 0 {
+		return schedule.ActivationHeight
+	}
+
+	return height
+}
+
+func (s *MunicipalInflationSegment) addNextChangeHeight(height int64) {
+	if height > 0 && (s.NextChangeHeight == 0 || height < s.NextChangeHeight) {
+		s.NextChangeHeight = height
+	}
+}
+
+func (s *MunicipalInflationSegment) addNextChangeTime(t *time.Time) {
+	if t != nil && (s.NextChangeTime == nil || t.Before(*s.NextChangeTime)) {
+		s.NextChangeTime = t
+	}
+}
+
+// Validate ensures validity of MunicipalInflationSchedule object fields for
+// inflation in the mint mode
+func (schedule *MunicipalInflationSchedule) Validate() error {
+	return schedule.ValidateForMode(MunicipalInflationModeMint)
+}
+
+// ValidateForMode ensures validity of MunicipalInflationSchedule object fields
+// for inflation in the given mode
+func (schedule *MunicipalInflationSchedule) ValidateForMode(mode MunicipalInflationMode) error {
+	if schedule.StartHeight < 0 || schedule.EndHeight < 0 {
+		return fmt.Errorf("inflation schedule params, start_height and end_height, cannot be negative")
+	}
+	if schedule.StartHeight > 0 && schedule.EndHeight > 0 && schedule.StartHeight >= schedule.EndHeight {
+		return fmt.Errorf("inflation schedule param, start_height %d, must be lower than end_height %d",
+			schedule.StartHeight, schedule.EndHeight)
+	}
+	if schedule.ActivationHeight < 0 {
+		return fmt.Errorf("inflation schedule param, activation_height, cannot be negative")
+	}
+	if schedule.ActivationHeight > 0 && schedule.StartTime == nil {
+		return fmt.Errorf("inflation schedule param, activation_height, requires start_time to be set")
+	}
+	if schedule.StartTime != nil && schedule.EndTime != nil && !schedule.StartTime.Before(*schedule.EndTime) {
+		return fmt.Errorf("inflation schedule param, start_time %s, must be before end_time %s",
+			schedule.StartTime, schedule.EndTime)
+	}
+
+	previous := schedule.StartHeight
+	for _, step := range schedule.Steps {
+		if step.Height <= previous {
+			return fmt.Errorf("inflation schedule param, steps, heights must be ascending and above start_height, height: %d",
+				step.Height)
+		}
+		if err := ValidateMunicipalInflationRate(mode, step.Value); err != nil {
+			return fmt.Errorf("inflation schedule param, steps, inflation rate at height %d %s", step.Height, err)
+		}
+		previous = step.Height
+	}
+
+	if schedule.DecayPeriod == 0 {
+		if !schedule.DecayFactor.IsNil() && !schedule.DecayFactor.IsZero() {
+			return fmt.Errorf("inflation schedule param, decay_factor, requires decay_period to be set")
+		}
+		return nil
+	}
+
+	if schedule.DecayFactor.IsNil() || !schedule.DecayFactor.IsPositive() || schedule.DecayFactor.GT(sdk.OneDec()) {
+		return fmt.Errorf("inflation schedule param, decay_factor, must be in the (0, 1] range")
+	}
+
+	return nil
+}
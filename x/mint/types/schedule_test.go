@@ -0,0 +1,184 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/mint/types"
+)
+
+func TestMunicipalInflationActiveSegment(t *testing.T) {
+	genesisTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	startTime := genesisTime.Add(time.Hour)
+	endTime := genesisTime.Add(2 * time.Hour)
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		name          string
+		schedule      *types.MunicipalInflationSchedule
+		height        int64
+		blockTime     time.Time
+		expRate       sdk.Dec
+		expNextHeight int64
+		expNextTime   *time.Time
+	}{
+		{"no schedule", nil, 100, genesisTime, onePercent, 0, nil},
+		{"before start height", &types.MunicipalInflationSchedule{StartHeight: 10, EndHeight: 20}, 5, genesisTime, sdk.ZeroDec(), 10, nil},
+		{"between start & end height", &types.MunicipalInflationSchedule{StartHeight: 10, EndHeight: 20}, 10, genesisTime, onePercent, 20, nil},
+		{"after end height", &types.MunicipalInflationSchedule{StartHeight: 10, EndHeight: 20}, 20, genesisTime, sdk.ZeroDec(), 0, nil},
+		{"before start time", &types.MunicipalInflationSchedule{StartTime: &startTime, EndTime: &endTime}, 5, genesisTime, sdk.ZeroDec(), 0, &startTime},
+		{"between start & end time", &types.MunicipalInflationSchedule{StartTime: &startTime, EndTime: &endTime}, 5, startTime, onePercent, 0, &endTime},
+		{"after end time", &types.MunicipalInflationSchedule{StartTime: &startTime, EndTime: &endTime}, 5, endTime, sdk.ZeroDec(), 0, nil},
+		{
+			"before step",
+			&types.MunicipalInflationSchedule{Steps: []types.MunicipalInflationStep{{Height: 10, Value: half}, {Height: 20, Value: sdk.ZeroDec()}}},
+			9, genesisTime, onePercent, 10, nil,
+		},
+		{
+			"after first step",
+			&types.MunicipalInflationSchedule{Steps: []types.MunicipalInflationStep{{Height: 10, Value: half}, {Height: 20, Value: sdk.ZeroDec()}}},
+			15, genesisTime, half, 20, nil,
+		},
+		{
+			"after last step",
+			&types.MunicipalInflationSchedule{Steps: []types.MunicipalInflationStep{{Height: 10, Value: half}, {Height: 20, Value: sdk.ZeroDec()}}},
+			25, genesisTime, sdk.ZeroDec(), 0, nil,
+		},
+		{"first decay period", &types.MunicipalInflationSchedule{StartHeight: 10, DecayPeriod: 100, DecayFactor: half}, 109, genesisTime, onePercent, 110, nil},
+		{"second halving", &types.MunicipalInflationSchedule{StartHeight: 10, DecayPeriod: 100, DecayFactor: half}, 210, genesisTime, onePercent.QuoInt64(4), 310, nil},
+		{
+			"decay anchored at first active block of time started schedule",
+			&types.MunicipalInflationSchedule{StartTime: &startTime, DecayPeriod: 100, DecayFactor: half},
+			1000, startTime, onePercent, 1100, nil,
+		},
+		{
+			"decay anchored at recorded activation height",
+			&types.MunicipalInflationSchedule{StartTime: &startTime, DecayPeriod: 100, DecayFactor: half, ActivationHeight: 1000},
+			1100, endTime, onePercent.QuoInt64(2), 1200, nil,
+		},
+		{
+			"decay restarted by step",
+			&types.MunicipalInflationSchedule{DecayPeriod: 100, DecayFactor: half, Steps: []types.MunicipalInflationStep{{Height: 150, Value: half}}},
+			249, genesisTime, half, 250, nil,
+		},
+	}
+
+	for _, tc := range tests {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			inflation := types.NewMunicipalInflation("", onePercent)
+			inflation.Schedule = tc.schedule
+
+			segment := inflation.ActiveSegment(tc.height, tc.blockTime)
+			require.True(t, tc.expRate.Equal(segment.Rate), "expected %s, got %s", tc.expRate, segment.Rate)
+			require.Equal(t, tc.expNextHeight, segment.NextChangeHeight)
+			require.Equal(t, tc.expNextTime, segment.NextChangeTime)
+			require.False(t, segment.IsChangeDue(tc.height, tc.blockTime))
+		})
+	}
+}
+
+func TestMunicipalInflationNextSegment(t *testing.T) {
+	inflation := types.NewMunicipalInflation("", onePercent)
+	inflation.Schedule = &types.MunicipalInflationSchedule{StartHeight: 10, DecayPeriod: 100, DecayFactor: sdk.NewDecWithPrec(5, 1)}
+
+	segment := inflation.ActiveSegment(50, time.Time{})
+	next, exists := inflation.NextSegment(segment, 50, time.Time{}, 0)
+	require.True(t, exists)
+	require.Equal(t, onePercent.QuoInt64(2), next.Rate)
+	require.True(t, segment.IsChangeDue(110, time.Time{}))
+
+	inflation.Schedule = nil
+	_, exists = inflation.NextSegment(inflation.ActiveSegment(50, time.Time{}), 50, time.Time{}, 0)
+	require.False(t, exists)
+}
+
+func TestMunicipalInflationNextSegmentSelectsEarlierChange(t *testing.T) {
+	blockTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	endTime := blockTime.Add(time.Hour)
+	half := sdk.NewDecWithPrec(5, 1)
+	// one block per second
+	blocksPerYear := uint64(types.SecondsPerYear)
+
+	inflation := types.NewMunicipalInflation("", onePercent)
+	inflation.Schedule = &types.MunicipalInflationSchedule{
+		EndTime: &endTime,
+		Steps:   []types.MunicipalInflationStep{{Height: 10000, Value: half}},
+	}
+
+	// the step is expected after the end time
+	segment := inflation.ActiveSegment(10, blockTime)
+	require.Equal(t, int64(10000), segment.NextChangeHeight)
+	require.Equal(t, &endTime, segment.NextChangeTime)
+	next, exists := inflation.NextSegment(segment, 10, blockTime, blocksPerYear)
+	require.True(t, exists)
+	require.True(t, next.Rate.IsZero())
+
+	// the step is expected before the end time
+	segment = inflation.ActiveSegment(9990, blockTime)
+	next, exists = inflation.NextSegment(segment, 9990, blockTime, blocksPerYear)
+	require.True(t, exists)
+	require.Equal(t, half, next.Rate)
+}
+
+func TestMunicipalInflationRecordActivation(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	half := sdk.NewDecWithPrec(5, 1)
+
+	inflation := types.NewMunicipalInflation("", onePercent)
+	inflation.Schedule = &types.MunicipalInflationSchedule{StartTime: &startTime, DecayPeriod: 100, DecayFactor: half}
+
+	_, recorded := inflation.RecordActivation(500, startTime.Add(-time.Second))
+	require.False(t, recorded)
+
+	activated, recorded := inflation.RecordActivation(500, startTime)
+	require.True(t, recorded)
+	require.Equal(t, int64(500), activated.Schedule.ActivationHeight)
+	require.Zero(t, inflation.Schedule.ActivationHeight)
+
+	_, recorded = activated.RecordActivation(501, startTime)
+	require.False(t, recorded)
+
+	// the first decay period starts at the activation height
+	require.Equal(t, onePercent, activated.ActiveSegment(599, startTime).Rate)
+	require.Equal(t, onePercent.QuoInt64(2), activated.ActiveSegment(600, startTime).Rate)
+}
+
+func TestValidationOfMunicipalInflationSchedule(t *testing.T) {
+	startTime := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	half := sdk.NewDecWithPrec(5, 1)
+
+	tests := []struct {
+		schedule       types.MunicipalInflationSchedule
+		expectedToPass bool
+	}{
+		{types.MunicipalInflationSchedule{}, true},
+		{types.MunicipalInflationSchedule{StartHeight: 10, EndHeight: 20}, true},
+		{types.MunicipalInflationSchedule{StartHeight: 20, EndHeight: 20}, false},
+		{types.MunicipalInflationSchedule{StartHeight: -1}, false},
+		{types.MunicipalInflationSchedule{StartTime: &startTime, EndTime: &startTime}, false},
+		{types.MunicipalInflationSchedule{StartTime: &startTime, ActivationHeight: 10}, true},
+		{types.MunicipalInflationSchedule{ActivationHeight: 10}, false},
+		{types.MunicipalInflationSchedule{StartTime: &startTime, ActivationHeight: -1}, false},
+		{types.MunicipalInflationSchedule{StartHeight: 10, Steps: []types.MunicipalInflationStep{{Height: 11, Value: half}, {Height: 12, Value: sdk.ZeroDec()}}}, true},
+		{types.MunicipalInflationSchedule{StartHeight: 10, Steps: []types.MunicipalInflationStep{{Height: 10, Value: half}}}, false},
+		{types.MunicipalInflationSchedule{Steps: []types.MunicipalInflationStep{{Height: 12, Value: half}, {Height: 11, Value: half}}}, false},
+		{types.MunicipalInflationSchedule{Steps: []types.MunicipalInflationStep{{Height: 12, Value: half.Neg()}}}, false},
+		{types.MunicipalInflationSchedule{DecayPeriod: 10, DecayFactor: half}, true},
+		{types.MunicipalInflationSchedule{DecayPeriod: 10, DecayFactor: sdk.ZeroDec()}, false},
+		{types.MunicipalInflationSchedule{DecayPeriod: 10, DecayFactor: sdk.NewDec(2)}, false},
+		{types.MunicipalInflationSchedule{DecayFactor: half}, false},
+	}
+
+	for _, tc := range tests {
+		err := tc.schedule.Validate()
+		if tc.expectedToPass {
+			require.NoError(t, err)
+		} else {
+			require.Error(t, err)
+		}
+	}
+}
@@ -0,0 +1,125 @@
+package keeper
+
+import (
+	"encoding/json"
+	"io"
+	"os"
+
+	protoio "github.com/gogo/protobuf/io"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// SnapshotFormat is the format of the payloads written by the UpgradeInfoSnapshotter, the payload is the JSON
+// encoded upgrade info
+const SnapshotFormat uint32 = 1
+
+var _ snapshottypes.ExtensionSnapshotter = (*UpgradeInfoSnapshotter)(nil)
+
+// UpgradeInfoSnapshotter is an ExtensionSnapshotter adding the upgrade info written to disk by DumpUpgradeInfoToDisk
+// to the state sync snapshots, since it is kept outside of the multistore and would otherwise be lost when a node is
+// state synced. The restored upgrade info is verified against the upgrades done in the restored multistore.
+type UpgradeInfoSnapshotter struct {
+	cms    sdk.MultiStore
+	keeper Keeper
+}
+
+// NewUpgradeInfoSnapshotter returns a new UpgradeInfoSnapshotter reading and writing the upgrade info of the keeper,
+// which is verified against the state of the given multistore on restore
+func NewUpgradeInfoSnapshotter(cms sdk.MultiStore, keeper Keeper) *UpgradeInfoSnapshotter {
+	return &UpgradeInfoSnapshotter{cms: cms, keeper: keeper}
+}
+
+// SnapshotName implements ExtensionSnapshotter
+func (s *UpgradeInfoSnapshotter) SnapshotName() string {
+	return types.ModuleName
+}
+
+// SnapshotFormat implements ExtensionSnapshotter
+func (s *UpgradeInfoSnapshotter) SnapshotFormat() uint32 {
+	return SnapshotFormat
+}
+
+// SupportedFormats implements ExtensionSnapshotter
+func (s *UpgradeInfoSnapshotter) SupportedFormats() []uint32 {
+	return []uint32{SnapshotFormat}
+}
+
+// Snapshot implements ExtensionSnapshotter. The upgrade info is written only if its upgrade height is not above the
+// snapshot height, so that the snapshots of all the nodes at a given height are the same whether or not the upgrade
+// info of a later upgrade has already been written to disk.
+func (s *UpgradeInfoSnapshotter) Snapshot(height uint64, protoWriter protoio.Writer) error {
+	upgradeInfoPath, err := s.keeper.GetUpgradeInfoPath()
+	if err != nil {
+		return err
+	}
+	data, err := os.ReadFile(upgradeInfoPath)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	var info upgradeInfo
+	if err := json.Unmarshal(data, &info); err != nil {
+		return sdkerrors.Wrapf(err, "invalid upgrade info in %s", upgradeInfoPath)
+	}
+	if info.Height <= 0 || uint64(info.Height) > height {
+		return nil
+	}
+
+	// the upgrade info is encoded again so that the payload does not depend on the formatting of the file
+	payload, err := json.Marshal(info)
+	if err != nil {
+		return err
+	}
+	return snapshottypes.WriteExtensionItem(protoWriter, payload)
+}
+
+// Restore implements ExtensionSnapshotter, it writes the upgrade info of the snapshot to disk. The multistore is
+// restored before the extensions, so the upgrade info is written only if the restored state records the upgrade as
+// done at the height of the upgrade info, which rejects payloads not matching the verified state.
+func (s *UpgradeInfoSnapshotter) Restore(
+	height uint64, format uint32, protoReader protoio.Reader,
+) (snapshottypes.SnapshotItem, error) {
+	if format != SnapshotFormat {
+		return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(snapshottypes.ErrUnknownFormat, "format %v", format)
+	}
+
+	for {
+		item := snapshottypes.SnapshotItem{}
+		err := protoReader.ReadMsg(&item)
+		if err == io.EOF {
+			return snapshottypes.SnapshotItem{}, nil
+		} else if err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid protobuf message")
+		}
+		payload := item.GetExtensionPayload()
+		if payload == nil {
+			// the item belongs to the next extension
+			return item, nil
+		}
+
+		var info upgradeInfo
+		if err := json.Unmarshal(payload.Payload, &info); err != nil {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrap(err, "invalid upgrade info")
+		}
+		if info.Height <= 0 || uint64(info.Height) > height {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight,
+				"upgrade info height %d is above the snapshot height %d", info.Height, height)
+		}
+		ctx := sdk.NewContext(s.cms.CacheMultiStore(), tmproto.Header{Height: int64(height)}, false, log.NewNopLogger())
+		if doneHeight := s.keeper.GetDoneHeight(ctx, info.Name); doneHeight != info.Height {
+			return snapshottypes.SnapshotItem{}, sdkerrors.Wrapf(sdkerrors.ErrInvalidHeight,
+				"upgrade info height %d of %s does not match the done height %d", info.Height, info.Name, doneHeight)
+		}
+		if err := s.keeper.DumpUpgradeInfoWithInfoToDisk(info.Height, info.Name, info.Info); err != nil {
+			return snapshottypes.SnapshotItem{}, err
+		}
+	}
+}
@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/tendermint/tendermint/libs/log"
+	tmproto "github.com/tendermint/tendermint/proto/tendermint/types"
+	dbm "github.com/tendermint/tm-db"
+
+	"github.com/cosmos/cosmos-sdk/snapshots"
+	snapshottypes "github.com/cosmos/cosmos-sdk/snapshots/types"
+	"github.com/cosmos/cosmos-sdk/store/rootmulti"
+	store "github.com/cosmos/cosmos-sdk/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/keeper"
+	"github.com/cosmos/cosmos-sdk/x/upgrade/types"
+)
+
+// newSnapshotManager returns a snapshot manager of a multistore with the upgrade info snapshotter of a new keeper
+func newSnapshotManager(t *testing.T, snapshotStore *snapshots.Store) (*snapshots.Manager, *rootmulti.Store, keeper.Keeper) {
+	key := sdk.NewKVStoreKey(types.StoreKey)
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger())
+	cms.MountStoreWithDB(key, store.StoreTypeIAVL, nil)
+	require.NoError(t, cms.LoadLatestVersion())
+
+	upgradeKeeper := keeper.NewKeeper(map[int64]bool{}, key, nil, t.TempDir(), nil)
+	manager := snapshots.NewManager(snapshotStore, cms)
+	require.NoError(t, manager.RegisterExtensions(keeper.NewUpgradeInfoSnapshotter(cms, upgradeKeeper)))
+	return manager, cms, upgradeKeeper
+}
+
+// applyUpgrade applies the upgrade in the block with the given height and commits it
+func applyUpgrade(cms *rootmulti.Store, upgradeKeeper keeper.Keeper, name string, height int64) {
+	ctx := sdk.NewContext(cms, tmproto.Header{Height: height}, false, log.NewNopLogger())
+	upgradeKeeper.SetUpgradeHandler(name, func(_ sdk.Context, _ types.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		return vm, nil
+	})
+	upgradeKeeper.ApplyUpgrade(ctx, types.Plan{Name: name, Height: height})
+	cms.Commit()
+}
+
+func restoreSnapshot(t *testing.T, snapshotStore *snapshots.Store, manager *snapshots.Manager, snapshot *snapshottypes.Snapshot) {
+	require.NoError(t, manager.Restore(*snapshot))
+	for i := uint32(0); i < snapshot.Chunks; i++ {
+		chunk, err := snapshotStore.LoadChunk(snapshot.Height, snapshot.Format, i)
+		require.NoError(t, err)
+		bz, err := io.ReadAll(chunk)
+		require.NoError(t, err)
+		require.NoError(t, chunk.Close())
+		done, err := manager.RestoreChunk(bz)
+		require.NoError(t, err)
+		require.Equal(t, i == snapshot.Chunks-1, done)
+	}
+}
+
+func TestUpgradeInfoSnapshotter(t *testing.T) {
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	sourceManager, sourceStore, source := newSnapshotManager(t, snapshotStore)
+	for i := 0; i < 2; i++ {
+		sourceStore.Commit()
+	}
+	applyUpgrade(sourceStore, source, "test_upgrade", 3)
+	require.NoError(t, source.DumpUpgradeInfoWithInfoToDisk(3, "test_upgrade", "some info"))
+	expected, err := source.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+
+	// the upgrade info of a later upgrade is not included in the snapshot
+	snapshot, err := sourceManager.Create(2)
+	require.NoError(t, err)
+	targetManager, _, target := newSnapshotManager(t, snapshotStore)
+	restoreSnapshot(t, snapshotStore, targetManager, snapshot)
+	targetInfo, err := target.GetUpgradeInfoPath()
+	require.NoError(t, err)
+	_, err = os.Stat(targetInfo)
+	require.True(t, os.IsNotExist(err))
+
+	// the upgrade info is restored along with the multistore
+	snapshot, err = sourceManager.Create(3)
+	require.NoError(t, err)
+	targetManager, _, target = newSnapshotManager(t, snapshotStore)
+	restoreSnapshot(t, snapshotStore, targetManager, snapshot)
+
+	restored, err := target.ReadUpgradeInfoFromDisk()
+	require.NoError(t, err)
+	require.Equal(t, expected, restored)
+	sourceInfo, err := source.GetUpgradeInfoPath()
+	require.NoError(t, err)
+	targetInfo, err = target.GetUpgradeInfoPath()
+	require.NoError(t, err)
+	sourceBz, err := os.ReadFile(sourceInfo)
+	require.NoError(t, err)
+	targetBz, err := os.ReadFile(targetInfo)
+	require.NoError(t, err)
+	require.Equal(t, sourceBz, targetBz)
+}
+
+func TestUpgradeInfoSnapshotter_ForgedUpgradeInfo(t *testing.T) {
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+
+	sourceManager, sourceStore, source := newSnapshotManager(t, snapshotStore)
+	for i := 0; i < 2; i++ {
+		sourceStore.Commit()
+	}
+	applyUpgrade(sourceStore, source, "test_upgrade", 3)
+
+	for name, info := range map[string]struct {
+		name   string
+		height int64
+	}{
+		"upgrade not done": {"forged_upgrade", 3},
+		"another height":   {"test_upgrade", 2},
+	} {
+		t.Run(name, func(t *testing.T) {
+			require.NoError(t, source.DumpUpgradeInfoWithInfoToDisk(info.height, info.name, "forged info"))
+			snapshot, err := sourceManager.Create(3)
+			require.NoError(t, err)
+			t.Cleanup(func() { require.NoError(t, snapshotStore.Delete(snapshot.Height, snapshot.Format)) })
+
+			targetManager, _, target := newSnapshotManager(t, snapshotStore)
+			require.NoError(t, targetManager.Restore(*snapshot))
+			var restoreErr error
+			for i := uint32(0); i < snapshot.Chunks && restoreErr == nil; i++ {
+				chunk, err := snapshotStore.LoadChunk(snapshot.Height, snapshot.Format, i)
+				require.NoError(t, err)
+				bz, err := io.ReadAll(chunk)
+				require.NoError(t, err)
+				require.NoError(t, chunk.Close())
+				_, restoreErr = targetManager.RestoreChunk(bz)
+			}
+			require.ErrorIs(t, restoreErr, sdkerrors.ErrInvalidHeight)
+
+			targetInfo, err := target.GetUpgradeInfoPath()
+			require.NoError(t, err)
+			_, err = os.Stat(targetInfo)
+			require.True(t, os.IsNotExist(err))
+		})
+	}
+}
+
+func TestUpgradeInfoSnapshotter_UnknownFormat(t *testing.T) {
+	snapshotter := keeper.NewUpgradeInfoSnapshotter(nil, keeper.NewKeeper(map[int64]bool{}, nil, nil, t.TempDir(), nil))
+	require.Equal(t, []uint32{keeper.SnapshotFormat}, snapshotter.SupportedFormats())
+
+	_, err := snapshotter.Restore(1, keeper.SnapshotFormat+1, nil)
+	require.ErrorIs(t, err, snapshottypes.ErrUnknownFormat)
+}